@@ -9,6 +9,7 @@ package v1
 import (
 	context "context"
 	v1 "github.com/knita-io/knita/api/events/v1"
+	v11 "github.com/knita-io/knita/api/executor/v1"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
@@ -22,9 +23,13 @@ const _ = grpc.SupportPackageIsVersion7
 const (
 	Director_Open_FullMethodName   = "/director.knita.io.Director/Open"
 	Director_Exec_FullMethodName   = "/director.knita.io.Director/Exec"
+	Director_Signal_FullMethodName = "/director.knita.io.Director/Signal"
+	Director_Attach_FullMethodName = "/director.knita.io.Director/Attach"
 	Director_Import_FullMethodName = "/director.knita.io.Director/Import"
 	Director_Export_FullMethodName = "/director.knita.io.Director/Export"
 	Director_Close_FullMethodName  = "/director.knita.io.Director/Close"
+	Director_Pause_FullMethodName  = "/director.knita.io.Director/Pause"
+	Director_Resume_FullMethodName = "/director.knita.io.Director/Resume"
 )
 
 // DirectorClient is the client API for Director service.
@@ -32,10 +37,14 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type DirectorClient interface {
 	Open(ctx context.Context, in *OpenRequest, opts ...grpc.CallOption) (*OpenResponse, error)
-	Exec(ctx context.Context, in *ExecRequest, opts ...grpc.CallOption) (Director_ExecClient, error)
+	Exec(ctx context.Context, opts ...grpc.CallOption) (Director_ExecClient, error)
+	Signal(ctx context.Context, in *SignalRequest, opts ...grpc.CallOption) (*SignalResponse, error)
+	Attach(ctx context.Context, opts ...grpc.CallOption) (Director_AttachClient, error)
 	Import(ctx context.Context, in *ImportRequest, opts ...grpc.CallOption) (*ImportResponse, error)
 	Export(ctx context.Context, in *ExportRequest, opts ...grpc.CallOption) (*ExportResponse, error)
 	Close(ctx context.Context, in *CloseRequest, opts ...grpc.CallOption) (*CloseResponse, error)
+	Pause(ctx context.Context, in *PauseRequest, opts ...grpc.CallOption) (*PauseResponse, error)
+	Resume(ctx context.Context, in *ResumeRequest, opts ...grpc.CallOption) (*ResumeResponse, error)
 }
 
 type directorClient struct {
@@ -55,22 +64,17 @@ func (c *directorClient) Open(ctx context.Context, in *OpenRequest, opts ...grpc
 	return out, nil
 }
 
-func (c *directorClient) Exec(ctx context.Context, in *ExecRequest, opts ...grpc.CallOption) (Director_ExecClient, error) {
+func (c *directorClient) Exec(ctx context.Context, opts ...grpc.CallOption) (Director_ExecClient, error) {
 	stream, err := c.cc.NewStream(ctx, &Director_ServiceDesc.Streams[0], Director_Exec_FullMethodName, opts...)
 	if err != nil {
 		return nil, err
 	}
 	x := &directorExecClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
-	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
-	}
 	return x, nil
 }
 
 type Director_ExecClient interface {
+	Send(*ExecRequest) error
 	Recv() (*v1.Event, error)
 	grpc.ClientStream
 }
@@ -79,6 +83,10 @@ type directorExecClient struct {
 	grpc.ClientStream
 }
 
+func (x *directorExecClient) Send(m *ExecRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
 func (x *directorExecClient) Recv() (*v1.Event, error) {
 	m := new(v1.Event)
 	if err := x.ClientStream.RecvMsg(m); err != nil {
@@ -87,6 +95,46 @@ func (x *directorExecClient) Recv() (*v1.Event, error) {
 	return m, nil
 }
 
+func (c *directorClient) Signal(ctx context.Context, in *SignalRequest, opts ...grpc.CallOption) (*SignalResponse, error) {
+	out := new(SignalResponse)
+	err := c.cc.Invoke(ctx, Director_Signal_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *directorClient) Attach(ctx context.Context, opts ...grpc.CallOption) (Director_AttachClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Director_ServiceDesc.Streams[1], Director_Attach_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &directorAttachClient{stream}
+	return x, nil
+}
+
+type Director_AttachClient interface {
+	Send(*v11.AttachRequest) error
+	Recv() (*v11.AttachResponse, error)
+	grpc.ClientStream
+}
+
+type directorAttachClient struct {
+	grpc.ClientStream
+}
+
+func (x *directorAttachClient) Send(m *v11.AttachRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *directorAttachClient) Recv() (*v11.AttachResponse, error) {
+	m := new(v11.AttachResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *directorClient) Import(ctx context.Context, in *ImportRequest, opts ...grpc.CallOption) (*ImportResponse, error) {
 	out := new(ImportResponse)
 	err := c.cc.Invoke(ctx, Director_Import_FullMethodName, in, out, opts...)
@@ -114,15 +162,37 @@ func (c *directorClient) Close(ctx context.Context, in *CloseRequest, opts ...gr
 	return out, nil
 }
 
+func (c *directorClient) Pause(ctx context.Context, in *PauseRequest, opts ...grpc.CallOption) (*PauseResponse, error) {
+	out := new(PauseResponse)
+	err := c.cc.Invoke(ctx, Director_Pause_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *directorClient) Resume(ctx context.Context, in *ResumeRequest, opts ...grpc.CallOption) (*ResumeResponse, error) {
+	out := new(ResumeResponse)
+	err := c.cc.Invoke(ctx, Director_Resume_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // DirectorServer is the server API for Director service.
 // All implementations must embed UnimplementedDirectorServer
 // for forward compatibility
 type DirectorServer interface {
 	Open(context.Context, *OpenRequest) (*OpenResponse, error)
-	Exec(*ExecRequest, Director_ExecServer) error
+	Exec(Director_ExecServer) error
+	Signal(context.Context, *SignalRequest) (*SignalResponse, error)
+	Attach(Director_AttachServer) error
 	Import(context.Context, *ImportRequest) (*ImportResponse, error)
 	Export(context.Context, *ExportRequest) (*ExportResponse, error)
 	Close(context.Context, *CloseRequest) (*CloseResponse, error)
+	Pause(context.Context, *PauseRequest) (*PauseResponse, error)
+	Resume(context.Context, *ResumeRequest) (*ResumeResponse, error)
 	mustEmbedUnimplementedDirectorServer()
 }
 
@@ -133,9 +203,15 @@ type UnimplementedDirectorServer struct {
 func (UnimplementedDirectorServer) Open(context.Context, *OpenRequest) (*OpenResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Open not implemented")
 }
-func (UnimplementedDirectorServer) Exec(*ExecRequest, Director_ExecServer) error {
+func (UnimplementedDirectorServer) Exec(Director_ExecServer) error {
 	return status.Errorf(codes.Unimplemented, "method Exec not implemented")
 }
+func (UnimplementedDirectorServer) Signal(context.Context, *SignalRequest) (*SignalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Signal not implemented")
+}
+func (UnimplementedDirectorServer) Attach(Director_AttachServer) error {
+	return status.Errorf(codes.Unimplemented, "method Attach not implemented")
+}
 func (UnimplementedDirectorServer) Import(context.Context, *ImportRequest) (*ImportResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Import not implemented")
 }
@@ -145,6 +221,12 @@ func (UnimplementedDirectorServer) Export(context.Context, *ExportRequest) (*Exp
 func (UnimplementedDirectorServer) Close(context.Context, *CloseRequest) (*CloseResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Close not implemented")
 }
+func (UnimplementedDirectorServer) Pause(context.Context, *PauseRequest) (*PauseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Pause not implemented")
+}
+func (UnimplementedDirectorServer) Resume(context.Context, *ResumeRequest) (*ResumeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Resume not implemented")
+}
 func (UnimplementedDirectorServer) mustEmbedUnimplementedDirectorServer() {}
 
 // UnsafeDirectorServer may be embedded to opt out of forward compatibility for this service.
@@ -177,15 +259,12 @@ func _Director_Open_Handler(srv interface{}, ctx context.Context, dec func(inter
 }
 
 func _Director_Exec_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(ExecRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
-	}
-	return srv.(DirectorServer).Exec(m, &directorExecServer{stream})
+	return srv.(DirectorServer).Exec(&directorExecServer{stream})
 }
 
 type Director_ExecServer interface {
 	Send(*v1.Event) error
+	Recv() (*ExecRequest, error)
 	grpc.ServerStream
 }
 
@@ -197,6 +276,58 @@ func (x *directorExecServer) Send(m *v1.Event) error {
 	return x.ServerStream.SendMsg(m)
 }
 
+func (x *directorExecServer) Recv() (*ExecRequest, error) {
+	m := new(ExecRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _Director_Signal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DirectorServer).Signal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Director_Signal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DirectorServer).Signal(ctx, req.(*SignalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Director_Attach_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(DirectorServer).Attach(&directorAttachServer{stream})
+}
+
+type Director_AttachServer interface {
+	Send(*v11.AttachResponse) error
+	Recv() (*v11.AttachRequest, error)
+	grpc.ServerStream
+}
+
+type directorAttachServer struct {
+	grpc.ServerStream
+}
+
+func (x *directorAttachServer) Send(m *v11.AttachResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *directorAttachServer) Recv() (*v11.AttachRequest, error) {
+	m := new(v11.AttachRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func _Director_Import_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ImportRequest)
 	if err := dec(in); err != nil {
@@ -251,6 +382,42 @@ func _Director_Close_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Director_Pause_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PauseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DirectorServer).Pause(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Director_Pause_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DirectorServer).Pause(ctx, req.(*PauseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Director_Resume_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResumeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DirectorServer).Resume(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Director_Resume_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DirectorServer).Resume(ctx, req.(*ResumeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Director_ServiceDesc is the grpc.ServiceDesc for Director service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -262,6 +429,10 @@ var Director_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Open",
 			Handler:    _Director_Open_Handler,
 		},
+		{
+			MethodName: "Signal",
+			Handler:    _Director_Signal_Handler,
+		},
 		{
 			MethodName: "Import",
 			Handler:    _Director_Import_Handler,
@@ -274,12 +445,27 @@ var Director_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Close",
 			Handler:    _Director_Close_Handler,
 		},
+		{
+			MethodName: "Pause",
+			Handler:    _Director_Pause_Handler,
+		},
+		{
+			MethodName: "Resume",
+			Handler:    _Director_Resume_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
 			StreamName:    "Exec",
 			Handler:       _Director_Exec_Handler,
 			ServerStreams: true,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "Attach",
+			Handler:       _Director_Attach_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
 		},
 	},
 	Metadata: "director/v1/director.proto",
@@ -11,6 +11,7 @@ import (
 	v1 "github.com/knita-io/knita/api/executor/v1"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
 	reflect "reflect"
 	sync "sync"
 )
@@ -770,6 +771,9 @@ type RuntimeOpenResult struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// ImageDigest is the digest of the image the runtime was started from (if any).
+	ImageDigest string `protobuf:"bytes,1,opt,name=image_digest,json=imageDigest,proto3" json:"image_digest,omitempty"`
 }
 
 func (x *RuntimeOpenResult) Reset() {
@@ -804,6 +808,13 @@ func (*RuntimeOpenResult) Descriptor() ([]byte, []int) {
 	return file_events_builtin_v1_builtin_proto_rawDescGZIP(), []int{12}
 }
 
+func (x *RuntimeOpenResult) GetImageDigest() string {
+	if x != nil {
+		return x.ImageDigest
+	}
+	return ""
+}
+
 type RuntimeOpenEndEvent struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -893,6 +904,141 @@ func (*RuntimeOpenEndEvent_Error) isRuntimeOpenEndEvent_Status() {}
 
 func (*RuntimeOpenEndEvent_Result) isRuntimeOpenEndEvent_Status() {}
 
+// ImagePullProgressEvent reports the progress of a container image pull while a runtime opens.
+type ImagePullProgressEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RuntimeId string                    `protobuf:"bytes,1,opt,name=runtime_id,json=runtimeId,proto3" json:"runtime_id,omitempty"`
+	ImageUri  string                    `protobuf:"bytes,2,opt,name=image_uri,json=imageUri,proto3" json:"image_uri,omitempty"`
+	Layers    []*ImagePullLayerProgress `protobuf:"bytes,3,rep,name=layers,proto3" json:"layers,omitempty"`
+}
+
+func (x *ImagePullProgressEvent) Reset() {
+	*x = ImagePullProgressEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_events_builtin_v1_builtin_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImagePullProgressEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImagePullProgressEvent) ProtoMessage() {}
+
+func (x *ImagePullProgressEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_events_builtin_v1_builtin_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImagePullProgressEvent.ProtoReflect.Descriptor instead.
+func (*ImagePullProgressEvent) Descriptor() ([]byte, []int) {
+	return file_events_builtin_v1_builtin_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ImagePullProgressEvent) GetRuntimeId() string {
+	if x != nil {
+		return x.RuntimeId
+	}
+	return ""
+}
+
+func (x *ImagePullProgressEvent) GetImageUri() string {
+	if x != nil {
+		return x.ImageUri
+	}
+	return ""
+}
+
+func (x *ImagePullProgressEvent) GetLayers() []*ImagePullLayerProgress {
+	if x != nil {
+		return x.Layers
+	}
+	return nil
+}
+
+type ImagePullLayerProgress struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LayerId string `protobuf:"bytes,1,opt,name=layer_id,json=layerId,proto3" json:"layer_id,omitempty"`
+	Status  string `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	Current int64  `protobuf:"varint,3,opt,name=current,proto3" json:"current,omitempty"`
+	Total   int64  `protobuf:"varint,4,opt,name=total,proto3" json:"total,omitempty"`
+}
+
+func (x *ImagePullLayerProgress) Reset() {
+	*x = ImagePullLayerProgress{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_events_builtin_v1_builtin_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImagePullLayerProgress) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImagePullLayerProgress) ProtoMessage() {}
+
+func (x *ImagePullLayerProgress) ProtoReflect() protoreflect.Message {
+	mi := &file_events_builtin_v1_builtin_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImagePullLayerProgress.ProtoReflect.Descriptor instead.
+func (*ImagePullLayerProgress) Descriptor() ([]byte, []int) {
+	return file_events_builtin_v1_builtin_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ImagePullLayerProgress) GetLayerId() string {
+	if x != nil {
+		return x.LayerId
+	}
+	return ""
+}
+
+func (x *ImagePullLayerProgress) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ImagePullLayerProgress) GetCurrent() int64 {
+	if x != nil {
+		return x.Current
+	}
+	return 0
+}
+
+func (x *ImagePullLayerProgress) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
 type RuntimeCloseStartEvent struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -904,7 +1050,7 @@ type RuntimeCloseStartEvent struct {
 func (x *RuntimeCloseStartEvent) Reset() {
 	*x = RuntimeCloseStartEvent{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_events_builtin_v1_builtin_proto_msgTypes[14]
+		mi := &file_events_builtin_v1_builtin_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -917,7 +1063,7 @@ func (x *RuntimeCloseStartEvent) String() string {
 func (*RuntimeCloseStartEvent) ProtoMessage() {}
 
 func (x *RuntimeCloseStartEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_events_builtin_v1_builtin_proto_msgTypes[14]
+	mi := &file_events_builtin_v1_builtin_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -930,7 +1076,7 @@ func (x *RuntimeCloseStartEvent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RuntimeCloseStartEvent.ProtoReflect.Descriptor instead.
 func (*RuntimeCloseStartEvent) Descriptor() ([]byte, []int) {
-	return file_events_builtin_v1_builtin_proto_rawDescGZIP(), []int{14}
+	return file_events_builtin_v1_builtin_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *RuntimeCloseStartEvent) GetRuntimeId() string {
@@ -949,7 +1095,7 @@ type RuntimeCloseResult struct {
 func (x *RuntimeCloseResult) Reset() {
 	*x = RuntimeCloseResult{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_events_builtin_v1_builtin_proto_msgTypes[15]
+		mi := &file_events_builtin_v1_builtin_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -962,7 +1108,7 @@ func (x *RuntimeCloseResult) String() string {
 func (*RuntimeCloseResult) ProtoMessage() {}
 
 func (x *RuntimeCloseResult) ProtoReflect() protoreflect.Message {
-	mi := &file_events_builtin_v1_builtin_proto_msgTypes[15]
+	mi := &file_events_builtin_v1_builtin_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -975,7 +1121,7 @@ func (x *RuntimeCloseResult) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RuntimeCloseResult.ProtoReflect.Descriptor instead.
 func (*RuntimeCloseResult) Descriptor() ([]byte, []int) {
-	return file_events_builtin_v1_builtin_proto_rawDescGZIP(), []int{15}
+	return file_events_builtin_v1_builtin_proto_rawDescGZIP(), []int{17}
 }
 
 type RuntimeCloseEndEvent struct {
@@ -994,7 +1140,7 @@ type RuntimeCloseEndEvent struct {
 func (x *RuntimeCloseEndEvent) Reset() {
 	*x = RuntimeCloseEndEvent{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_events_builtin_v1_builtin_proto_msgTypes[16]
+		mi := &file_events_builtin_v1_builtin_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1007,7 +1153,7 @@ func (x *RuntimeCloseEndEvent) String() string {
 func (*RuntimeCloseEndEvent) ProtoMessage() {}
 
 func (x *RuntimeCloseEndEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_events_builtin_v1_builtin_proto_msgTypes[16]
+	mi := &file_events_builtin_v1_builtin_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1020,7 +1166,7 @@ func (x *RuntimeCloseEndEvent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RuntimeCloseEndEvent.ProtoReflect.Descriptor instead.
 func (*RuntimeCloseEndEvent) Descriptor() ([]byte, []int) {
-	return file_events_builtin_v1_builtin_proto_rawDescGZIP(), []int{16}
+	return file_events_builtin_v1_builtin_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *RuntimeCloseEndEvent) GetRuntimeId() string {
@@ -1079,7 +1225,7 @@ type StdoutEvent struct {
 func (x *StdoutEvent) Reset() {
 	*x = StdoutEvent{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_events_builtin_v1_builtin_proto_msgTypes[17]
+		mi := &file_events_builtin_v1_builtin_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1092,7 +1238,7 @@ func (x *StdoutEvent) String() string {
 func (*StdoutEvent) ProtoMessage() {}
 
 func (x *StdoutEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_events_builtin_v1_builtin_proto_msgTypes[17]
+	mi := &file_events_builtin_v1_builtin_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1105,7 +1251,7 @@ func (x *StdoutEvent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StdoutEvent.ProtoReflect.Descriptor instead.
 func (*StdoutEvent) Descriptor() ([]byte, []int) {
-	return file_events_builtin_v1_builtin_proto_rawDescGZIP(), []int{17}
+	return file_events_builtin_v1_builtin_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *StdoutEvent) GetData() []byte {
@@ -1134,7 +1280,7 @@ type StderrEvent struct {
 func (x *StderrEvent) Reset() {
 	*x = StderrEvent{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_events_builtin_v1_builtin_proto_msgTypes[18]
+		mi := &file_events_builtin_v1_builtin_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1147,7 +1293,7 @@ func (x *StderrEvent) String() string {
 func (*StderrEvent) ProtoMessage() {}
 
 func (x *StderrEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_events_builtin_v1_builtin_proto_msgTypes[18]
+	mi := &file_events_builtin_v1_builtin_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1160,7 +1306,7 @@ func (x *StderrEvent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StderrEvent.ProtoReflect.Descriptor instead.
 func (*StderrEvent) Descriptor() ([]byte, []int) {
-	return file_events_builtin_v1_builtin_proto_rawDescGZIP(), []int{18}
+	return file_events_builtin_v1_builtin_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *StderrEvent) GetData() []byte {
@@ -1193,7 +1339,7 @@ type LogEventSource struct {
 func (x *LogEventSource) Reset() {
 	*x = LogEventSource{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_events_builtin_v1_builtin_proto_msgTypes[19]
+		mi := &file_events_builtin_v1_builtin_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1206,7 +1352,7 @@ func (x *LogEventSource) String() string {
 func (*LogEventSource) ProtoMessage() {}
 
 func (x *LogEventSource) ProtoReflect() protoreflect.Message {
-	mi := &file_events_builtin_v1_builtin_proto_msgTypes[19]
+	mi := &file_events_builtin_v1_builtin_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1219,7 +1365,7 @@ func (x *LogEventSource) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LogEventSource.ProtoReflect.Descriptor instead.
 func (*LogEventSource) Descriptor() ([]byte, []int) {
-	return file_events_builtin_v1_builtin_proto_rawDescGZIP(), []int{19}
+	return file_events_builtin_v1_builtin_proto_rawDescGZIP(), []int{21}
 }
 
 func (m *LogEventSource) GetSource() isLogEventSource_Source {
@@ -1283,7 +1429,7 @@ type LogSourceRuntime struct {
 func (x *LogSourceRuntime) Reset() {
 	*x = LogSourceRuntime{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_events_builtin_v1_builtin_proto_msgTypes[20]
+		mi := &file_events_builtin_v1_builtin_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1296,7 +1442,7 @@ func (x *LogSourceRuntime) String() string {
 func (*LogSourceRuntime) ProtoMessage() {}
 
 func (x *LogSourceRuntime) ProtoReflect() protoreflect.Message {
-	mi := &file_events_builtin_v1_builtin_proto_msgTypes[20]
+	mi := &file_events_builtin_v1_builtin_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1309,7 +1455,7 @@ func (x *LogSourceRuntime) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LogSourceRuntime.ProtoReflect.Descriptor instead.
 func (*LogSourceRuntime) Descriptor() ([]byte, []int) {
-	return file_events_builtin_v1_builtin_proto_rawDescGZIP(), []int{20}
+	return file_events_builtin_v1_builtin_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *LogSourceRuntime) GetRuntimeId() string {
@@ -1333,7 +1479,7 @@ type LogSourceExec struct {
 func (x *LogSourceExec) Reset() {
 	*x = LogSourceExec{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_events_builtin_v1_builtin_proto_msgTypes[21]
+		mi := &file_events_builtin_v1_builtin_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1346,7 +1492,7 @@ func (x *LogSourceExec) String() string {
 func (*LogSourceExec) ProtoMessage() {}
 
 func (x *LogSourceExec) ProtoReflect() protoreflect.Message {
-	mi := &file_events_builtin_v1_builtin_proto_msgTypes[21]
+	mi := &file_events_builtin_v1_builtin_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1359,7 +1505,7 @@ func (x *LogSourceExec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LogSourceExec.ProtoReflect.Descriptor instead.
 func (*LogSourceExec) Descriptor() ([]byte, []int) {
-	return file_events_builtin_v1_builtin_proto_rawDescGZIP(), []int{21}
+	return file_events_builtin_v1_builtin_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *LogSourceExec) GetRuntimeId() string {
@@ -1392,7 +1538,7 @@ type LogSourceDirector struct {
 func (x *LogSourceDirector) Reset() {
 	*x = LogSourceDirector{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_events_builtin_v1_builtin_proto_msgTypes[22]
+		mi := &file_events_builtin_v1_builtin_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1405,7 +1551,7 @@ func (x *LogSourceDirector) String() string {
 func (*LogSourceDirector) ProtoMessage() {}
 
 func (x *LogSourceDirector) ProtoReflect() protoreflect.Message {
-	mi := &file_events_builtin_v1_builtin_proto_msgTypes[22]
+	mi := &file_events_builtin_v1_builtin_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1418,7 +1564,7 @@ func (x *LogSourceDirector) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LogSourceDirector.ProtoReflect.Descriptor instead.
 func (*LogSourceDirector) Descriptor() ([]byte, []int) {
-	return file_events_builtin_v1_builtin_proto_rawDescGZIP(), []int{22}
+	return file_events_builtin_v1_builtin_proto_rawDescGZIP(), []int{24}
 }
 
 type ExecStartEvent struct {
@@ -1434,7 +1580,7 @@ type ExecStartEvent struct {
 func (x *ExecStartEvent) Reset() {
 	*x = ExecStartEvent{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_events_builtin_v1_builtin_proto_msgTypes[23]
+		mi := &file_events_builtin_v1_builtin_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1447,7 +1593,7 @@ func (x *ExecStartEvent) String() string {
 func (*ExecStartEvent) ProtoMessage() {}
 
 func (x *ExecStartEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_events_builtin_v1_builtin_proto_msgTypes[23]
+	mi := &file_events_builtin_v1_builtin_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1460,7 +1606,7 @@ func (x *ExecStartEvent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ExecStartEvent.ProtoReflect.Descriptor instead.
 func (*ExecStartEvent) Descriptor() ([]byte, []int) {
-	return file_events_builtin_v1_builtin_proto_rawDescGZIP(), []int{23}
+	return file_events_builtin_v1_builtin_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *ExecStartEvent) GetRuntimeId() string {
@@ -1490,12 +1636,15 @@ type ExecResult struct {
 	unknownFields protoimpl.UnknownFields
 
 	ExitCode int32 `protobuf:"varint,4,opt,name=exit_code,json=exitCode,proto3" json:"exit_code,omitempty"`
+	// TimedOut indicates the command was terminated because it exceeded its timeout.
+	TimedOut bool              `protobuf:"varint,5,opt,name=timed_out,json=timedOut,proto3" json:"timed_out,omitempty"`
+	Usage    *v1.ResourceUsage `protobuf:"bytes,6,opt,name=usage,proto3" json:"usage,omitempty"`
 }
 
 func (x *ExecResult) Reset() {
 	*x = ExecResult{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_events_builtin_v1_builtin_proto_msgTypes[24]
+		mi := &file_events_builtin_v1_builtin_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1508,7 +1657,7 @@ func (x *ExecResult) String() string {
 func (*ExecResult) ProtoMessage() {}
 
 func (x *ExecResult) ProtoReflect() protoreflect.Message {
-	mi := &file_events_builtin_v1_builtin_proto_msgTypes[24]
+	mi := &file_events_builtin_v1_builtin_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1521,7 +1670,7 @@ func (x *ExecResult) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ExecResult.ProtoReflect.Descriptor instead.
 func (*ExecResult) Descriptor() ([]byte, []int) {
-	return file_events_builtin_v1_builtin_proto_rawDescGZIP(), []int{24}
+	return file_events_builtin_v1_builtin_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *ExecResult) GetExitCode() int32 {
@@ -1531,6 +1680,60 @@ func (x *ExecResult) GetExitCode() int32 {
 	return 0
 }
 
+func (x *ExecResult) GetTimedOut() bool {
+	if x != nil {
+		return x.TimedOut
+	}
+	return false
+}
+
+func (x *ExecResult) GetUsage() *v1.ResourceUsage {
+	if x != nil {
+		return x.Usage
+	}
+	return nil
+}
+
+// ExecCancelled indicates the exec was cancelled by the client before it completed.
+// The exec's processes are terminated when it is cancelled.
+type ExecCancelled struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ExecCancelled) Reset() {
+	*x = ExecCancelled{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_events_builtin_v1_builtin_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExecCancelled) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExecCancelled) ProtoMessage() {}
+
+func (x *ExecCancelled) ProtoReflect() protoreflect.Message {
+	mi := &file_events_builtin_v1_builtin_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExecCancelled.ProtoReflect.Descriptor instead.
+func (*ExecCancelled) Descriptor() ([]byte, []int) {
+	return file_events_builtin_v1_builtin_proto_rawDescGZIP(), []int{27}
+}
+
 type ExecEndEvent struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1542,13 +1745,14 @@ type ExecEndEvent struct {
 	//
 	//	*ExecEndEvent_Error
 	//	*ExecEndEvent_Result
+	//	*ExecEndEvent_Cancelled
 	Status isExecEndEvent_Status `protobuf_oneof:"status"`
 }
 
 func (x *ExecEndEvent) Reset() {
 	*x = ExecEndEvent{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_events_builtin_v1_builtin_proto_msgTypes[25]
+		mi := &file_events_builtin_v1_builtin_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1561,7 +1765,7 @@ func (x *ExecEndEvent) String() string {
 func (*ExecEndEvent) ProtoMessage() {}
 
 func (x *ExecEndEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_events_builtin_v1_builtin_proto_msgTypes[25]
+	mi := &file_events_builtin_v1_builtin_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1574,7 +1778,7 @@ func (x *ExecEndEvent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ExecEndEvent.ProtoReflect.Descriptor instead.
 func (*ExecEndEvent) Descriptor() ([]byte, []int) {
-	return file_events_builtin_v1_builtin_proto_rawDescGZIP(), []int{25}
+	return file_events_builtin_v1_builtin_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *ExecEndEvent) GetRuntimeId() string {
@@ -1612,6 +1816,13 @@ func (x *ExecEndEvent) GetResult() *ExecResult {
 	return nil
 }
 
+func (x *ExecEndEvent) GetCancelled() *ExecCancelled {
+	if x, ok := x.GetStatus().(*ExecEndEvent_Cancelled); ok {
+		return x.Cancelled
+	}
+	return nil
+}
+
 type isExecEndEvent_Status interface {
 	isExecEndEvent_Status()
 }
@@ -1624,10 +1835,16 @@ type ExecEndEvent_Result struct {
 	Result *ExecResult `protobuf:"bytes,4,opt,name=result,proto3,oneof"`
 }
 
+type ExecEndEvent_Cancelled struct {
+	Cancelled *ExecCancelled `protobuf:"bytes,5,opt,name=cancelled,proto3,oneof"`
+}
+
 func (*ExecEndEvent_Error) isExecEndEvent_Status() {}
 
 func (*ExecEndEvent_Result) isExecEndEvent_Status() {}
 
+func (*ExecEndEvent_Cancelled) isExecEndEvent_Status() {}
+
 type ImportStartEvent struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1640,7 +1857,7 @@ type ImportStartEvent struct {
 func (x *ImportStartEvent) Reset() {
 	*x = ImportStartEvent{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_events_builtin_v1_builtin_proto_msgTypes[26]
+		mi := &file_events_builtin_v1_builtin_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1653,7 +1870,7 @@ func (x *ImportStartEvent) String() string {
 func (*ImportStartEvent) ProtoMessage() {}
 
 func (x *ImportStartEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_events_builtin_v1_builtin_proto_msgTypes[26]
+	mi := &file_events_builtin_v1_builtin_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1666,7 +1883,7 @@ func (x *ImportStartEvent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ImportStartEvent.ProtoReflect.Descriptor instead.
 func (*ImportStartEvent) Descriptor() ([]byte, []int) {
-	return file_events_builtin_v1_builtin_proto_rawDescGZIP(), []int{26}
+	return file_events_builtin_v1_builtin_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *ImportStartEvent) GetRuntimeId() string {
@@ -1683,29 +1900,34 @@ func (x *ImportStartEvent) GetImportId() string {
 	return ""
 }
 
-type ImportResult struct {
+// ImportProgressEvent reports the progress of an import. Published periodically while files are transferred.
+type ImportProgressEvent struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	RuntimeId string            `protobuf:"bytes,1,opt,name=runtime_id,json=runtimeId,proto3" json:"runtime_id,omitempty"`
+	ImportId  string            `protobuf:"bytes,2,opt,name=import_id,json=importId,proto3" json:"import_id,omitempty"`
+	Progress  *TransferProgress `protobuf:"bytes,3,opt,name=progress,proto3" json:"progress,omitempty"`
 }
 
-func (x *ImportResult) Reset() {
-	*x = ImportResult{}
+func (x *ImportProgressEvent) Reset() {
+	*x = ImportProgressEvent{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_events_builtin_v1_builtin_proto_msgTypes[27]
+		mi := &file_events_builtin_v1_builtin_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ImportResult) String() string {
+func (x *ImportProgressEvent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ImportResult) ProtoMessage() {}
+func (*ImportProgressEvent) ProtoMessage() {}
 
-func (x *ImportResult) ProtoReflect() protoreflect.Message {
-	mi := &file_events_builtin_v1_builtin_proto_msgTypes[27]
+func (x *ImportProgressEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_events_builtin_v1_builtin_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1716,19 +1938,204 @@ func (x *ImportResult) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ImportResult.ProtoReflect.Descriptor instead.
-func (*ImportResult) Descriptor() ([]byte, []int) {
-	return file_events_builtin_v1_builtin_proto_rawDescGZIP(), []int{27}
+// Deprecated: Use ImportProgressEvent.ProtoReflect.Descriptor instead.
+func (*ImportProgressEvent) Descriptor() ([]byte, []int) {
+	return file_events_builtin_v1_builtin_proto_rawDescGZIP(), []int{30}
 }
 
-type ImportEndEvent struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *ImportProgressEvent) GetRuntimeId() string {
+	if x != nil {
+		return x.RuntimeId
+	}
+	return ""
+}
 
-	RuntimeId string `protobuf:"bytes,1,opt,name=runtime_id,json=runtimeId,proto3" json:"runtime_id,omitempty"`
-	ImportId  string `protobuf:"bytes,2,opt,name=import_id,json=importId,proto3" json:"import_id,omitempty"`
-	// Types that are assignable to Status:
+func (x *ImportProgressEvent) GetImportId() string {
+	if x != nil {
+		return x.ImportId
+	}
+	return ""
+}
+
+func (x *ImportProgressEvent) GetProgress() *TransferProgress {
+	if x != nil {
+		return x.Progress
+	}
+	return nil
+}
+
+// TransferProgress is the progress of an import or export. Files that the runtime already held are counted
+// as transferred once they are skipped.
+type TransferProgress struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FilesDone uint32 `protobuf:"varint,1,opt,name=files_done,json=filesDone,proto3" json:"files_done,omitempty"`
+	BytesDone uint64 `protobuf:"varint,2,opt,name=bytes_done,json=bytesDone,proto3" json:"bytes_done,omitempty"`
+	// FilesTotal and BytesTotal are zero if the totals are unknown (e.g. the executor predates progress reporting).
+	FilesTotal uint32 `protobuf:"varint,3,opt,name=files_total,json=filesTotal,proto3" json:"files_total,omitempty"`
+	BytesTotal uint64 `protobuf:"varint,4,opt,name=bytes_total,json=bytesTotal,proto3" json:"bytes_total,omitempty"`
+	// Elapsed is the time since the transfer started.
+	Elapsed *durationpb.Duration `protobuf:"bytes,5,opt,name=elapsed,proto3" json:"elapsed,omitempty"`
+}
+
+func (x *TransferProgress) Reset() {
+	*x = TransferProgress{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_events_builtin_v1_builtin_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TransferProgress) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferProgress) ProtoMessage() {}
+
+func (x *TransferProgress) ProtoReflect() protoreflect.Message {
+	mi := &file_events_builtin_v1_builtin_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferProgress.ProtoReflect.Descriptor instead.
+func (*TransferProgress) Descriptor() ([]byte, []int) {
+	return file_events_builtin_v1_builtin_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *TransferProgress) GetFilesDone() uint32 {
+	if x != nil {
+		return x.FilesDone
+	}
+	return 0
+}
+
+func (x *TransferProgress) GetBytesDone() uint64 {
+	if x != nil {
+		return x.BytesDone
+	}
+	return 0
+}
+
+func (x *TransferProgress) GetFilesTotal() uint32 {
+	if x != nil {
+		return x.FilesTotal
+	}
+	return 0
+}
+
+func (x *TransferProgress) GetBytesTotal() uint64 {
+	if x != nil {
+		return x.BytesTotal
+	}
+	return 0
+}
+
+func (x *TransferProgress) GetElapsed() *durationpb.Duration {
+	if x != nil {
+		return x.Elapsed
+	}
+	return nil
+}
+
+type ImportResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// SkippedFiles is the number of files that were not sent because the runtime already held identical content.
+	SkippedFiles uint32 `protobuf:"varint,1,opt,name=skipped_files,json=skippedFiles,proto3" json:"skipped_files,omitempty"`
+	SkippedBytes uint64 `protobuf:"varint,2,opt,name=skipped_bytes,json=skippedBytes,proto3" json:"skipped_bytes,omitempty"`
+	DeletedFiles uint32 `protobuf:"varint,3,opt,name=deleted_files,json=deletedFiles,proto3" json:"deleted_files,omitempty"`
+	// CachedFiles is the number of files that were not sent because the executor held their content in its blob cache.
+	CachedFiles uint32 `protobuf:"varint,4,opt,name=cached_files,json=cachedFiles,proto3" json:"cached_files,omitempty"`
+	CachedBytes uint64 `protobuf:"varint,5,opt,name=cached_bytes,json=cachedBytes,proto3" json:"cached_bytes,omitempty"`
+}
+
+func (x *ImportResult) Reset() {
+	*x = ImportResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_events_builtin_v1_builtin_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportResult) ProtoMessage() {}
+
+func (x *ImportResult) ProtoReflect() protoreflect.Message {
+	mi := &file_events_builtin_v1_builtin_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportResult.ProtoReflect.Descriptor instead.
+func (*ImportResult) Descriptor() ([]byte, []int) {
+	return file_events_builtin_v1_builtin_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ImportResult) GetSkippedFiles() uint32 {
+	if x != nil {
+		return x.SkippedFiles
+	}
+	return 0
+}
+
+func (x *ImportResult) GetSkippedBytes() uint64 {
+	if x != nil {
+		return x.SkippedBytes
+	}
+	return 0
+}
+
+func (x *ImportResult) GetDeletedFiles() uint32 {
+	if x != nil {
+		return x.DeletedFiles
+	}
+	return 0
+}
+
+func (x *ImportResult) GetCachedFiles() uint32 {
+	if x != nil {
+		return x.CachedFiles
+	}
+	return 0
+}
+
+func (x *ImportResult) GetCachedBytes() uint64 {
+	if x != nil {
+		return x.CachedBytes
+	}
+	return 0
+}
+
+type ImportEndEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RuntimeId string `protobuf:"bytes,1,opt,name=runtime_id,json=runtimeId,proto3" json:"runtime_id,omitempty"`
+	ImportId  string `protobuf:"bytes,2,opt,name=import_id,json=importId,proto3" json:"import_id,omitempty"`
+	// Types that are assignable to Status:
 	//
 	//	*ImportEndEvent_Error
 	//	*ImportEndEvent_Result
@@ -1738,7 +2145,7 @@ type ImportEndEvent struct {
 func (x *ImportEndEvent) Reset() {
 	*x = ImportEndEvent{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_events_builtin_v1_builtin_proto_msgTypes[28]
+		mi := &file_events_builtin_v1_builtin_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1751,7 +2158,7 @@ func (x *ImportEndEvent) String() string {
 func (*ImportEndEvent) ProtoMessage() {}
 
 func (x *ImportEndEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_events_builtin_v1_builtin_proto_msgTypes[28]
+	mi := &file_events_builtin_v1_builtin_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1764,7 +2171,7 @@ func (x *ImportEndEvent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ImportEndEvent.ProtoReflect.Descriptor instead.
 func (*ImportEndEvent) Descriptor() ([]byte, []int) {
-	return file_events_builtin_v1_builtin_proto_rawDescGZIP(), []int{28}
+	return file_events_builtin_v1_builtin_proto_rawDescGZIP(), []int{33}
 }
 
 func (x *ImportEndEvent) GetRuntimeId() string {
@@ -1830,7 +2237,7 @@ type ExportStartEvent struct {
 func (x *ExportStartEvent) Reset() {
 	*x = ExportStartEvent{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_events_builtin_v1_builtin_proto_msgTypes[29]
+		mi := &file_events_builtin_v1_builtin_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1843,7 +2250,7 @@ func (x *ExportStartEvent) String() string {
 func (*ExportStartEvent) ProtoMessage() {}
 
 func (x *ExportStartEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_events_builtin_v1_builtin_proto_msgTypes[29]
+	mi := &file_events_builtin_v1_builtin_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1856,7 +2263,7 @@ func (x *ExportStartEvent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ExportStartEvent.ProtoReflect.Descriptor instead.
 func (*ExportStartEvent) Descriptor() ([]byte, []int) {
-	return file_events_builtin_v1_builtin_proto_rawDescGZIP(), []int{29}
+	return file_events_builtin_v1_builtin_proto_rawDescGZIP(), []int{34}
 }
 
 func (x *ExportStartEvent) GetRuntimeId() string {
@@ -1873,6 +2280,70 @@ func (x *ExportStartEvent) GetExportId() string {
 	return ""
 }
 
+// ExportProgressEvent reports the progress of an export. Published periodically while files are transferred.
+type ExportProgressEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RuntimeId string            `protobuf:"bytes,1,opt,name=runtime_id,json=runtimeId,proto3" json:"runtime_id,omitempty"`
+	ExportId  string            `protobuf:"bytes,2,opt,name=export_id,json=exportId,proto3" json:"export_id,omitempty"`
+	Progress  *TransferProgress `protobuf:"bytes,3,opt,name=progress,proto3" json:"progress,omitempty"`
+}
+
+func (x *ExportProgressEvent) Reset() {
+	*x = ExportProgressEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_events_builtin_v1_builtin_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportProgressEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportProgressEvent) ProtoMessage() {}
+
+func (x *ExportProgressEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_events_builtin_v1_builtin_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportProgressEvent.ProtoReflect.Descriptor instead.
+func (*ExportProgressEvent) Descriptor() ([]byte, []int) {
+	return file_events_builtin_v1_builtin_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ExportProgressEvent) GetRuntimeId() string {
+	if x != nil {
+		return x.RuntimeId
+	}
+	return ""
+}
+
+func (x *ExportProgressEvent) GetExportId() string {
+	if x != nil {
+		return x.ExportId
+	}
+	return ""
+}
+
+func (x *ExportProgressEvent) GetProgress() *TransferProgress {
+	if x != nil {
+		return x.Progress
+	}
+	return nil
+}
+
 type ExportResult struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1882,7 +2353,7 @@ type ExportResult struct {
 func (x *ExportResult) Reset() {
 	*x = ExportResult{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_events_builtin_v1_builtin_proto_msgTypes[30]
+		mi := &file_events_builtin_v1_builtin_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1895,7 +2366,7 @@ func (x *ExportResult) String() string {
 func (*ExportResult) ProtoMessage() {}
 
 func (x *ExportResult) ProtoReflect() protoreflect.Message {
-	mi := &file_events_builtin_v1_builtin_proto_msgTypes[30]
+	mi := &file_events_builtin_v1_builtin_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1908,7 +2379,7 @@ func (x *ExportResult) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ExportResult.ProtoReflect.Descriptor instead.
 func (*ExportResult) Descriptor() ([]byte, []int) {
-	return file_events_builtin_v1_builtin_proto_rawDescGZIP(), []int{30}
+	return file_events_builtin_v1_builtin_proto_rawDescGZIP(), []int{36}
 }
 
 type ExportEndEvent struct {
@@ -1928,7 +2399,7 @@ type ExportEndEvent struct {
 func (x *ExportEndEvent) Reset() {
 	*x = ExportEndEvent{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_events_builtin_v1_builtin_proto_msgTypes[31]
+		mi := &file_events_builtin_v1_builtin_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1941,7 +2412,7 @@ func (x *ExportEndEvent) String() string {
 func (*ExportEndEvent) ProtoMessage() {}
 
 func (x *ExportEndEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_events_builtin_v1_builtin_proto_msgTypes[31]
+	mi := &file_events_builtin_v1_builtin_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1954,7 +2425,7 @@ func (x *ExportEndEvent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ExportEndEvent.ProtoReflect.Descriptor instead.
 func (*ExportEndEvent) Descriptor() ([]byte, []int) {
-	return file_events_builtin_v1_builtin_proto_rawDescGZIP(), []int{31}
+	return file_events_builtin_v1_builtin_proto_rawDescGZIP(), []int{37}
 }
 
 func (x *ExportEndEvent) GetRuntimeId() string {
@@ -2019,7 +2490,7 @@ type SyncPointReachedEvent struct {
 func (x *SyncPointReachedEvent) Reset() {
 	*x = SyncPointReachedEvent{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_events_builtin_v1_builtin_proto_msgTypes[32]
+		mi := &file_events_builtin_v1_builtin_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2032,7 +2503,7 @@ func (x *SyncPointReachedEvent) String() string {
 func (*SyncPointReachedEvent) ProtoMessage() {}
 
 func (x *SyncPointReachedEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_events_builtin_v1_builtin_proto_msgTypes[32]
+	mi := &file_events_builtin_v1_builtin_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2045,7 +2516,7 @@ func (x *SyncPointReachedEvent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SyncPointReachedEvent.ProtoReflect.Descriptor instead.
 func (*SyncPointReachedEvent) Descriptor() ([]byte, []int) {
-	return file_events_builtin_v1_builtin_proto_rawDescGZIP(), []int{32}
+	return file_events_builtin_v1_builtin_proto_rawDescGZIP(), []int{38}
 }
 
 func (x *SyncPointReachedEvent) GetBarrierId() string {
@@ -2055,6 +2526,167 @@ func (x *SyncPointReachedEvent) GetBarrierId() string {
 	return ""
 }
 
+// BuildPausedEvent indicates the build has paused at a breakpoint so that a runtime can be inspected.
+// The build remains paused until it is resumed or aborted, or the pause times out.
+type BuildPausedEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BuildId   string `protobuf:"bytes,1,opt,name=build_id,json=buildId,proto3" json:"build_id,omitempty"`
+	PauseId   string `protobuf:"bytes,2,opt,name=pause_id,json=pauseId,proto3" json:"pause_id,omitempty"`
+	RuntimeId string `protobuf:"bytes,3,opt,name=runtime_id,json=runtimeId,proto3" json:"runtime_id,omitempty"`
+	Reason    string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	// Instructions explain how to attach to the runtime, and resume or abort the build.
+	Instructions string               `protobuf:"bytes,5,opt,name=instructions,proto3" json:"instructions,omitempty"`
+	Timeout      *durationpb.Duration `protobuf:"bytes,6,opt,name=timeout,proto3" json:"timeout,omitempty"`
+}
+
+func (x *BuildPausedEvent) Reset() {
+	*x = BuildPausedEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_events_builtin_v1_builtin_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BuildPausedEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BuildPausedEvent) ProtoMessage() {}
+
+func (x *BuildPausedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_events_builtin_v1_builtin_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BuildPausedEvent.ProtoReflect.Descriptor instead.
+func (*BuildPausedEvent) Descriptor() ([]byte, []int) {
+	return file_events_builtin_v1_builtin_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *BuildPausedEvent) GetBuildId() string {
+	if x != nil {
+		return x.BuildId
+	}
+	return ""
+}
+
+func (x *BuildPausedEvent) GetPauseId() string {
+	if x != nil {
+		return x.PauseId
+	}
+	return ""
+}
+
+func (x *BuildPausedEvent) GetRuntimeId() string {
+	if x != nil {
+		return x.RuntimeId
+	}
+	return ""
+}
+
+func (x *BuildPausedEvent) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *BuildPausedEvent) GetInstructions() string {
+	if x != nil {
+		return x.Instructions
+	}
+	return ""
+}
+
+func (x *BuildPausedEvent) GetTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.Timeout
+	}
+	return nil
+}
+
+type BuildResumedEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BuildId  string `protobuf:"bytes,1,opt,name=build_id,json=buildId,proto3" json:"build_id,omitempty"`
+	PauseId  string `protobuf:"bytes,2,opt,name=pause_id,json=pauseId,proto3" json:"pause_id,omitempty"`
+	Aborted  bool   `protobuf:"varint,3,opt,name=aborted,proto3" json:"aborted,omitempty"`
+	TimedOut bool   `protobuf:"varint,4,opt,name=timed_out,json=timedOut,proto3" json:"timed_out,omitempty"`
+}
+
+func (x *BuildResumedEvent) Reset() {
+	*x = BuildResumedEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_events_builtin_v1_builtin_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BuildResumedEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BuildResumedEvent) ProtoMessage() {}
+
+func (x *BuildResumedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_events_builtin_v1_builtin_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BuildResumedEvent.ProtoReflect.Descriptor instead.
+func (*BuildResumedEvent) Descriptor() ([]byte, []int) {
+	return file_events_builtin_v1_builtin_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *BuildResumedEvent) GetBuildId() string {
+	if x != nil {
+		return x.BuildId
+	}
+	return ""
+}
+
+func (x *BuildResumedEvent) GetPauseId() string {
+	if x != nil {
+		return x.PauseId
+	}
+	return ""
+}
+
+func (x *BuildResumedEvent) GetAborted() bool {
+	if x != nil {
+		return x.Aborted
+	}
+	return false
+}
+
+func (x *BuildResumedEvent) GetTimedOut() bool {
+	if x != nil {
+		return x.TimedOut
+	}
+	return false
+}
+
 var File_events_builtin_v1_builtin_proto protoreflect.FileDescriptor
 
 var file_events_builtin_v1_builtin_proto_rawDesc = []byte{
@@ -2064,7 +2696,9 @@ var file_events_builtin_v1_builtin_proto_rawDesc = []byte{
 	0x73, 0x2e, 0x6b, 0x6e, 0x69, 0x74, 0x61, 0x2e, 0x69, 0x6f, 0x1a, 0x1a, 0x65, 0x78, 0x65, 0x63,
 	0x75, 0x74, 0x6f, 0x72, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x6f, 0x72,
 	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x16, 0x62, 0x72, 0x6f, 0x6b, 0x65, 0x72, 0x2f, 0x76,
-	0x31, 0x2f, 0x62, 0x72, 0x6f, 0x6b, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x21,
+	0x31, 0x2f, 0x62, 0x72, 0x6f, 0x6b, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f,
+	0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x21,
 	0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
 	0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
 	0x65, 0x22, 0x62, 0x0a, 0x0c, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x49, 0x6e, 0x66,
@@ -2151,20 +2785,40 @@ var file_events_builtin_v1_builtin_proto_rawDesc = []byte{
 	0x69, 0x6d, 0x65, 0x49, 0x64, 0x12, 0x32, 0x0a, 0x04, 0x6f, 0x70, 0x74, 0x73, 0x18, 0x02, 0x20,
 	0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x6f, 0x72, 0x2e, 0x6b,
 	0x6e, 0x69, 0x74, 0x61, 0x2e, 0x69, 0x6f, 0x2e, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x4f,
-	0x70, 0x74, 0x73, 0x52, 0x04, 0x6f, 0x70, 0x74, 0x73, 0x22, 0x13, 0x0a, 0x11, 0x52, 0x75, 0x6e,
-	0x74, 0x69, 0x6d, 0x65, 0x4f, 0x70, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0xbc,
-	0x01, 0x0a, 0x13, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x4f, 0x70, 0x65, 0x6e, 0x45, 0x6e,
-	0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d,
-	0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x75, 0x6e, 0x74,
-	0x69, 0x6d, 0x65, 0x49, 0x64, 0x12, 0x36, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x62, 0x75, 0x69, 0x6c, 0x74, 0x69, 0x6e, 0x2e, 0x65,
-	0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x6b, 0x6e, 0x69, 0x74, 0x61, 0x2e, 0x69, 0x6f, 0x2e, 0x45,
-	0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x44, 0x0a,
-	0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e,
-	0x62, 0x75, 0x69, 0x6c, 0x74, 0x69, 0x6e, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x6b,
-	0x6e, 0x69, 0x74, 0x61, 0x2e, 0x69, 0x6f, 0x2e, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x4f,
-	0x70, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x48, 0x00, 0x52, 0x06, 0x72, 0x65, 0x73,
-	0x75, 0x6c, 0x74, 0x42, 0x08, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x37, 0x0a,
+	0x70, 0x74, 0x73, 0x52, 0x04, 0x6f, 0x70, 0x74, 0x73, 0x22, 0x36, 0x0a, 0x11, 0x52, 0x75, 0x6e,
+	0x74, 0x69, 0x6d, 0x65, 0x4f, 0x70, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x21,
+	0x0a, 0x0c, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x44, 0x69, 0x67, 0x65, 0x73,
+	0x74, 0x22, 0xbc, 0x01, 0x0a, 0x13, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x4f, 0x70, 0x65,
+	0x6e, 0x45, 0x6e, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x75, 0x6e,
+	0x74, 0x69, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72,
+	0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x49, 0x64, 0x12, 0x36, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x62, 0x75, 0x69, 0x6c, 0x74, 0x69,
+	0x6e, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x6b, 0x6e, 0x69, 0x74, 0x61, 0x2e, 0x69,
+	0x6f, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x12, 0x44, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x2a, 0x2e, 0x62, 0x75, 0x69, 0x6c, 0x74, 0x69, 0x6e, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74,
+	0x73, 0x2e, 0x6b, 0x6e, 0x69, 0x74, 0x61, 0x2e, 0x69, 0x6f, 0x2e, 0x52, 0x75, 0x6e, 0x74, 0x69,
+	0x6d, 0x65, 0x4f, 0x70, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x48, 0x00, 0x52, 0x06,
+	0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x42, 0x08, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x22, 0x9d, 0x01, 0x0a, 0x16, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x50, 0x75, 0x6c, 0x6c, 0x50, 0x72,
+	0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x72,
+	0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x6d,
+	0x61, 0x67, 0x65, 0x5f, 0x75, 0x72, 0x69, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x69,
+	0x6d, 0x61, 0x67, 0x65, 0x55, 0x72, 0x69, 0x12, 0x47, 0x0a, 0x06, 0x6c, 0x61, 0x79, 0x65, 0x72,
+	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x62, 0x75, 0x69, 0x6c, 0x74, 0x69,
+	0x6e, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x6b, 0x6e, 0x69, 0x74, 0x61, 0x2e, 0x69,
+	0x6f, 0x2e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x50, 0x75, 0x6c, 0x6c, 0x4c, 0x61, 0x79, 0x65, 0x72,
+	0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x52, 0x06, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73,
+	0x22, 0x7b, 0x0a, 0x16, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x50, 0x75, 0x6c, 0x6c, 0x4c, 0x61, 0x79,
+	0x65, 0x72, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x6c, 0x61,
+	0x79, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6c, 0x61,
+	0x79, 0x65, 0x72, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x18, 0x0a,
+	0x07, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07,
+	0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x22, 0x37, 0x0a,
 	0x16, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x53, 0x74, 0x61,
 	0x72, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x75, 0x6e, 0x74, 0x69,
 	0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x75, 0x6e,
@@ -2227,68 +2881,144 @@ var file_events_builtin_v1_builtin_proto_rawDesc = []byte{
 	0x65, 0x63, 0x49, 0x64, 0x12, 0x2f, 0x0a, 0x04, 0x6f, 0x70, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01,
 	0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x6f, 0x72, 0x2e, 0x6b, 0x6e,
 	0x69, 0x74, 0x61, 0x2e, 0x69, 0x6f, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x4f, 0x70, 0x74, 0x73, 0x52,
-	0x04, 0x6f, 0x70, 0x74, 0x73, 0x22, 0x29, 0x0a, 0x0a, 0x45, 0x78, 0x65, 0x63, 0x52, 0x65, 0x73,
+	0x04, 0x6f, 0x70, 0x74, 0x73, 0x22, 0x7e, 0x0a, 0x0a, 0x45, 0x78, 0x65, 0x63, 0x52, 0x65, 0x73,
 	0x75, 0x6c, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x65, 0x78, 0x69, 0x74, 0x5f, 0x63, 0x6f, 0x64, 0x65,
 	0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x65, 0x78, 0x69, 0x74, 0x43, 0x6f, 0x64, 0x65,
-	0x22, 0xc7, 0x01, 0x0a, 0x0c, 0x45, 0x78, 0x65, 0x63, 0x45, 0x6e, 0x64, 0x45, 0x76, 0x65, 0x6e,
-	0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x49, 0x64,
-	0x12, 0x17, 0x0a, 0x07, 0x65, 0x78, 0x65, 0x63, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x06, 0x65, 0x78, 0x65, 0x63, 0x49, 0x64, 0x12, 0x36, 0x0a, 0x05, 0x65, 0x72, 0x72,
-	0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x62, 0x75, 0x69, 0x6c, 0x74,
-	0x69, 0x6e, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x6b, 0x6e, 0x69, 0x74, 0x61, 0x2e,
-	0x69, 0x6f, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f,
-	0x72, 0x12, 0x3d, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x23, 0x2e, 0x62, 0x75, 0x69, 0x6c, 0x74, 0x69, 0x6e, 0x2e, 0x65, 0x76, 0x65, 0x6e,
-	0x74, 0x73, 0x2e, 0x6b, 0x6e, 0x69, 0x74, 0x61, 0x2e, 0x69, 0x6f, 0x2e, 0x45, 0x78, 0x65, 0x63,
-	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x48, 0x00, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74,
-	0x42, 0x08, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x4e, 0x0a, 0x10, 0x49, 0x6d,
-	0x70, 0x6f, 0x72, 0x74, 0x53, 0x74, 0x61, 0x72, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x1d,
-	0x0a, 0x0a, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x09, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x49, 0x64, 0x12, 0x1b, 0x0a,
-	0x09, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x08, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x49, 0x64, 0x22, 0x0e, 0x0a, 0x0c, 0x49, 0x6d,
-	0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0xcf, 0x01, 0x0a, 0x0e, 0x49,
-	0x6d, 0x70, 0x6f, 0x72, 0x74, 0x45, 0x6e, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x1d, 0x0a,
-	0x0a, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x09, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09,
-	0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x08, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x49, 0x64, 0x12, 0x36, 0x0a, 0x05, 0x65, 0x72, 0x72,
-	0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x62, 0x75, 0x69, 0x6c, 0x74,
-	0x69, 0x6e, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x6b, 0x6e, 0x69, 0x74, 0x61, 0x2e,
-	0x69, 0x6f, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f,
-	0x72, 0x12, 0x3f, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x25, 0x2e, 0x62, 0x75, 0x69, 0x6c, 0x74, 0x69, 0x6e, 0x2e, 0x65, 0x76, 0x65, 0x6e,
-	0x74, 0x73, 0x2e, 0x6b, 0x6e, 0x69, 0x74, 0x61, 0x2e, 0x69, 0x6f, 0x2e, 0x49, 0x6d, 0x70, 0x6f,
-	0x72, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x48, 0x00, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75,
-	0x6c, 0x74, 0x42, 0x08, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x4e, 0x0a, 0x10,
-	0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x74, 0x61, 0x72, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x12, 0x1b, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x64, 0x5f, 0x6f, 0x75, 0x74, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x64, 0x4f, 0x75, 0x74, 0x12, 0x36, 0x0a,
+	0x05, 0x75, 0x73, 0x61, 0x67, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x65,
+	0x78, 0x65, 0x63, 0x75, 0x74, 0x6f, 0x72, 0x2e, 0x6b, 0x6e, 0x69, 0x74, 0x61, 0x2e, 0x69, 0x6f,
+	0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x05,
+	0x75, 0x73, 0x61, 0x67, 0x65, 0x22, 0x0f, 0x0a, 0x0d, 0x45, 0x78, 0x65, 0x63, 0x43, 0x61, 0x6e,
+	0x63, 0x65, 0x6c, 0x6c, 0x65, 0x64, 0x22, 0x8f, 0x02, 0x0a, 0x0c, 0x45, 0x78, 0x65, 0x63, 0x45,
+	0x6e, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x75, 0x6e, 0x74, 0x69,
+	0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x75, 0x6e,
+	0x74, 0x69, 0x6d, 0x65, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x65, 0x78, 0x65, 0x63, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x65, 0x78, 0x65, 0x63, 0x49, 0x64, 0x12,
+	0x36, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e,
+	0x2e, 0x62, 0x75, 0x69, 0x6c, 0x74, 0x69, 0x6e, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e,
+	0x6b, 0x6e, 0x69, 0x74, 0x61, 0x2e, 0x69, 0x6f, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00,
+	0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x3d, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x62, 0x75, 0x69, 0x6c, 0x74, 0x69,
+	0x6e, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x6b, 0x6e, 0x69, 0x74, 0x61, 0x2e, 0x69,
+	0x6f, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x48, 0x00, 0x52, 0x06,
+	0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x46, 0x0a, 0x09, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c,
+	0x6c, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x62, 0x75, 0x69, 0x6c,
+	0x74, 0x69, 0x6e, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x6b, 0x6e, 0x69, 0x74, 0x61,
+	0x2e, 0x69, 0x6f, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x65,
+	0x64, 0x48, 0x00, 0x52, 0x09, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x65, 0x64, 0x42, 0x08,
+	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x4e, 0x0a, 0x10, 0x49, 0x6d, 0x70, 0x6f,
+	0x72, 0x74, 0x53, 0x74, 0x61, 0x72, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a,
+	0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x69,
+	0x6d, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x49, 0x64, 0x22, 0x98, 0x01, 0x0a, 0x13, 0x49, 0x6d, 0x70,
+	0x6f, 0x72, 0x74, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x45, 0x76, 0x65, 0x6e, 0x74,
 	0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01,
 	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x49, 0x64, 0x12,
-	0x1b, 0x0a, 0x09, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x08, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x49, 0x64, 0x22, 0x0e, 0x0a, 0x0c,
-	0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0xcf, 0x01, 0x0a,
-	0x0e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x45, 0x6e, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12,
-	0x1d, 0x0a, 0x0a, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x49, 0x64, 0x12, 0x1b,
-	0x0a, 0x09, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x08, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x49, 0x64, 0x12, 0x36, 0x0a, 0x05, 0x65,
-	0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x62, 0x75, 0x69,
+	0x1b, 0x0a, 0x09, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x49, 0x64, 0x12, 0x45, 0x0a, 0x08,
+	0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29,
+	0x2e, 0x62, 0x75, 0x69, 0x6c, 0x74, 0x69, 0x6e, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e,
+	0x6b, 0x6e, 0x69, 0x74, 0x61, 0x2e, 0x69, 0x6f, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65,
+	0x72, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x67, 0x72,
+	0x65, 0x73, 0x73, 0x22, 0xc7, 0x01, 0x0a, 0x10, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72,
+	0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x69, 0x6c, 0x65,
+	0x73, 0x5f, 0x64, 0x6f, 0x6e, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x66, 0x69,
+	0x6c, 0x65, 0x73, 0x44, 0x6f, 0x6e, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x79, 0x74, 0x65, 0x73,
+	0x5f, 0x64, 0x6f, 0x6e, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x62, 0x79, 0x74,
+	0x65, 0x73, 0x44, 0x6f, 0x6e, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x5f,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x66, 0x69, 0x6c,
+	0x65, 0x73, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x1f, 0x0a, 0x0b, 0x62, 0x79, 0x74, 0x65, 0x73,
+	0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x62, 0x79,
+	0x74, 0x65, 0x73, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x33, 0x0a, 0x07, 0x65, 0x6c, 0x61, 0x70,
+	0x73, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x65, 0x6c, 0x61, 0x70, 0x73, 0x65, 0x64, 0x22, 0xc3, 0x01,
+	0x0a, 0x0c, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x23,
+	0x0a, 0x0d, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x46, 0x69,
+	0x6c, 0x65, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x5f, 0x62,
+	0x79, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x73, 0x6b, 0x69, 0x70,
+	0x70, 0x65, 0x64, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x64, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x64, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x0c, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x21, 0x0a,
+	0x0c, 0x63, 0x61, 0x63, 0x68, 0x65, 0x64, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x0b, 0x63, 0x61, 0x63, 0x68, 0x65, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x73,
+	0x12, 0x21, 0x0a, 0x0c, 0x63, 0x61, 0x63, 0x68, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x63, 0x61, 0x63, 0x68, 0x65, 0x64, 0x42, 0x79,
+	0x74, 0x65, 0x73, 0x22, 0xcf, 0x01, 0x0a, 0x0e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x45, 0x6e,
+	0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d,
+	0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x75, 0x6e, 0x74,
+	0x69, 0x6d, 0x65, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74,
+	0x49, 0x64, 0x12, 0x36, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1e, 0x2e, 0x62, 0x75, 0x69, 0x6c, 0x74, 0x69, 0x6e, 0x2e, 0x65, 0x76, 0x65, 0x6e,
+	0x74, 0x73, 0x2e, 0x6b, 0x6e, 0x69, 0x74, 0x61, 0x2e, 0x69, 0x6f, 0x2e, 0x45, 0x72, 0x72, 0x6f,
+	0x72, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x3f, 0x0a, 0x06, 0x72, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x62, 0x75, 0x69,
 	0x6c, 0x74, 0x69, 0x6e, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x6b, 0x6e, 0x69, 0x74,
-	0x61, 0x2e, 0x69, 0x6f, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72,
-	0x72, 0x6f, 0x72, 0x12, 0x3f, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x62, 0x75, 0x69, 0x6c, 0x74, 0x69, 0x6e, 0x2e, 0x65, 0x76,
-	0x65, 0x6e, 0x74, 0x73, 0x2e, 0x6b, 0x6e, 0x69, 0x74, 0x61, 0x2e, 0x69, 0x6f, 0x2e, 0x45, 0x78,
-	0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x48, 0x00, 0x52, 0x06, 0x72, 0x65,
-	0x73, 0x75, 0x6c, 0x74, 0x42, 0x08, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x36,
-	0x0a, 0x15, 0x53, 0x79, 0x6e, 0x63, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x61, 0x63, 0x68,
-	0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x61, 0x72, 0x72, 0x69,
-	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x61, 0x72,
-	0x72, 0x69, 0x65, 0x72, 0x49, 0x64, 0x42, 0x31, 0x5a, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
-	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6b, 0x6e, 0x69, 0x74, 0x61, 0x2d, 0x69, 0x6f, 0x2f, 0x6b, 0x6e,
-	0x69, 0x74, 0x61, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x62,
-	0x75, 0x69, 0x6c, 0x74, 0x69, 0x6e, 0x2f, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x33,
+	0x61, 0x2e, 0x69, 0x6f, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x48, 0x00, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x42, 0x08, 0x0a, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x4e, 0x0a, 0x10, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x53,
+	0x74, 0x61, 0x72, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x75, 0x6e,
+	0x74, 0x69, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72,
+	0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x65, 0x78, 0x70, 0x6f,
+	0x72, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x65, 0x78, 0x70,
+	0x6f, 0x72, 0x74, 0x49, 0x64, 0x22, 0x98, 0x01, 0x0a, 0x13, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74,
+	0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x1d, 0x0a,
+	0x0a, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09,
+	0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x49, 0x64, 0x12, 0x45, 0x0a, 0x08, 0x70, 0x72, 0x6f,
+	0x67, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x62, 0x75,
+	0x69, 0x6c, 0x74, 0x69, 0x6e, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x6b, 0x6e, 0x69,
+	0x74, 0x61, 0x2e, 0x69, 0x6f, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x50, 0x72,
+	0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73,
+	0x22, 0x0e, 0x0a, 0x0c, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x22, 0xcf, 0x01, 0x0a, 0x0e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x45, 0x6e, 0x64, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65,
+	0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x49, 0x64, 0x12,
+	0x36, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e,
+	0x2e, 0x62, 0x75, 0x69, 0x6c, 0x74, 0x69, 0x6e, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e,
+	0x6b, 0x6e, 0x69, 0x74, 0x61, 0x2e, 0x69, 0x6f, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00,
+	0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x3f, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x62, 0x75, 0x69, 0x6c, 0x74, 0x69,
+	0x6e, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x6b, 0x6e, 0x69, 0x74, 0x61, 0x2e, 0x69,
+	0x6f, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x48, 0x00,
+	0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x42, 0x08, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x22, 0x36, 0x0a, 0x15, 0x53, 0x79, 0x6e, 0x63, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52,
+	0x65, 0x61, 0x63, 0x68, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x62,
+	0x61, 0x72, 0x72, 0x69, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x62, 0x61, 0x72, 0x72, 0x69, 0x65, 0x72, 0x49, 0x64, 0x22, 0xd8, 0x01, 0x0a, 0x10, 0x42,
+	0x75, 0x69, 0x6c, 0x64, 0x50, 0x61, 0x75, 0x73, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12,
+	0x19, 0x0a, 0x08, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x70, 0x61,
+	0x75, 0x73, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x61,
+	0x75, 0x73, 0x65, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65,
+	0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x75, 0x6e, 0x74, 0x69,
+	0x6d, 0x65, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x22, 0x0a, 0x0c,
+	0x69, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0c, 0x69, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x12, 0x33, 0x0a, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x74, 0x69,
+	0x6d, 0x65, 0x6f, 0x75, 0x74, 0x22, 0x80, 0x01, 0x0a, 0x11, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x52,
+	0x65, 0x73, 0x75, 0x6d, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x62,
+	0x75, 0x69, 0x6c, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x62,
+	0x75, 0x69, 0x6c, 0x64, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x70, 0x61, 0x75, 0x73, 0x65, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x61, 0x75, 0x73, 0x65, 0x49,
+	0x64, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x62, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x07, 0x61, 0x62, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x74,
+	0x69, 0x6d, 0x65, 0x64, 0x5f, 0x6f, 0x75, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08,
+	0x74, 0x69, 0x6d, 0x65, 0x64, 0x4f, 0x75, 0x74, 0x42, 0x31, 0x5a, 0x2f, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6b, 0x6e, 0x69, 0x74, 0x61, 0x2d, 0x69, 0x6f, 0x2f,
+	0x6b, 0x6e, 0x69, 0x74, 0x61, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73,
+	0x2f, 0x62, 0x75, 0x69, 0x6c, 0x74, 0x69, 0x6e, 0x2f, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
 }
 
 var (
@@ -2303,7 +3033,7 @@ func file_events_builtin_v1_builtin_proto_rawDescGZIP() []byte {
 	return file_events_builtin_v1_builtin_proto_rawDescData
 }
 
-var file_events_builtin_v1_builtin_proto_msgTypes = make([]protoimpl.MessageInfo, 33)
+var file_events_builtin_v1_builtin_proto_msgTypes = make([]protoimpl.MessageInfo, 41)
 var file_events_builtin_v1_builtin_proto_goTypes = []interface{}{
 	(*Error)(nil),                       // 0: builtin.events.knita.io.Error
 	(*DirectorInfo)(nil),                // 1: builtin.events.knita.io.DirectorInfo
@@ -2319,63 +3049,80 @@ var file_events_builtin_v1_builtin_proto_goTypes = []interface{}{
 	(*RuntimeOpenStartEvent)(nil),       // 11: builtin.events.knita.io.RuntimeOpenStartEvent
 	(*RuntimeOpenResult)(nil),           // 12: builtin.events.knita.io.RuntimeOpenResult
 	(*RuntimeOpenEndEvent)(nil),         // 13: builtin.events.knita.io.RuntimeOpenEndEvent
-	(*RuntimeCloseStartEvent)(nil),      // 14: builtin.events.knita.io.RuntimeCloseStartEvent
-	(*RuntimeCloseResult)(nil),          // 15: builtin.events.knita.io.RuntimeCloseResult
-	(*RuntimeCloseEndEvent)(nil),        // 16: builtin.events.knita.io.RuntimeCloseEndEvent
-	(*StdoutEvent)(nil),                 // 17: builtin.events.knita.io.StdoutEvent
-	(*StderrEvent)(nil),                 // 18: builtin.events.knita.io.StderrEvent
-	(*LogEventSource)(nil),              // 19: builtin.events.knita.io.LogEventSource
-	(*LogSourceRuntime)(nil),            // 20: builtin.events.knita.io.LogSourceRuntime
-	(*LogSourceExec)(nil),               // 21: builtin.events.knita.io.LogSourceExec
-	(*LogSourceDirector)(nil),           // 22: builtin.events.knita.io.LogSourceDirector
-	(*ExecStartEvent)(nil),              // 23: builtin.events.knita.io.ExecStartEvent
-	(*ExecResult)(nil),                  // 24: builtin.events.knita.io.ExecResult
-	(*ExecEndEvent)(nil),                // 25: builtin.events.knita.io.ExecEndEvent
-	(*ImportStartEvent)(nil),            // 26: builtin.events.knita.io.ImportStartEvent
-	(*ImportResult)(nil),                // 27: builtin.events.knita.io.ImportResult
-	(*ImportEndEvent)(nil),              // 28: builtin.events.knita.io.ImportEndEvent
-	(*ExportStartEvent)(nil),            // 29: builtin.events.knita.io.ExportStartEvent
-	(*ExportResult)(nil),                // 30: builtin.events.knita.io.ExportResult
-	(*ExportEndEvent)(nil),              // 31: builtin.events.knita.io.ExportEndEvent
-	(*SyncPointReachedEvent)(nil),       // 32: builtin.events.knita.io.SyncPointReachedEvent
-	(*v1.SystemInfo)(nil),               // 33: executor.knita.io.SystemInfo
-	(*v1.RuntimeOpts)(nil),              // 34: executor.knita.io.RuntimeOpts
-	(*v11.RuntimeContract)(nil),         // 35: broker.knita.io.RuntimeContract
-	(*v1.ExecOpts)(nil),                 // 36: executor.knita.io.ExecOpts
+	(*ImagePullProgressEvent)(nil),      // 14: builtin.events.knita.io.ImagePullProgressEvent
+	(*ImagePullLayerProgress)(nil),      // 15: builtin.events.knita.io.ImagePullLayerProgress
+	(*RuntimeCloseStartEvent)(nil),      // 16: builtin.events.knita.io.RuntimeCloseStartEvent
+	(*RuntimeCloseResult)(nil),          // 17: builtin.events.knita.io.RuntimeCloseResult
+	(*RuntimeCloseEndEvent)(nil),        // 18: builtin.events.knita.io.RuntimeCloseEndEvent
+	(*StdoutEvent)(nil),                 // 19: builtin.events.knita.io.StdoutEvent
+	(*StderrEvent)(nil),                 // 20: builtin.events.knita.io.StderrEvent
+	(*LogEventSource)(nil),              // 21: builtin.events.knita.io.LogEventSource
+	(*LogSourceRuntime)(nil),            // 22: builtin.events.knita.io.LogSourceRuntime
+	(*LogSourceExec)(nil),               // 23: builtin.events.knita.io.LogSourceExec
+	(*LogSourceDirector)(nil),           // 24: builtin.events.knita.io.LogSourceDirector
+	(*ExecStartEvent)(nil),              // 25: builtin.events.knita.io.ExecStartEvent
+	(*ExecResult)(nil),                  // 26: builtin.events.knita.io.ExecResult
+	(*ExecCancelled)(nil),               // 27: builtin.events.knita.io.ExecCancelled
+	(*ExecEndEvent)(nil),                // 28: builtin.events.knita.io.ExecEndEvent
+	(*ImportStartEvent)(nil),            // 29: builtin.events.knita.io.ImportStartEvent
+	(*ImportProgressEvent)(nil),         // 30: builtin.events.knita.io.ImportProgressEvent
+	(*TransferProgress)(nil),            // 31: builtin.events.knita.io.TransferProgress
+	(*ImportResult)(nil),                // 32: builtin.events.knita.io.ImportResult
+	(*ImportEndEvent)(nil),              // 33: builtin.events.knita.io.ImportEndEvent
+	(*ExportStartEvent)(nil),            // 34: builtin.events.knita.io.ExportStartEvent
+	(*ExportProgressEvent)(nil),         // 35: builtin.events.knita.io.ExportProgressEvent
+	(*ExportResult)(nil),                // 36: builtin.events.knita.io.ExportResult
+	(*ExportEndEvent)(nil),              // 37: builtin.events.knita.io.ExportEndEvent
+	(*SyncPointReachedEvent)(nil),       // 38: builtin.events.knita.io.SyncPointReachedEvent
+	(*BuildPausedEvent)(nil),            // 39: builtin.events.knita.io.BuildPausedEvent
+	(*BuildResumedEvent)(nil),           // 40: builtin.events.knita.io.BuildResumedEvent
+	(*v1.SystemInfo)(nil),               // 41: executor.knita.io.SystemInfo
+	(*v1.RuntimeOpts)(nil),              // 42: executor.knita.io.RuntimeOpts
+	(*v11.RuntimeContract)(nil),         // 43: broker.knita.io.RuntimeContract
+	(*v1.ExecOpts)(nil),                 // 44: executor.knita.io.ExecOpts
+	(*v1.ResourceUsage)(nil),            // 45: executor.knita.io.ResourceUsage
+	(*durationpb.Duration)(nil),         // 46: google.protobuf.Duration
 }
 var file_events_builtin_v1_builtin_proto_depIdxs = []int32{
-	33, // 0: builtin.events.knita.io.DirectorInfo.sys_info:type_name -> executor.knita.io.SystemInfo
+	41, // 0: builtin.events.knita.io.DirectorInfo.sys_info:type_name -> executor.knita.io.SystemInfo
 	1,  // 1: builtin.events.knita.io.BuildStartEvent.director_info:type_name -> builtin.events.knita.io.DirectorInfo
 	0,  // 2: builtin.events.knita.io.BuildEndEvent.error:type_name -> builtin.events.knita.io.Error
 	3,  // 3: builtin.events.knita.io.BuildEndEvent.result:type_name -> builtin.events.knita.io.BuildResult
-	34, // 4: builtin.events.knita.io.RuntimeTenderStartEvent.opts:type_name -> executor.knita.io.RuntimeOpts
-	35, // 5: builtin.events.knita.io.RuntimeTenderResult.contracts:type_name -> broker.knita.io.RuntimeContract
+	42, // 4: builtin.events.knita.io.RuntimeTenderStartEvent.opts:type_name -> executor.knita.io.RuntimeOpts
+	43, // 5: builtin.events.knita.io.RuntimeTenderResult.contracts:type_name -> broker.knita.io.RuntimeContract
 	0,  // 6: builtin.events.knita.io.RuntimeTenderEndEvent.error:type_name -> builtin.events.knita.io.Error
 	6,  // 7: builtin.events.knita.io.RuntimeTenderEndEvent.result:type_name -> builtin.events.knita.io.RuntimeTenderResult
 	0,  // 8: builtin.events.knita.io.RuntimeSettlementEndEvent.error:type_name -> builtin.events.knita.io.Error
 	9,  // 9: builtin.events.knita.io.RuntimeSettlementEndEvent.result:type_name -> builtin.events.knita.io.RuntimeSettlementResult
-	34, // 10: builtin.events.knita.io.RuntimeOpenStartEvent.opts:type_name -> executor.knita.io.RuntimeOpts
+	42, // 10: builtin.events.knita.io.RuntimeOpenStartEvent.opts:type_name -> executor.knita.io.RuntimeOpts
 	0,  // 11: builtin.events.knita.io.RuntimeOpenEndEvent.error:type_name -> builtin.events.knita.io.Error
 	12, // 12: builtin.events.knita.io.RuntimeOpenEndEvent.result:type_name -> builtin.events.knita.io.RuntimeOpenResult
-	0,  // 13: builtin.events.knita.io.RuntimeCloseEndEvent.error:type_name -> builtin.events.knita.io.Error
-	15, // 14: builtin.events.knita.io.RuntimeCloseEndEvent.result:type_name -> builtin.events.knita.io.RuntimeCloseResult
-	19, // 15: builtin.events.knita.io.StdoutEvent.source:type_name -> builtin.events.knita.io.LogEventSource
-	19, // 16: builtin.events.knita.io.StderrEvent.source:type_name -> builtin.events.knita.io.LogEventSource
-	20, // 17: builtin.events.knita.io.LogEventSource.runtime:type_name -> builtin.events.knita.io.LogSourceRuntime
-	21, // 18: builtin.events.knita.io.LogEventSource.exec:type_name -> builtin.events.knita.io.LogSourceExec
-	22, // 19: builtin.events.knita.io.LogEventSource.director:type_name -> builtin.events.knita.io.LogSourceDirector
-	36, // 20: builtin.events.knita.io.ExecStartEvent.opts:type_name -> executor.knita.io.ExecOpts
-	0,  // 21: builtin.events.knita.io.ExecEndEvent.error:type_name -> builtin.events.knita.io.Error
-	24, // 22: builtin.events.knita.io.ExecEndEvent.result:type_name -> builtin.events.knita.io.ExecResult
-	0,  // 23: builtin.events.knita.io.ImportEndEvent.error:type_name -> builtin.events.knita.io.Error
-	27, // 24: builtin.events.knita.io.ImportEndEvent.result:type_name -> builtin.events.knita.io.ImportResult
-	0,  // 25: builtin.events.knita.io.ExportEndEvent.error:type_name -> builtin.events.knita.io.Error
-	30, // 26: builtin.events.knita.io.ExportEndEvent.result:type_name -> builtin.events.knita.io.ExportResult
-	27, // [27:27] is the sub-list for method output_type
-	27, // [27:27] is the sub-list for method input_type
-	27, // [27:27] is the sub-list for extension type_name
-	27, // [27:27] is the sub-list for extension extendee
-	0,  // [0:27] is the sub-list for field type_name
+	15, // 13: builtin.events.knita.io.ImagePullProgressEvent.layers:type_name -> builtin.events.knita.io.ImagePullLayerProgress
+	0,  // 14: builtin.events.knita.io.RuntimeCloseEndEvent.error:type_name -> builtin.events.knita.io.Error
+	17, // 15: builtin.events.knita.io.RuntimeCloseEndEvent.result:type_name -> builtin.events.knita.io.RuntimeCloseResult
+	21, // 16: builtin.events.knita.io.StdoutEvent.source:type_name -> builtin.events.knita.io.LogEventSource
+	21, // 17: builtin.events.knita.io.StderrEvent.source:type_name -> builtin.events.knita.io.LogEventSource
+	22, // 18: builtin.events.knita.io.LogEventSource.runtime:type_name -> builtin.events.knita.io.LogSourceRuntime
+	23, // 19: builtin.events.knita.io.LogEventSource.exec:type_name -> builtin.events.knita.io.LogSourceExec
+	24, // 20: builtin.events.knita.io.LogEventSource.director:type_name -> builtin.events.knita.io.LogSourceDirector
+	44, // 21: builtin.events.knita.io.ExecStartEvent.opts:type_name -> executor.knita.io.ExecOpts
+	45, // 22: builtin.events.knita.io.ExecResult.usage:type_name -> executor.knita.io.ResourceUsage
+	0,  // 23: builtin.events.knita.io.ExecEndEvent.error:type_name -> builtin.events.knita.io.Error
+	26, // 24: builtin.events.knita.io.ExecEndEvent.result:type_name -> builtin.events.knita.io.ExecResult
+	27, // 25: builtin.events.knita.io.ExecEndEvent.cancelled:type_name -> builtin.events.knita.io.ExecCancelled
+	31, // 26: builtin.events.knita.io.ImportProgressEvent.progress:type_name -> builtin.events.knita.io.TransferProgress
+	46, // 27: builtin.events.knita.io.TransferProgress.elapsed:type_name -> google.protobuf.Duration
+	0,  // 28: builtin.events.knita.io.ImportEndEvent.error:type_name -> builtin.events.knita.io.Error
+	32, // 29: builtin.events.knita.io.ImportEndEvent.result:type_name -> builtin.events.knita.io.ImportResult
+	31, // 30: builtin.events.knita.io.ExportProgressEvent.progress:type_name -> builtin.events.knita.io.TransferProgress
+	0,  // 31: builtin.events.knita.io.ExportEndEvent.error:type_name -> builtin.events.knita.io.Error
+	36, // 32: builtin.events.knita.io.ExportEndEvent.result:type_name -> builtin.events.knita.io.ExportResult
+	46, // 33: builtin.events.knita.io.BuildPausedEvent.timeout:type_name -> google.protobuf.Duration
+	34, // [34:34] is the sub-list for method output_type
+	34, // [34:34] is the sub-list for method input_type
+	34, // [34:34] is the sub-list for extension type_name
+	34, // [34:34] is the sub-list for extension extendee
+	0,  // [0:34] is the sub-list for field type_name
 }
 
 func init() { file_events_builtin_v1_builtin_proto_init() }
@@ -2553,7 +3300,7 @@ func file_events_builtin_v1_builtin_proto_init() {
 			}
 		}
 		file_events_builtin_v1_builtin_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RuntimeCloseStartEvent); i {
+			switch v := v.(*ImagePullProgressEvent); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2565,7 +3312,7 @@ func file_events_builtin_v1_builtin_proto_init() {
 			}
 		}
 		file_events_builtin_v1_builtin_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RuntimeCloseResult); i {
+			switch v := v.(*ImagePullLayerProgress); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2577,7 +3324,7 @@ func file_events_builtin_v1_builtin_proto_init() {
 			}
 		}
 		file_events_builtin_v1_builtin_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RuntimeCloseEndEvent); i {
+			switch v := v.(*RuntimeCloseStartEvent); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2589,7 +3336,7 @@ func file_events_builtin_v1_builtin_proto_init() {
 			}
 		}
 		file_events_builtin_v1_builtin_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StdoutEvent); i {
+			switch v := v.(*RuntimeCloseResult); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2601,7 +3348,7 @@ func file_events_builtin_v1_builtin_proto_init() {
 			}
 		}
 		file_events_builtin_v1_builtin_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StderrEvent); i {
+			switch v := v.(*RuntimeCloseEndEvent); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2613,7 +3360,7 @@ func file_events_builtin_v1_builtin_proto_init() {
 			}
 		}
 		file_events_builtin_v1_builtin_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LogEventSource); i {
+			switch v := v.(*StdoutEvent); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2625,7 +3372,7 @@ func file_events_builtin_v1_builtin_proto_init() {
 			}
 		}
 		file_events_builtin_v1_builtin_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LogSourceRuntime); i {
+			switch v := v.(*StderrEvent); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2637,7 +3384,7 @@ func file_events_builtin_v1_builtin_proto_init() {
 			}
 		}
 		file_events_builtin_v1_builtin_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LogSourceExec); i {
+			switch v := v.(*LogEventSource); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2649,7 +3396,7 @@ func file_events_builtin_v1_builtin_proto_init() {
 			}
 		}
 		file_events_builtin_v1_builtin_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LogSourceDirector); i {
+			switch v := v.(*LogSourceRuntime); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2661,7 +3408,7 @@ func file_events_builtin_v1_builtin_proto_init() {
 			}
 		}
 		file_events_builtin_v1_builtin_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ExecStartEvent); i {
+			switch v := v.(*LogSourceExec); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2673,7 +3420,7 @@ func file_events_builtin_v1_builtin_proto_init() {
 			}
 		}
 		file_events_builtin_v1_builtin_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ExecResult); i {
+			switch v := v.(*LogSourceDirector); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2685,7 +3432,7 @@ func file_events_builtin_v1_builtin_proto_init() {
 			}
 		}
 		file_events_builtin_v1_builtin_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ExecEndEvent); i {
+			switch v := v.(*ExecStartEvent); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2697,7 +3444,7 @@ func file_events_builtin_v1_builtin_proto_init() {
 			}
 		}
 		file_events_builtin_v1_builtin_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ImportStartEvent); i {
+			switch v := v.(*ExecResult); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2709,7 +3456,7 @@ func file_events_builtin_v1_builtin_proto_init() {
 			}
 		}
 		file_events_builtin_v1_builtin_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ImportResult); i {
+			switch v := v.(*ExecCancelled); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2721,7 +3468,7 @@ func file_events_builtin_v1_builtin_proto_init() {
 			}
 		}
 		file_events_builtin_v1_builtin_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ImportEndEvent); i {
+			switch v := v.(*ExecEndEvent); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2733,7 +3480,7 @@ func file_events_builtin_v1_builtin_proto_init() {
 			}
 		}
 		file_events_builtin_v1_builtin_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ExportStartEvent); i {
+			switch v := v.(*ImportStartEvent); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2745,7 +3492,7 @@ func file_events_builtin_v1_builtin_proto_init() {
 			}
 		}
 		file_events_builtin_v1_builtin_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ExportResult); i {
+			switch v := v.(*ImportProgressEvent); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2757,7 +3504,7 @@ func file_events_builtin_v1_builtin_proto_init() {
 			}
 		}
 		file_events_builtin_v1_builtin_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ExportEndEvent); i {
+			switch v := v.(*TransferProgress); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2769,6 +3516,78 @@ func file_events_builtin_v1_builtin_proto_init() {
 			}
 		}
 		file_events_builtin_v1_builtin_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_events_builtin_v1_builtin_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportEndEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_events_builtin_v1_builtin_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportStartEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_events_builtin_v1_builtin_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportProgressEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_events_builtin_v1_builtin_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_events_builtin_v1_builtin_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportEndEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_events_builtin_v1_builtin_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SyncPointReachedEvent); i {
 			case 0:
 				return &v.state
@@ -2780,6 +3599,30 @@ func file_events_builtin_v1_builtin_proto_init() {
 				return nil
 			}
 		}
+		file_events_builtin_v1_builtin_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BuildPausedEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_events_builtin_v1_builtin_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BuildResumedEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	file_events_builtin_v1_builtin_proto_msgTypes[4].OneofWrappers = []interface{}{
 		(*BuildEndEvent_Error)(nil),
@@ -2797,24 +3640,25 @@ func file_events_builtin_v1_builtin_proto_init() {
 		(*RuntimeOpenEndEvent_Error)(nil),
 		(*RuntimeOpenEndEvent_Result)(nil),
 	}
-	file_events_builtin_v1_builtin_proto_msgTypes[16].OneofWrappers = []interface{}{
+	file_events_builtin_v1_builtin_proto_msgTypes[18].OneofWrappers = []interface{}{
 		(*RuntimeCloseEndEvent_Error)(nil),
 		(*RuntimeCloseEndEvent_Result)(nil),
 	}
-	file_events_builtin_v1_builtin_proto_msgTypes[19].OneofWrappers = []interface{}{
+	file_events_builtin_v1_builtin_proto_msgTypes[21].OneofWrappers = []interface{}{
 		(*LogEventSource_Runtime)(nil),
 		(*LogEventSource_Exec)(nil),
 		(*LogEventSource_Director)(nil),
 	}
-	file_events_builtin_v1_builtin_proto_msgTypes[25].OneofWrappers = []interface{}{
+	file_events_builtin_v1_builtin_proto_msgTypes[28].OneofWrappers = []interface{}{
 		(*ExecEndEvent_Error)(nil),
 		(*ExecEndEvent_Result)(nil),
+		(*ExecEndEvent_Cancelled)(nil),
 	}
-	file_events_builtin_v1_builtin_proto_msgTypes[28].OneofWrappers = []interface{}{
+	file_events_builtin_v1_builtin_proto_msgTypes[33].OneofWrappers = []interface{}{
 		(*ImportEndEvent_Error)(nil),
 		(*ImportEndEvent_Result)(nil),
 	}
-	file_events_builtin_v1_builtin_proto_msgTypes[31].OneofWrappers = []interface{}{
+	file_events_builtin_v1_builtin_proto_msgTypes[37].OneofWrappers = []interface{}{
 		(*ExportEndEvent_Error)(nil),
 		(*ExportEndEvent_Result)(nil),
 	}
@@ -2824,7 +3668,7 @@ func file_events_builtin_v1_builtin_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_events_builtin_v1_builtin_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   33,
+			NumMessages:   41,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
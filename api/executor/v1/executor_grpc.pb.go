@@ -20,14 +20,19 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Executor_Introspect_FullMethodName = "/executor.knita.io.Executor/Introspect"
-	Executor_Events_FullMethodName     = "/executor.knita.io.Executor/Events"
-	Executor_Open_FullMethodName       = "/executor.knita.io.Executor/Open"
-	Executor_Heartbeat_FullMethodName  = "/executor.knita.io.Executor/Heartbeat"
-	Executor_Exec_FullMethodName       = "/executor.knita.io.Executor/Exec"
-	Executor_Import_FullMethodName     = "/executor.knita.io.Executor/Import"
-	Executor_Export_FullMethodName     = "/executor.knita.io.Executor/Export"
-	Executor_Close_FullMethodName      = "/executor.knita.io.Executor/Close"
+	Executor_Introspect_FullMethodName      = "/executor.knita.io.Executor/Introspect"
+	Executor_Events_FullMethodName          = "/executor.knita.io.Executor/Events"
+	Executor_Open_FullMethodName            = "/executor.knita.io.Executor/Open"
+	Executor_Heartbeat_FullMethodName       = "/executor.knita.io.Executor/Heartbeat"
+	Executor_Exec_FullMethodName            = "/executor.knita.io.Executor/Exec"
+	Executor_Signal_FullMethodName          = "/executor.knita.io.Executor/Signal"
+	Executor_Attach_FullMethodName          = "/executor.knita.io.Executor/Attach"
+	Executor_Manifest_FullMethodName        = "/executor.knita.io.Executor/Manifest"
+	Executor_ManifestDigests_FullMethodName = "/executor.knita.io.Executor/ManifestDigests"
+	Executor_Blobs_FullMethodName           = "/executor.knita.io.Executor/Blobs"
+	Executor_Import_FullMethodName          = "/executor.knita.io.Executor/Import"
+	Executor_Export_FullMethodName          = "/executor.knita.io.Executor/Export"
+	Executor_Close_FullMethodName           = "/executor.knita.io.Executor/Close"
 )
 
 // ExecutorClient is the client API for Executor service.
@@ -38,7 +43,12 @@ type ExecutorClient interface {
 	Events(ctx context.Context, in *EventsRequest, opts ...grpc.CallOption) (Executor_EventsClient, error)
 	Open(ctx context.Context, in *OpenRequest, opts ...grpc.CallOption) (*OpenResponse, error)
 	Heartbeat(ctx context.Context, in *HeartbeatRequest, opts ...grpc.CallOption) (*HeartbeatResponse, error)
-	Exec(ctx context.Context, in *ExecRequest, opts ...grpc.CallOption) (*ExecResponse, error)
+	Exec(ctx context.Context, opts ...grpc.CallOption) (Executor_ExecClient, error)
+	Signal(ctx context.Context, in *SignalRequest, opts ...grpc.CallOption) (*SignalResponse, error)
+	Attach(ctx context.Context, opts ...grpc.CallOption) (Executor_AttachClient, error)
+	Manifest(ctx context.Context, in *ManifestRequest, opts ...grpc.CallOption) (Executor_ManifestClient, error)
+	ManifestDigests(ctx context.Context, in *ManifestDigestsRequest, opts ...grpc.CallOption) (*ManifestDigestsResponse, error)
+	Blobs(ctx context.Context, in *BlobsRequest, opts ...grpc.CallOption) (*BlobsResponse, error)
 	Import(ctx context.Context, opts ...grpc.CallOption) (Executor_ImportClient, error)
 	Export(ctx context.Context, in *ExportRequest, opts ...grpc.CallOption) (Executor_ExportClient, error)
 	Close(ctx context.Context, in *CloseRequest, opts ...grpc.CallOption) (*CloseResponse, error)
@@ -111,9 +121,124 @@ func (c *executorClient) Heartbeat(ctx context.Context, in *HeartbeatRequest, op
 	return out, nil
 }
 
-func (c *executorClient) Exec(ctx context.Context, in *ExecRequest, opts ...grpc.CallOption) (*ExecResponse, error) {
-	out := new(ExecResponse)
-	err := c.cc.Invoke(ctx, Executor_Exec_FullMethodName, in, out, opts...)
+func (c *executorClient) Exec(ctx context.Context, opts ...grpc.CallOption) (Executor_ExecClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Executor_ServiceDesc.Streams[1], Executor_Exec_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &executorExecClient{stream}
+	return x, nil
+}
+
+type Executor_ExecClient interface {
+	Send(*ExecRequest) error
+	CloseAndRecv() (*ExecResponse, error)
+	grpc.ClientStream
+}
+
+type executorExecClient struct {
+	grpc.ClientStream
+}
+
+func (x *executorExecClient) Send(m *ExecRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *executorExecClient) CloseAndRecv() (*ExecResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(ExecResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *executorClient) Signal(ctx context.Context, in *SignalRequest, opts ...grpc.CallOption) (*SignalResponse, error) {
+	out := new(SignalResponse)
+	err := c.cc.Invoke(ctx, Executor_Signal_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *executorClient) Attach(ctx context.Context, opts ...grpc.CallOption) (Executor_AttachClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Executor_ServiceDesc.Streams[2], Executor_Attach_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &executorAttachClient{stream}
+	return x, nil
+}
+
+type Executor_AttachClient interface {
+	Send(*AttachRequest) error
+	Recv() (*AttachResponse, error)
+	grpc.ClientStream
+}
+
+type executorAttachClient struct {
+	grpc.ClientStream
+}
+
+func (x *executorAttachClient) Send(m *AttachRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *executorAttachClient) Recv() (*AttachResponse, error) {
+	m := new(AttachResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *executorClient) Manifest(ctx context.Context, in *ManifestRequest, opts ...grpc.CallOption) (Executor_ManifestClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Executor_ServiceDesc.Streams[3], Executor_Manifest_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &executorManifestClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Executor_ManifestClient interface {
+	Recv() (*ManifestResponse, error)
+	grpc.ClientStream
+}
+
+type executorManifestClient struct {
+	grpc.ClientStream
+}
+
+func (x *executorManifestClient) Recv() (*ManifestResponse, error) {
+	m := new(ManifestResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *executorClient) ManifestDigests(ctx context.Context, in *ManifestDigestsRequest, opts ...grpc.CallOption) (*ManifestDigestsResponse, error) {
+	out := new(ManifestDigestsResponse)
+	err := c.cc.Invoke(ctx, Executor_ManifestDigests_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *executorClient) Blobs(ctx context.Context, in *BlobsRequest, opts ...grpc.CallOption) (*BlobsResponse, error) {
+	out := new(BlobsResponse)
+	err := c.cc.Invoke(ctx, Executor_Blobs_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -121,7 +246,7 @@ func (c *executorClient) Exec(ctx context.Context, in *ExecRequest, opts ...grpc
 }
 
 func (c *executorClient) Import(ctx context.Context, opts ...grpc.CallOption) (Executor_ImportClient, error) {
-	stream, err := c.cc.NewStream(ctx, &Executor_ServiceDesc.Streams[1], Executor_Import_FullMethodName, opts...)
+	stream, err := c.cc.NewStream(ctx, &Executor_ServiceDesc.Streams[4], Executor_Import_FullMethodName, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -155,7 +280,7 @@ func (x *executorImportClient) CloseAndRecv() (*ImportResponse, error) {
 }
 
 func (c *executorClient) Export(ctx context.Context, in *ExportRequest, opts ...grpc.CallOption) (Executor_ExportClient, error) {
-	stream, err := c.cc.NewStream(ctx, &Executor_ServiceDesc.Streams[2], Executor_Export_FullMethodName, opts...)
+	stream, err := c.cc.NewStream(ctx, &Executor_ServiceDesc.Streams[5], Executor_Export_FullMethodName, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -203,7 +328,12 @@ type ExecutorServer interface {
 	Events(*EventsRequest, Executor_EventsServer) error
 	Open(context.Context, *OpenRequest) (*OpenResponse, error)
 	Heartbeat(context.Context, *HeartbeatRequest) (*HeartbeatResponse, error)
-	Exec(context.Context, *ExecRequest) (*ExecResponse, error)
+	Exec(Executor_ExecServer) error
+	Signal(context.Context, *SignalRequest) (*SignalResponse, error)
+	Attach(Executor_AttachServer) error
+	Manifest(*ManifestRequest, Executor_ManifestServer) error
+	ManifestDigests(context.Context, *ManifestDigestsRequest) (*ManifestDigestsResponse, error)
+	Blobs(context.Context, *BlobsRequest) (*BlobsResponse, error)
 	Import(Executor_ImportServer) error
 	Export(*ExportRequest, Executor_ExportServer) error
 	Close(context.Context, *CloseRequest) (*CloseResponse, error)
@@ -226,8 +356,23 @@ func (UnimplementedExecutorServer) Open(context.Context, *OpenRequest) (*OpenRes
 func (UnimplementedExecutorServer) Heartbeat(context.Context, *HeartbeatRequest) (*HeartbeatResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Heartbeat not implemented")
 }
-func (UnimplementedExecutorServer) Exec(context.Context, *ExecRequest) (*ExecResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Exec not implemented")
+func (UnimplementedExecutorServer) Exec(Executor_ExecServer) error {
+	return status.Errorf(codes.Unimplemented, "method Exec not implemented")
+}
+func (UnimplementedExecutorServer) Signal(context.Context, *SignalRequest) (*SignalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Signal not implemented")
+}
+func (UnimplementedExecutorServer) Attach(Executor_AttachServer) error {
+	return status.Errorf(codes.Unimplemented, "method Attach not implemented")
+}
+func (UnimplementedExecutorServer) Manifest(*ManifestRequest, Executor_ManifestServer) error {
+	return status.Errorf(codes.Unimplemented, "method Manifest not implemented")
+}
+func (UnimplementedExecutorServer) ManifestDigests(context.Context, *ManifestDigestsRequest) (*ManifestDigestsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ManifestDigests not implemented")
+}
+func (UnimplementedExecutorServer) Blobs(context.Context, *BlobsRequest) (*BlobsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Blobs not implemented")
 }
 func (UnimplementedExecutorServer) Import(Executor_ImportServer) error {
 	return status.Errorf(codes.Unimplemented, "method Import not implemented")
@@ -326,20 +471,129 @@ func _Executor_Heartbeat_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Executor_Exec_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ExecRequest)
+func _Executor_Exec_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(ExecutorServer).Exec(&executorExecServer{stream})
+}
+
+type Executor_ExecServer interface {
+	SendAndClose(*ExecResponse) error
+	Recv() (*ExecRequest, error)
+	grpc.ServerStream
+}
+
+type executorExecServer struct {
+	grpc.ServerStream
+}
+
+func (x *executorExecServer) SendAndClose(m *ExecResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *executorExecServer) Recv() (*ExecRequest, error) {
+	m := new(ExecRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _Executor_Signal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExecutorServer).Signal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Executor_Signal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExecutorServer).Signal(ctx, req.(*SignalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Executor_Attach_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(ExecutorServer).Attach(&executorAttachServer{stream})
+}
+
+type Executor_AttachServer interface {
+	Send(*AttachResponse) error
+	Recv() (*AttachRequest, error)
+	grpc.ServerStream
+}
+
+type executorAttachServer struct {
+	grpc.ServerStream
+}
+
+func (x *executorAttachServer) Send(m *AttachResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *executorAttachServer) Recv() (*AttachRequest, error) {
+	m := new(AttachRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _Executor_Manifest_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ManifestRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ExecutorServer).Manifest(m, &executorManifestServer{stream})
+}
+
+type Executor_ManifestServer interface {
+	Send(*ManifestResponse) error
+	grpc.ServerStream
+}
+
+type executorManifestServer struct {
+	grpc.ServerStream
+}
+
+func (x *executorManifestServer) Send(m *ManifestResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Executor_ManifestDigests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ManifestDigestsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ExecutorServer).Exec(ctx, in)
+		return srv.(ExecutorServer).ManifestDigests(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Executor_Exec_FullMethodName,
+		FullMethod: Executor_ManifestDigests_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ExecutorServer).Exec(ctx, req.(*ExecRequest))
+		return srv.(ExecutorServer).ManifestDigests(ctx, req.(*ManifestDigestsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Executor_Blobs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlobsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExecutorServer).Blobs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Executor_Blobs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExecutorServer).Blobs(ctx, req.(*BlobsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -429,8 +683,16 @@ var Executor_ServiceDesc = grpc.ServiceDesc{
 			Handler:    _Executor_Heartbeat_Handler,
 		},
 		{
-			MethodName: "Exec",
-			Handler:    _Executor_Exec_Handler,
+			MethodName: "Signal",
+			Handler:    _Executor_Signal_Handler,
+		},
+		{
+			MethodName: "ManifestDigests",
+			Handler:    _Executor_ManifestDigests_Handler,
+		},
+		{
+			MethodName: "Blobs",
+			Handler:    _Executor_Blobs_Handler,
 		},
 		{
 			MethodName: "Close",
@@ -443,6 +705,22 @@ var Executor_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _Executor_Events_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "Exec",
+			Handler:       _Executor_Exec_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "Attach",
+			Handler:       _Executor_Attach_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "Manifest",
+			Handler:       _Executor_Manifest_Handler,
+			ServerStreams: true,
+		},
 		{
 			StreamName:    "Import",
 			Handler:       _Executor_Import_Handler,
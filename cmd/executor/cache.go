@@ -0,0 +1,73 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/knita-io/knita/internal/executor/cache"
+)
+
+var cacheCMD = &cobra.Command{
+	Use:   "cache",
+	Short: "Manages the persistent caches runtimes mount",
+}
+
+var cacheListCMD = &cobra.Command{
+	Use:   "list",
+	Short: "Lists persistent caches",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cmd.SilenceUsage = true
+		manager, err := makeCacheManager(cmd)
+		if err != nil {
+			return err
+		}
+		infos, err := manager.List()
+		if err != nil {
+			return err
+		}
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(w, "NAME\tSIZE (MB)\tLAST USED")
+		for _, info := range infos {
+			lastUsed := "never"
+			if !info.LastUsed.IsZero() {
+				lastUsed = info.LastUsed.Format(time.RFC3339)
+			}
+			fmt.Fprintf(w, "%s\t%.1f\t%s\n", info.Name, float64(info.Size)/(1024*1024), lastUsed)
+		}
+		return w.Flush()
+	},
+}
+
+var cachePurgeCMD = &cobra.Command{
+	Use:   "purge [name...]",
+	Short: "Purges the named persistent caches, or all caches if no names are specified",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cmd.SilenceUsage = true
+		manager, err := makeCacheManager(cmd)
+		if err != nil {
+			return err
+		}
+		return manager.Purge(args...)
+	},
+}
+
+// makeCacheManager returns a cache manager configured from the executor config file.
+// NOTE: Caches are only locked within the executor process, so purging caches while
+// the executor is running may disrupt in progress builds.
+func makeCacheManager(cmd *cobra.Command) (*cache.Manager, error) {
+	syslog, err := makeLogger()
+	if err != nil {
+		return nil, err
+	}
+	configFilePath, _ := cmd.Flags().GetString("config")
+	config, err := getConfig(syslog, configFilePath)
+	if err != nil {
+		return nil, err
+	}
+	return cache.NewManager(syslog, config.Cache.toCacheConfig()), nil
+}
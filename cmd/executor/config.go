@@ -6,6 +6,9 @@ import (
 
 	"github.com/rs/xid"
 
+	"github.com/knita-io/knita/internal/executor/blob"
+	"github.com/knita-io/knita/internal/executor/cache"
+
 	"github.com/spf13/viper"
 	"go.uber.org/zap"
 )
@@ -18,6 +21,61 @@ type config struct {
 	Name string `mapstructure:"name"`
 	// Labels the executor will advertise to the broker.
 	Labels map[string]string `mapstructure:"labels"`
+	// Docker configures Docker runtimes.
+	Docker dockerConfig `mapstructure:"docker"`
+	// Cache configures the persistent caches runtimes may mount.
+	Cache cacheConfig `mapstructure:"cache"`
+	// BlobCache configures the store of imported file blobs.
+	BlobCache blobCacheConfig `mapstructure:"blob_cache"`
+}
+
+type cacheConfig struct {
+	// Dir is the directory caches are persisted to.
+	Dir string `mapstructure:"dir"`
+	// MaxTotalSizeMB is the maximum combined size of all caches.
+	MaxTotalSizeMB int64 `mapstructure:"max_total_size_mb"`
+	// DefaultMaxSizeMB is the maximum size of a cache that does not request its own limit.
+	DefaultMaxSizeMB int64 `mapstructure:"default_max_size_mb"`
+}
+
+func (c cacheConfig) toCacheConfig() cache.Config {
+	return cache.Config{
+		Dir:            c.Dir,
+		MaxTotalSize:   c.MaxTotalSizeMB * 1024 * 1024,
+		DefaultMaxSize: c.DefaultMaxSizeMB * 1024 * 1024,
+	}
+}
+
+type blobCacheConfig struct {
+	// Disabled disables the blob cache, so every imported file is transferred in full.
+	Disabled bool `mapstructure:"disabled"`
+	// Dir is the directory blobs are stored in.
+	Dir string `mapstructure:"dir"`
+	// MaxSizeMB is the maximum combined size of all blobs. Defaults to 10 GiB.
+	MaxSizeMB int64 `mapstructure:"max_size_mb"`
+}
+
+func (c blobCacheConfig) toBlobConfig() blob.Config {
+	if c.Disabled {
+		return blob.Config{}
+	}
+	maxSize := int64(blob.DefaultMaxSize)
+	if c.MaxSizeMB > 0 {
+		maxSize = c.MaxSizeMB * 1024 * 1024
+	}
+	return blob.Config{Dir: c.Dir, MaxSize: maxSize}
+}
+
+type dockerConfig struct {
+	// Policy restricts the Docker runtime options that builds may request.
+	Policy dockerPolicyConfig `mapstructure:"policy"`
+}
+
+type dockerPolicyConfig struct {
+	ForbidPrivileged      bool     `mapstructure:"forbid_privileged"`
+	ForbidDockerSocket    bool     `mapstructure:"forbid_docker_socket"`
+	ForbiddenNetworkModes []string `mapstructure:"forbidden_network_modes"`
+	ForbiddenCapabilities []string `mapstructure:"forbidden_capabilities"`
 }
 
 func fillDefaultValues(config *config) *config {
@@ -41,7 +41,18 @@ var rootCmd = &cobra.Command{
 		}
 		defer listener.Close()
 
-		executor := executor.NewServer(syslog, executor.Config{Name: config.Name, Labels: config.Labels})
+		executor := executor.NewServer(syslog, executor.Config{
+			Name:   config.Name,
+			Labels: config.Labels,
+			DockerPolicy: executor.DockerPolicy{
+				ForbidPrivileged:      config.Docker.Policy.ForbidPrivileged,
+				ForbidDockerSocket:    config.Docker.Policy.ForbidDockerSocket,
+				ForbiddenNetworkModes: config.Docker.Policy.ForbiddenNetworkModes,
+				ForbiddenCapabilities: config.Docker.Policy.ForbiddenCapabilities,
+			},
+			Cache: config.Cache.toCacheConfig(),
+			Blobs: config.BlobCache.toBlobConfig(),
+		})
 		defer executor.Stop()
 
 		srv := grpc.NewServer(
@@ -60,7 +71,7 @@ var rootCmd = &cobra.Command{
 		}()
 		defer srv.Stop()
 
-		c := make(chan os.Signal)
+		c := make(chan os.Signal, 1)
 		signal.Notify(c, os.Interrupt, syscall.SIGTERM)
 		<-c
 
@@ -90,6 +101,9 @@ func makeLogger() (*zap.SugaredLogger, error) {
 func main() {
 	rootCmd.PersistentFlags().StringP("config", "c", defaultConfigFilePath, "Specify a custom path to the executor config file")
 	rootCmd.AddCommand(versionCMD)
+	cacheCMD.AddCommand(cacheListCMD)
+	cacheCMD.AddCommand(cachePurgeCMD)
+	rootCmd.AddCommand(cacheCMD)
 	if err := rootCmd.Execute(); err != nil {
 		os.Exit(1)
 	}
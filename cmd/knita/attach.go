@@ -0,0 +1,166 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/spf13/cobra"
+	"golang.org/x/term"
+	"google.golang.org/grpc"
+
+	directorv1 "github.com/knita-io/knita/api/director/v1"
+	executorv1 "github.com/knita-io/knita/api/executor/v1"
+)
+
+var attachCMD = &cobra.Command{
+	Use:   "attach <runtime-id> [command]",
+	Args:  cobra.MinimumNArgs(1),
+	Short: "Opens an interactive shell inside a runtime of a running build",
+	Long: `Opens an interactive shell inside a runtime of a running build, for manual introspection.
+Runtime IDs are printed to the build log as runtimes are opened. Optionally specify a command to run
+instead of a shell. The running build is found automatically if there is only one, otherwise specify
+its socket with --socket.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cmd.SilenceUsage = true
+		conn, err := dialBuild(cmd)
+		if err != nil {
+			return err
+		}
+		defer conn.Close()
+		exitCode, err := attach(directorv1.NewDirectorClient(conn), args[0], args[1:])
+		if err != nil {
+			return err
+		}
+		if exitCode != 0 {
+			os.Exit(int(exitCode))
+		}
+		return nil
+	},
+}
+
+// dialBuild connects to the director of the running build, as specified by the command's --socket flag.
+func dialBuild(cmd *cobra.Command) (*grpc.ClientConn, error) {
+	socket, _ := cmd.Flags().GetString("socket")
+	if socket == "" {
+		var err error
+		socket, err = findBuildSocket()
+		if err != nil {
+			return nil, err
+		}
+	}
+	dialer := func(addr string, t time.Duration) (net.Conn, error) {
+		return net.Dial("unix", addr)
+	}
+	conn, err := grpc.Dial(socket, grpc.WithInsecure(), grpc.WithDialer(dialer))
+	if err != nil {
+		return nil, fmt.Errorf("error dialing build socket %s: %w", socket, err)
+	}
+	return conn, nil
+}
+
+// findBuildSocket returns the director socket of the running build.
+func findBuildSocket() (string, error) {
+	if socket := os.Getenv("KNITA_SOCKET"); socket != "" {
+		return socket, nil
+	}
+	sockets, err := filepath.Glob(filepath.Join(os.TempDir(), "knita-cli-*.socket"))
+	if err != nil {
+		return "", fmt.Errorf("error finding running builds: %w", err)
+	}
+	switch len(sockets) {
+	case 0:
+		return "", fmt.Errorf("error no running builds found")
+	case 1:
+		return sockets[0], nil
+	default:
+		return "", fmt.Errorf("error found multiple running builds; specify one with --socket:\n%s", strings.Join(sockets, "\n"))
+	}
+}
+
+// attach opens an interactive session inside a runtime, attached to this process's terminal.
+// Returns the exit code of the session's command.
+func attach(client directorv1.DirectorClient, runtimeID string, command []string) (int32, error) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	stdinFD := int(os.Stdin.Fd())
+	stdoutFD := int(os.Stdout.Fd())
+	opts := &executorv1.AttachOpts{Command: command, Tty: terminalSize(stdoutFD)}
+	if termEnv := os.Getenv("TERM"); termEnv != "" {
+		opts.Env = []string{"TERM=" + termEnv}
+	}
+	stream, err := client.Attach(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("error attaching: %w", err)
+	}
+	if err := stream.Send(&executorv1.AttachRequest{RuntimeId: runtimeID, Opts: opts}); err != nil {
+		return 0, fmt.Errorf("error sending attach request: %w", err)
+	}
+	if term.IsTerminal(stdinFD) {
+		// Raw mode passes keystrokes (including Ctrl+C) through to the session's terminal.
+		state, err := term.MakeRaw(stdinFD)
+		if err != nil {
+			return 0, fmt.Errorf("error putting terminal into raw mode: %w", err)
+		}
+		defer term.Restore(stdinFD, state)
+	}
+	var sendMu sync.Mutex
+	send := func(req *executorv1.AttachRequest) error {
+		sendMu.Lock()
+		defer sendMu.Unlock()
+		return stream.Send(req)
+	}
+	go func() {
+		for range watchTerminalResize(ctx) {
+			if err := send(&executorv1.AttachRequest{Resize: terminalSize(stdoutFD)}); err != nil {
+				return
+			}
+		}
+	}()
+	go func() {
+		buf := make([]byte, 32*1024)
+		for {
+			n, err := os.Stdin.Read(buf)
+			if n > 0 {
+				if err := send(&executorv1.AttachRequest{Stdin: buf[:n]}); err != nil {
+					return
+				}
+			}
+			if err != nil {
+				sendMu.Lock()
+				stream.CloseSend()
+				sendMu.Unlock()
+				return
+			}
+		}
+	}()
+	for {
+		res, err := stream.Recv()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return 0, fmt.Errorf("error session ended unexpectedly")
+			}
+			return 0, fmt.Errorf("error in session: %w", err)
+		}
+		if res.Exited {
+			return res.ExitCode, nil
+		}
+		os.Stdout.Write(res.Output)
+	}
+}
+
+// terminalSize returns the size of the terminal fd, or nil if fd is not a terminal.
+func terminalSize(fd int) *executorv1.TtyOpts {
+	cols, rows, err := term.GetSize(fd)
+	if err != nil {
+		return nil
+	}
+	return &executorv1.TtyOpts{Rows: uint32(rows), Cols: uint32(cols)}
+}
@@ -0,0 +1,34 @@
+//go:build !windows
+
+package main
+
+import (
+	"context"
+	"os"
+	"os/signal"
+	"syscall"
+)
+
+// watchTerminalResize notifies the returned channel whenever the terminal is resized, until ctx is done.
+func watchTerminalResize(ctx context.Context) <-chan struct{} {
+	sigC := make(chan os.Signal, 1)
+	signal.Notify(sigC, syscall.SIGWINCH)
+	resizeC := make(chan struct{})
+	go func() {
+		defer close(resizeC)
+		defer signal.Stop(sigC)
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-sigC:
+				select {
+				case resizeC <- struct{}{}:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+	return resizeC
+}
@@ -0,0 +1,40 @@
+package main
+
+import (
+	"context"
+	"os"
+	"time"
+
+	"golang.org/x/term"
+)
+
+// watchTerminalResize notifies the returned channel whenever the terminal is resized, until ctx is done.
+// Windows has no equivalent of SIGWINCH, so the terminal size is polled.
+func watchTerminalResize(ctx context.Context) <-chan struct{} {
+	resizeC := make(chan struct{})
+	go func() {
+		defer close(resizeC)
+		fd := int(os.Stdout.Fd())
+		lastCols, lastRows, _ := term.GetSize(fd)
+		ticker := time.NewTicker(250 * time.Millisecond)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				cols, rows, err := term.GetSize(fd)
+				if err != nil || (cols == lastCols && rows == lastRows) {
+					continue
+				}
+				lastCols, lastRows = cols, rows
+				select {
+				case resizeC <- struct{}{}:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+	return resizeC
+}
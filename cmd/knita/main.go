@@ -142,7 +142,10 @@ var buildCMD = &cobra.Command{
 		brokerClient := brokerv1.NewBrokerClient(conn)
 		buildLog := director.NewLog(event.NewBroker(syslog), buildID)
 		defer buildLog.Close()
-		build := director.NewBuild(syslog, buildLog, buildID, brokerClient, file.WriteDirFS(work))
+		pauseOnFailure, _ := cmd.Flags().GetBool("pause-on-failure")
+		pauseTimeout, _ := cmd.Flags().GetDuration("pause-timeout")
+		build := director.NewBuild(syslog, buildLog, buildID, brokerClient, file.WriteDirFS(work),
+			director.WithPauseOnFailure(pauseOnFailure), director.WithPauseTimeout(pauseTimeout))
 		directorServer := director.NewServer(syslog, build)
 		embeddedExecutorName, _ := os.Hostname()
 		if embeddedExecutorName == "" {
@@ -353,7 +356,15 @@ func getSocketPath() (string, error) {
 func main() {
 	buildCMD.PersistentFlags().BoolP("verbose", "v", false, "Set to true to disable the pretty build UI and send the build log directly to stdout")
 	buildCMD.PersistentFlags().StringP("config", "c", defaultConfigFilePath, "Specify a custom path to the Knita config file")
+	buildCMD.PersistentFlags().Bool("pause-on-failure", false, "Set to true to pause the build when an exec fails, so the runtime can be inspected with knita attach")
+	buildCMD.PersistentFlags().Duration("pause-timeout", director.DefaultPauseTimeout, "Specify the maximum time the build remains paused before resuming automatically")
+	attachCMD.Flags().String("socket", "", "Specify the socket of the build to attach to. Defaults to the only running build")
+	resumeCMD.Flags().String("socket", "", "Specify the socket of the build to resume. Defaults to the only running build")
+	abortCMD.Flags().String("socket", "", "Specify the socket of the build to abort. Defaults to the only running build")
 	rootCmd.AddCommand(buildCMD)
+	rootCmd.AddCommand(attachCMD)
+	rootCmd.AddCommand(resumeCMD)
+	rootCmd.AddCommand(abortCMD)
 	rootCmd.AddCommand(versionCMD)
 	if err := rootCmd.Execute(); err != nil {
 		os.Exit(1)
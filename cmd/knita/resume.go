@@ -0,0 +1,55 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	directorv1 "github.com/knita-io/knita/api/director/v1"
+)
+
+var resumeCMD = &cobra.Command{
+	Use:   "resume",
+	Args:  cobra.NoArgs,
+	Short: "Resumes a running build that is paused at a breakpoint",
+	Long: `Resumes a running build that is paused at a breakpoint. The running build is found automatically
+if there is only one, otherwise specify its socket with --socket.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return resume(cmd, false)
+	},
+}
+
+var abortCMD = &cobra.Command{
+	Use:   "abort",
+	Args:  cobra.NoArgs,
+	Short: "Aborts a running build that is paused at a breakpoint",
+	Long: `Aborts a running build that is paused at a breakpoint, failing the build. The running build is
+found automatically if there is only one, otherwise specify its socket with --socket.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return resume(cmd, true)
+	},
+}
+
+// resume resumes all paused runtimes of the running build, or aborts them if abort is true.
+func resume(cmd *cobra.Command, abort bool) error {
+	cmd.SilenceUsage = true
+	conn, err := dialBuild(cmd)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	res, err := directorv1.NewDirectorClient(conn).Resume(context.Background(), &directorv1.ResumeRequest{Abort: abort})
+	if err != nil {
+		return fmt.Errorf("error resuming build: %w", err)
+	}
+	if res.Resumed == 0 {
+		return fmt.Errorf("error build is not paused")
+	}
+	if abort {
+		fmt.Printf("Aborted build (%d paused runtimes)\n", res.Resumed)
+	} else {
+		fmt.Printf("Resumed build (%d paused runtimes)\n", res.Resumed)
+	}
+	return nil
+}
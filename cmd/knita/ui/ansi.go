@@ -0,0 +1,140 @@
+package ui
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// sgrReset resets all colors and text styles.
+const sgrReset = "\x1b[0m"
+
+// formatTerminalText sanitizes untrusted terminal output (e.g. from a command run with a tty) for display
+// on a single line. SGR escape sequences (colors and text styles) are preserved, while all other escape
+// sequences and control characters are removed, so the output cannot move the cursor or otherwise corrupt
+// the UI. Only the text after the last carriage return or newline is kept, as that is what a terminal would
+// display on the current line e.g. the latest state of a progress bar.
+func formatTerminalText(text string) string {
+	text = strings.TrimRight(text, "\r\n")
+	if i := strings.LastIndexAny(text, "\r\n"); i >= 0 {
+		text = text[i+1:]
+	}
+	var (
+		b      strings.Builder
+		styled bool
+	)
+	for i := 0; i < len(text); {
+		if text[i] != '\x1b' {
+			r, size := utf8.DecodeRuneInString(text[i:])
+			if unicode.IsPrint(r) {
+				b.WriteRune(r)
+			}
+			i += size
+			continue
+		}
+		n := escapeSequenceLen(text[i:])
+		if seq := text[i : i+n]; isSGR(seq) {
+			b.WriteString(seq)
+			styled = true
+		}
+		i += n
+	}
+	if styled {
+		// Stop styles leaking into the rest of the UI.
+		b.WriteString(sgrReset)
+	}
+	return b.String()
+}
+
+// escapeSequenceLen returns the length of the escape sequence at the start of s, which must begin with ESC.
+func escapeSequenceLen(s string) int {
+	if len(s) < 2 {
+		return len(s)
+	}
+	switch s[1] {
+	case '[':
+		// CSI sequences end with a final byte in the range 0x40-0x7E, and are aborted by an ESC.
+		for i := 2; i < len(s); i++ {
+			if s[i] == '\x1b' {
+				return i
+			}
+			if s[i] >= 0x40 && s[i] <= 0x7e {
+				return i + 1
+			}
+		}
+		return len(s)
+	case ']', 'P', 'X', '^', '_':
+		// String sequences (e.g. OSC window titles) end with BEL or ST (ESC \).
+		for i := 2; i < len(s); i++ {
+			if s[i] == '\a' {
+				return i + 1
+			}
+			if s[i] == '\x1b' && i+1 < len(s) && s[i+1] == '\\' {
+				return i + 2
+			}
+		}
+		return len(s)
+	default:
+		_, size := utf8.DecodeRuneInString(s[1:])
+		return 1 + size
+	}
+}
+
+// isSGR returns true if seq is an SGR (Select Graphic Rendition) sequence e.g. "\x1b[1;31m".
+func isSGR(seq string) bool {
+	if len(seq) < 3 || seq[1] != '[' || seq[len(seq)-1] != 'm' {
+		return false
+	}
+	for _, c := range seq[2 : len(seq)-1] {
+		if c != ';' && (c < '0' || c > '9') {
+			return false
+		}
+	}
+	return true
+}
+
+// terminalTextWidth returns the number of runes text occupies when displayed.
+// text must have been sanitized with formatTerminalText.
+func terminalTextWidth(text string) int {
+	var width int
+	for i := 0; i < len(text); {
+		if text[i] == '\x1b' {
+			i += escapeSequenceLen(text[i:])
+			continue
+		}
+		_, size := utf8.DecodeRuneInString(text[i:])
+		i += size
+		width++
+	}
+	return width
+}
+
+// truncateTerminalText truncates text to width displayed runes, preserving its escape sequences.
+// text must have been sanitized with formatTerminalText.
+func truncateTerminalText(text string, width int) string {
+	var (
+		b      strings.Builder
+		styled bool
+		n      int
+	)
+	for i := 0; i < len(text); {
+		if text[i] == '\x1b' {
+			size := escapeSequenceLen(text[i:])
+			b.WriteString(text[i : i+size])
+			styled = true
+			i += size
+			continue
+		}
+		_, size := utf8.DecodeRuneInString(text[i:])
+		if n >= width {
+			break
+		}
+		b.WriteString(text[i : i+size])
+		i += size
+		n++
+	}
+	if styled && !strings.HasSuffix(b.String(), sgrReset) {
+		b.WriteString(sgrReset)
+	}
+	return b.String()
+}
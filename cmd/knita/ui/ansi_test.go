@@ -0,0 +1,53 @@
+package ui
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFormatTerminalText(t *testing.T) {
+	var table = []struct {
+		name string
+		in   string
+		out  string
+	}{
+		{name: "plain", in: "hello world", out: "hello world"},
+		{name: "last line", in: "first\nsecond\r\n", out: "second"},
+		{name: "carriage return", in: "10%\r50%\r100%", out: "100%"},
+		{name: "sgr", in: "\x1b[1;31merror\x1b[0m: failed", out: "\x1b[1;31merror\x1b[0m: failed" + sgrReset},
+		{name: "sgr 256 colors", in: "\x1b[38;5;196mred", out: "\x1b[38;5;196mred" + sgrReset},
+		{name: "sgr reset only when styled", in: "\x1b[2Kplain", out: "plain"},
+		{name: "cursor movement", in: "a\x1b[2Ab\x1b[10;20Hc", out: "abc"},
+		{name: "private csi", in: "\x1b[?25lhidden cursor\x1b[?25h", out: "hidden cursor"},
+		{name: "sgr with sub-parameters", in: "\x1b[4:3mcurly", out: "curly"},
+		{name: "osc terminated by bel", in: "\x1b]0;title\atext", out: "text"},
+		{name: "osc terminated by st", in: "\x1b]8;;https://example.com\x1b\\link\x1b]8;;\x1b\\", out: "link"},
+		{name: "dcs", in: "\x1bPq#0;2;0;0;0#1~~\x1b\\after", out: "after"},
+		{name: "apc and pm", in: "\x1b_apc\x1b\\a\x1b^pm\ab", out: "ab"},
+		{name: "two byte escape", in: "\x1b7saved\x1b8", out: "saved"},
+		{name: "escape before multibyte rune", in: "\x1bébc", out: "bc"},
+		{name: "c0 controls", in: "a\x00b\x07c\x08d\te", out: "abcde"},
+		{name: "c1 controls", in: "a\u009b31mb\u0085c\u009dd", out: "a31mbcd"},
+		{name: "unicode", in: "✓ done 日本", out: "✓ done 日本"},
+		{name: "lone escape", in: "text\x1b", out: "text"},
+		{name: "unterminated csi", in: "text\x1b[31", out: "text"},
+		{name: "unterminated osc", in: "text\x1b]0;title", out: "text"},
+		{name: "unterminated osc with trailing escape", in: "text\x1b]0;title\x1b", out: "text"},
+		{name: "csi aborted by escape", in: "\x1b[31\x1b[32mgreen", out: "\x1b[32mgreen" + sgrReset},
+	}
+	for _, test := range table {
+		t.Run(test.name, func(t *testing.T) {
+			require.Equal(t, test.out, formatTerminalText(test.in))
+		})
+	}
+}
+
+func TestTruncateTerminalText(t *testing.T) {
+	text := formatTerminalText("\x1b[31mred\x1b[0m and plain")
+	require.Equal(t, 13, terminalTextWidth(text))
+	require.Equal(t, "\x1b[31mred\x1b[0m an"+sgrReset, truncateTerminalText(text, 6))
+	require.Equal(t, "\x1b[31mre"+sgrReset, truncateTerminalText(text, 2))
+	require.Equal(t, text, truncateTerminalText(text, 13))
+	require.Equal(t, "plain", truncateTerminalText("plain text", 5))
+}
@@ -5,7 +5,6 @@ import (
 	"io"
 	"strings"
 	"time"
-	"unicode/utf8"
 
 	"github.com/chelnak/ysmrr/pkg/tput"
 
@@ -20,6 +19,7 @@ type ExecElement struct {
 	start        time.Time
 	runTime      time.Duration
 	complete     bool
+	cancelled    bool
 	message      string
 	exitCode     int32
 	err          string
@@ -70,7 +70,9 @@ func (e *ExecElement) Render(writer io.Writer, width int) {
 
 	var text string
 	if e.complete {
-		if e.exitCode == 0 && e.err == "" {
+		if e.cancelled {
+			text = fmt.Sprintf(" ✗ %s: cancelled (%s)\r\n", displayName, runTime)
+		} else if e.exitCode == 0 && e.err == "" {
 			text = fmt.Sprintf(" ✓ %s (%s)\r\n", displayName, runTime)
 		} else {
 			if e.err == "" {
@@ -80,16 +82,16 @@ func (e *ExecElement) Render(writer io.Writer, width int) {
 		}
 	} else {
 		maxMessageWidth := width - len(e.spinnerChars[e.spinnerFrame]) - len(displayName) - len(runTime) - 9
-		message := formatUntrustedText(e.message)
-		if len(message) > maxMessageWidth {
-			message = message[:maxMessageWidth]
+		message := formatTerminalText(e.message)
+		if terminalTextWidth(message) > maxMessageWidth {
+			message = truncateTerminalText(message, maxMessageWidth)
 		}
-		padding := maxMessageWidth - len(message)
+		padding := max(maxMessageWidth-terminalTextWidth(message), 0)
 		text = fmt.Sprintf(" %s %s: %s%s     %s\r\n", e.spinnerChars[e.spinnerFrame], displayName, message, strings.Repeat(" ", padding), runTime)
 	}
 
-	if utf8.RuneCountInString(text) > width { // TODO use this for all strlen
-		text = text[:width]
+	if terminalTextWidth(text) > width {
+		text = truncateTerminalText(text, width)
 	}
 	tput.ClearLine(writer)
 	fmt.Fprint(writer, text)
@@ -106,3 +108,9 @@ func (e *ExecElement) Complete(exitCode int32, err string) {
 	e.complete = true
 	e.ui.notifyUpdate()
 }
+
+func (e *ExecElement) Cancel() {
+	e.cancelled = true
+	e.complete = true
+	e.ui.notifyUpdate()
+}
@@ -153,6 +153,10 @@ func (ui *Manager) onEventCallback(event *event.Event) {
 		withElement(ui, ui.runtimeIDToTenderID[p.RuntimeId], func(ele *RuntimeElement) {
 			ele.EndOpening()
 		})
+	case *builtinv1.ImagePullProgressEvent:
+		withElement(ui, ui.runtimeIDToTenderID[p.RuntimeId], func(ele *RuntimeElement) {
+			ele.SetPullProgress(p)
+		})
 	case *builtinv1.RuntimeCloseEndEvent:
 		withElement(ui, ui.runtimeIDToTenderID[p.RuntimeId], func(ele *RuntimeElement) {
 			switch s := p.Status.(type) {
@@ -175,26 +179,48 @@ func (ui *Manager) onEventCallback(event *event.Event) {
 		withElement(ui, p.ExecId, func(ele *ExecElement) {
 			switch s := p.Status.(type) {
 			case *builtinv1.ExecEndEvent_Result:
-				ele.Complete(s.Result.ExitCode, "")
+				if s.Result.TimedOut {
+					ele.Complete(s.Result.ExitCode, "timed out")
+				} else {
+					ele.Complete(s.Result.ExitCode, "")
+				}
 			case *builtinv1.ExecEndEvent_Error:
 				ele.Complete(-1, s.Error.Message)
+			case *builtinv1.ExecEndEvent_Cancelled:
+				ele.Cancel()
 			}
 		})
+	case *builtinv1.BuildPausedEvent:
+		withElement(ui, ui.runtimeIDToTenderID[p.RuntimeId], func(ele *RuntimeElement) {
+			ele.AddChildElement(NewPauseElement(ui, p.PauseId, p.RuntimeId, p.Reason, p.Timeout.AsDuration()))
+		})
+	case *builtinv1.BuildResumedEvent:
+		withElement(ui, p.PauseId, func(ele *PauseElement) {
+			ele.Complete(p.Aborted, p.TimedOut)
+		})
 	case *builtinv1.ImportStartEvent:
 		withElement(ui, ui.runtimeIDToTenderID[p.RuntimeId], func(ele *RuntimeElement) {
 			ele.StartImport()
 		})
+	case *builtinv1.ImportProgressEvent:
+		withElement(ui, ui.runtimeIDToTenderID[p.RuntimeId], func(ele *RuntimeElement) {
+			ele.SetImportProgress(p.ImportId, p.Progress)
+		})
 	case *builtinv1.ImportEndEvent:
 		withElement(ui, ui.runtimeIDToTenderID[p.RuntimeId], func(ele *RuntimeElement) {
-			ele.EndImport()
+			ele.EndImport(p.ImportId)
 		})
 	case *builtinv1.ExportStartEvent:
 		withElement(ui, ui.runtimeIDToTenderID[p.RuntimeId], func(ele *RuntimeElement) {
 			ele.StartExport()
 		})
+	case *builtinv1.ExportProgressEvent:
+		withElement(ui, ui.runtimeIDToTenderID[p.RuntimeId], func(ele *RuntimeElement) {
+			ele.SetExportProgress(p.ExportId, p.Progress)
+		})
 	case *builtinv1.ExportEndEvent:
 		withElement(ui, ui.runtimeIDToTenderID[p.RuntimeId], func(ele *RuntimeElement) {
-			ele.EndExport()
+			ele.EndExport(p.ExportId)
 		})
 	case *builtinv1.StdoutEvent:
 		switch s := p.Source.Source.(type) {
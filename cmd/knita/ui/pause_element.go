@@ -0,0 +1,80 @@
+package ui
+
+import (
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/chelnak/ysmrr/pkg/tput"
+)
+
+type PauseElement struct {
+	ui        *Manager
+	pauseID   string
+	runtimeID string
+	reason    string
+	deadline  time.Time
+	remaining time.Duration
+	complete  bool
+	aborted   bool
+	timedOut  bool
+}
+
+func NewPauseElement(ui *Manager, pauseID string, runtimeID string, reason string, timeout time.Duration) *PauseElement {
+	return &PauseElement{
+		ui:        ui,
+		pauseID:   pauseID,
+		runtimeID: runtimeID,
+		reason:    reason,
+		deadline:  time.Now().Add(timeout),
+		remaining: timeout,
+	}
+}
+
+func (e *PauseElement) ID() string {
+	return e.pauseID
+}
+
+func (e *PauseElement) Update(fc int) {
+	if !e.complete {
+		remaining := time.Until(e.deadline).Round(time.Second)
+		if e.remaining != remaining {
+			e.remaining = remaining
+			e.ui.notifyUpdate()
+		}
+	}
+}
+
+func (e *PauseElement) Height() int {
+	return 1
+}
+
+func (e *PauseElement) Render(writer io.Writer, width int) {
+	reason := formatUntrustedText(e.reason)
+	var text string
+	if e.complete {
+		switch {
+		case e.aborted:
+			text = fmt.Sprintf(" ✗ paused: %s: aborted\r\n", reason)
+		case e.timedOut:
+			text = fmt.Sprintf(" ▶ paused: %s: resumed after timeout\r\n", reason)
+		default:
+			text = fmt.Sprintf(" ▶ paused: %s: resumed\r\n", reason)
+		}
+	} else {
+		text = fmt.Sprintf(" ⏸ paused: %s: run 'knita attach %s' to inspect, then 'knita resume' or 'knita abort' (resumes in %s)\r\n",
+			reason, e.runtimeID, max(e.remaining, 0))
+	}
+	if terminalTextWidth(text) > width {
+		text = truncateTerminalText(text, width)
+	}
+	tput.ClearLine(writer)
+	fmt.Fprint(writer, text)
+}
+
+func (e *PauseElement) Complete(aborted bool, timedOut bool) {
+	e.aborted = aborted
+	e.timedOut = timedOut
+	e.complete = true
+	e.ui.notifyUpdate()
+}
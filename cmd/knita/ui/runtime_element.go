@@ -4,10 +4,12 @@ import (
 	"fmt"
 	"io"
 	"strings"
+	"time"
 	"unicode/utf8"
 
 	"github.com/chelnak/ysmrr/pkg/tput"
 
+	builtinv1 "github.com/knita-io/knita/api/events/builtin/v1"
 	executorv1 "github.com/knita-io/knita/api/executor/v1"
 )
 
@@ -22,13 +24,25 @@ type RuntimeElement struct {
 	err            string
 	tendering      bool
 	opening        bool
+	pull           *builtinv1.ImagePullProgressEvent
 	currentExecs   int
 	currentImports int
 	currentExports int
+	// imports and exports are the latest progress of the in progress imports and exports, by ID.
+	imports map[string]*builtinv1.TransferProgress
+	exports map[string]*builtinv1.TransferProgress
 }
 
 func NewRuntimeElement(ui *Manager, tenderID string, opts *executorv1.RuntimeOpts) *RuntimeElement {
-	return &RuntimeElement{ui: ui, tenderID: tenderID, opts: opts, height: 1, ElementContainer: NewElementContainer(ui)}
+	return &RuntimeElement{
+		ui:               ui,
+		tenderID:         tenderID,
+		opts:             opts,
+		height:           1,
+		ElementContainer: NewElementContainer(ui),
+		imports:          make(map[string]*builtinv1.TransferProgress),
+		exports:          make(map[string]*builtinv1.TransferProgress),
+	}
 }
 
 func (e *RuntimeElement) ID() string {
@@ -61,24 +75,37 @@ func (e *RuntimeElement) Render(writer io.Writer, width int) {
 			states = append(states, "locating")
 		}
 		if e.opening {
-			states = append(states, "provisioning")
+			if pull := formatPullProgress(e.pull); pull != "" {
+				states = append(states, fmt.Sprintf("provisioning (%s)", pull))
+			} else {
+				states = append(states, "provisioning")
+			}
 		}
 		if e.currentExecs > 0 {
 			states = append(states, "executing")
 		}
 		if e.currentImports > 0 {
-			states = append(states, "importing")
+			if progress := formatTransferProgress(e.imports); progress != "" {
+				states = append(states, fmt.Sprintf("importing %s", progress))
+			} else {
+				states = append(states, "importing")
+			}
 		}
 		if e.currentExports > 0 {
-			states = append(states, "exporting")
+			if progress := formatTransferProgress(e.exports); progress != "" {
+				states = append(states, fmt.Sprintf("exporting %s", progress))
+			} else {
+				states = append(states, "exporting")
+			}
 		}
 		if len(states) == 0 {
 			states = append(states, "idle")
 		}
 		text = fmt.Sprintf("%s: %s\r\n", displayName, strings.Join(states, ", "))
 	}
-	if utf8.RuneCountInString(text) > width {
-		text = text[:width]
+	if line := strings.TrimSuffix(text, "\r\n"); utf8.RuneCountInString(line) > width {
+		// Truncate by runes, as progress bars are multibyte, and keep the line ending.
+		text = string([]rune(line)[:width]) + "\r\n"
 	}
 	tput.ClearLine(writer)
 	fmt.Fprint(writer, text)
@@ -102,6 +129,12 @@ func (e *RuntimeElement) StartOpening() {
 
 func (e *RuntimeElement) EndOpening() {
 	e.opening = false
+	e.pull = nil
+	e.ui.notifyUpdate()
+}
+
+func (e *RuntimeElement) SetPullProgress(pull *builtinv1.ImagePullProgressEvent) {
+	e.pull = pull
 	e.ui.notifyUpdate()
 }
 
@@ -120,8 +153,14 @@ func (e *RuntimeElement) StartImport() {
 	e.ui.notifyUpdate()
 }
 
-func (e *RuntimeElement) EndImport() {
+func (e *RuntimeElement) SetImportProgress(importID string, progress *builtinv1.TransferProgress) {
+	e.imports[importID] = progress
+	e.ui.notifyUpdate()
+}
+
+func (e *RuntimeElement) EndImport(importID string) {
 	e.currentImports--
+	delete(e.imports, importID)
 	e.ui.notifyUpdate()
 }
 
@@ -130,8 +169,14 @@ func (e *RuntimeElement) StartExport() {
 	e.ui.notifyUpdate()
 }
 
-func (e *RuntimeElement) EndExport() {
+func (e *RuntimeElement) SetExportProgress(exportID string, progress *builtinv1.TransferProgress) {
+	e.exports[exportID] = progress
+	e.ui.notifyUpdate()
+}
+
+func (e *RuntimeElement) EndExport(exportID string) {
 	e.currentExports--
+	delete(e.exports, exportID)
 	e.ui.notifyUpdate()
 }
 
@@ -145,3 +190,88 @@ func (e *RuntimeElement) Complete(err string) {
 	e.complete = true
 	e.ui.notifyUpdate()
 }
+
+// formatPullProgress returns a one line summary of an in progress image pull,
+// or an empty string if there is no pull in progress.
+func formatPullProgress(pull *builtinv1.ImagePullProgressEvent) string {
+	if pull == nil || len(pull.Layers) == 0 {
+		return ""
+	}
+	var done int
+	var current, total int64
+	for _, layer := range pull.Layers {
+		switch layer.Status {
+		case "Pull complete", "Already exists":
+			done++
+		case "Downloading":
+			current += layer.Current
+			total += layer.Total
+		}
+	}
+	if done == len(pull.Layers) {
+		return ""
+	}
+	text := fmt.Sprintf("pulling %s: %d/%d layers", formatUntrustedText(pull.ImageUri), done, len(pull.Layers))
+	if total > 0 {
+		text += fmt.Sprintf(", %s/%s", formatBytes(current), formatBytes(total))
+	}
+	return text
+}
+
+// transferProgressBarWidth is the width of a transfer's progress bar, in characters.
+const transferProgressBarWidth = 20
+
+// formatTransferProgress returns a one line summary of in progress transfers, with a progress bar and throughput,
+// or an empty string if none of them have reported progress. Concurrent transfers are summarized together.
+func formatTransferProgress(transfers map[string]*builtinv1.TransferProgress) string {
+	if len(transfers) == 0 {
+		return ""
+	}
+	var filesDone, filesTotal uint32
+	var bytesDone, bytesTotal uint64
+	var throughput float64
+	for _, progress := range transfers {
+		filesDone += progress.FilesDone
+		filesTotal += progress.FilesTotal
+		bytesDone += progress.BytesDone
+		bytesTotal += progress.BytesTotal
+		if elapsed := progress.Elapsed.AsDuration(); elapsed >= time.Second {
+			throughput += float64(progress.BytesDone) / elapsed.Seconds()
+		}
+	}
+	var bar string
+	var parts []string
+	if filesTotal > 0 {
+		// Bytes are a better measure of the time remaining, unless the files are empty.
+		fraction := float64(filesDone) / float64(filesTotal)
+		if bytesTotal > 0 {
+			fraction = float64(bytesDone) / float64(bytesTotal)
+		}
+		fraction = min(max(fraction, 0), 1)
+		filled := int(fraction * transferProgressBarWidth)
+		bar = fmt.Sprintf("%s%s %d%% ", strings.Repeat("█", filled), strings.Repeat("░", transferProgressBarWidth-filled),
+			int(fraction*100))
+		parts = append(parts,
+			fmt.Sprintf("%d/%d files", filesDone, filesTotal),
+			fmt.Sprintf("%s/%s", formatBytes(int64(bytesDone)), formatBytes(int64(bytesTotal))))
+	} else {
+		parts = append(parts, fmt.Sprintf("%d files", filesDone), formatBytes(int64(bytesDone)))
+	}
+	if throughput > 0 {
+		parts = append(parts, fmt.Sprintf("%s/s", formatBytes(int64(throughput))))
+	}
+	return fmt.Sprintf("%s(%s)", bar, strings.Join(parts, ", "))
+}
+
+func formatBytes(n int64) string {
+	const unit = 1000
+	if n < unit {
+		return fmt.Sprintf("%dB", n)
+	}
+	div, exp := int64(unit), 0
+	for m := n / unit; m >= unit; m /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f%cB", float64(n)/float64(div), "kMGTPE"[exp])
+}
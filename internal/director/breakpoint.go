@@ -0,0 +1,103 @@
+package director
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	directorv1 "github.com/knita-io/knita/api/director/v1"
+	builtinv1 "github.com/knita-io/knita/api/events/builtin/v1"
+)
+
+// DefaultPauseTimeout is the maximum time a build remains paused at a breakpoint before resuming automatically.
+const DefaultPauseTimeout = time.Hour
+
+// breakpoints coordinates pausing a build at breakpoints, so that runtimes can be inspected,
+// until the build is resumed or aborted, or the pause times out.
+type breakpoints struct {
+	log       *Log
+	buildID   string
+	timeout   time.Duration
+	onFailure bool
+	mu        sync.Mutex
+	paused    map[string]chan bool
+}
+
+func newBreakpoints(log *Log, buildID string, timeout time.Duration, onFailure bool) *breakpoints {
+	if timeout <= 0 {
+		timeout = DefaultPauseTimeout
+	}
+	return &breakpoints{
+		log:       log,
+		buildID:   buildID,
+		timeout:   timeout,
+		onFailure: onFailure,
+		paused:    map[string]chan bool{},
+	}
+}
+
+// Pause publishes a BuildPausedEvent and blocks until the build is resumed or aborted,
+// the pause times out, or ctx is cancelled.
+func (b *breakpoints) Pause(ctx context.Context, runtimeID string, reason string, timeout time.Duration) (*directorv1.PauseResponse, error) {
+	pauseID := uuid.New().String()
+	resumeC := make(chan bool, 1)
+	b.mu.Lock()
+	b.paused[pauseID] = resumeC
+	b.mu.Unlock()
+	defer func() {
+		b.mu.Lock()
+		delete(b.paused, pauseID)
+		b.mu.Unlock()
+	}()
+
+	instructions := fmt.Sprintf("Attach a shell with: knita attach %s\nResume the build with: knita resume\nAbort the build with: knita abort", runtimeID)
+	b.log.Publish(&builtinv1.BuildPausedEvent{
+		BuildId:      b.buildID,
+		PauseId:      pauseID,
+		RuntimeId:    runtimeID,
+		Reason:       reason,
+		Instructions: instructions,
+		Timeout:      durationpb.New(timeout),
+	})
+	b.log.Printf("Build paused: %s\n%s\nThe build will resume automatically in %s", reason, instructions, timeout)
+
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+	res := &directorv1.PauseResponse{}
+	select {
+	case abort := <-resumeC:
+		res.Aborted = abort
+	case <-timer.C:
+		res.TimedOut = true
+	case <-ctx.Done():
+		b.log.Publish(&builtinv1.BuildResumedEvent{BuildId: b.buildID, PauseId: pauseID, Aborted: true})
+		return nil, fmt.Errorf("error waiting for build to resume: %w", ctx.Err())
+	}
+	b.log.Publish(&builtinv1.BuildResumedEvent{BuildId: b.buildID, PauseId: pauseID, Aborted: res.Aborted, TimedOut: res.TimedOut})
+	switch {
+	case res.Aborted:
+		b.log.Printf("Build aborted")
+	case res.TimedOut:
+		b.log.Printf("Build resumed; pause timed out after %s", timeout)
+	default:
+		b.log.Printf("Build resumed")
+	}
+	return res, nil
+}
+
+// Resume resumes all paused runtimes, or aborts them if abort is true.
+// Returns the number of paused runtimes that were resumed or aborted.
+func (b *breakpoints) Resume(abort bool) int {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	n := len(b.paused)
+	for pauseID, resumeC := range b.paused {
+		resumeC <- abort
+		delete(b.paused, pauseID)
+	}
+	return n
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"net"
 	stdruntime "runtime"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/pbnjay/memory"
@@ -25,15 +26,58 @@ type Build struct {
 	buildID     string
 	broker      brokerv1.BrokerClient
 	localWorkFS file.WriteFS
+	breakpoints *breakpoints
 }
 
-func NewBuild(syslog *zap.SugaredLogger, log *Log, buildID string, broker brokerv1.BrokerClient, localWorkFS file.WriteFS) *Build {
+type buildConfig struct {
+	pauseOnFailure bool
+	pauseTimeout   time.Duration
+}
+
+type BuildOpt interface {
+	Apply(*buildConfig)
+}
+
+type pauseOnFailureOpt struct {
+	enabled bool
+}
+
+func (o *pauseOnFailureOpt) Apply(config *buildConfig) {
+	config.pauseOnFailure = o.enabled
+}
+
+// WithPauseOnFailure pauses the build whenever an exec fails, keeping the runtime alive
+// so it can be inspected, until the build is resumed or aborted.
+func WithPauseOnFailure(enabled bool) BuildOpt {
+	return &pauseOnFailureOpt{enabled: enabled}
+}
+
+type pauseTimeoutOpt struct {
+	timeout time.Duration
+}
+
+func (o *pauseTimeoutOpt) Apply(config *buildConfig) {
+	config.pauseTimeout = o.timeout
+}
+
+// WithPauseTimeout sets the maximum time the build remains paused at a breakpoint
+// before resuming automatically. Defaults to DefaultPauseTimeout.
+func WithPauseTimeout(timeout time.Duration) BuildOpt {
+	return &pauseTimeoutOpt{timeout: timeout}
+}
+
+func NewBuild(syslog *zap.SugaredLogger, log *Log, buildID string, broker brokerv1.BrokerClient, localWorkFS file.WriteFS, opts ...BuildOpt) *Build {
+	config := &buildConfig{}
+	for _, opt := range opts {
+		opt.Apply(config)
+	}
 	return &Build{
 		syslog:      syslog.Named("director"),
 		log:         log,
 		buildID:     buildID,
 		broker:      broker,
 		localWorkFS: localWorkFS,
+		breakpoints: newBreakpoints(log, buildID, config.pauseTimeout, config.pauseOnFailure),
 	}
 }
 
@@ -47,6 +91,12 @@ func (c *Build) Log() *Log {
 	return c.log
 }
 
+// Resume resumes all paused runtimes in the build, or aborts them if abort is true.
+// Returns the number of paused runtimes that were resumed or aborted.
+func (c *Build) Resume(abort bool) int {
+	return c.breakpoints.Resume(abort)
+}
+
 // Run executes fn, wrapping it in build start and end events.
 func (c *Build) Run(fn func() error) error {
 	info := &builtinv1.DirectorInfo{
@@ -88,7 +138,7 @@ func (c *Build) OpenRuntime(ctx context.Context, opts *executorv1.RuntimeOpts) (
 		return nil, err
 	}
 	c.syslog.Info("Connected to executor")
-	r := newRuntime(c.syslog, c.log, c.buildID, contract.RuntimeId, rClient, c.localWorkFS, contract.Opts)
+	r := newRuntime(c.syslog, c.log, c.buildID, contract.RuntimeId, rClient, c.localWorkFS, contract.Opts, c.breakpoints)
 	err = r.Open(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("error creating runtime: %w", err)
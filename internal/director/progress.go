@@ -0,0 +1,68 @@
+package director
+
+import (
+	"sync"
+	"time"
+
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	builtinv1 "github.com/knita-io/knita/api/events/builtin/v1"
+)
+
+// transferProgressInterval is the minimum interval between transfer progress events.
+const transferProgressInterval = 250 * time.Millisecond
+
+// transferProgress tracks the progress of an import or export, which may be split across concurrent streams,
+// and periodically publishes it. Progress is published while mu is held, so it is published in order.
+type transferProgress struct {
+	publish     func(progress *builtinv1.TransferProgress)
+	mu          sync.Mutex
+	start       time.Time
+	lastPublish time.Time
+	filesDone   int
+	bytesDone   uint64
+	filesTotal  int
+	bytesTotal  uint64
+}
+
+func newTransferProgress(publish func(progress *builtinv1.TransferProgress)) *transferProgress {
+	return &transferProgress{publish: publish, start: time.Now()}
+}
+
+// SetTotals sets the number and combined size of the files to transfer.
+func (p *transferProgress) SetTotals(files int, bytes uint64) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.filesTotal = files
+	p.bytesTotal = bytes
+}
+
+// Add records that files and bytes have been transferred, and publishes the progress if it has not been
+// published within the interval. It is a file.ProgressFunc.
+func (p *transferProgress) Add(files int, bytes uint64) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.filesDone += files
+	p.bytesDone += bytes
+	if time.Since(p.lastPublish) >= transferProgressInterval {
+		p.publishLocked()
+	}
+}
+
+// Publish publishes the current progress.
+func (p *transferProgress) Publish() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.publishLocked()
+}
+
+func (p *transferProgress) publishLocked() {
+	p.lastPublish = time.Now()
+	p.publish(&builtinv1.TransferProgress{
+		FilesDone:  uint32(p.filesDone),
+		BytesDone:  p.bytesDone,
+		FilesTotal: uint32(p.filesTotal),
+		BytesTotal: p.bytesTotal,
+		Elapsed:    durationpb.New(p.lastPublish.Sub(p.start)),
+	})
+}
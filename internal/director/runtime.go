@@ -5,12 +5,19 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"io/fs"
 	"os"
 	"path/filepath"
+	"sync"
 	"time"
 
 	"github.com/google/uuid"
 	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
 
 	directorv1 "github.com/knita-io/knita/api/director/v1"
 	builtinv1 "github.com/knita-io/knita/api/events/builtin/v1"
@@ -20,7 +27,14 @@ import (
 	"github.com/knita-io/knita/internal/log"
 )
 
-const heartbeatTimeout = time.Second * 5
+const (
+	heartbeatTimeout     = time.Second * 5
+	maxHeartbeatInterval = time.Second * 40
+	// pauseSuspendGrace is added to a pause's timeout when suspending the runtime's deadline,
+	// so the runtime outlives the pause.
+	pauseSuspendGrace = time.Minute
+	stdinChunkSize    = 32 * 1024
+)
 
 type Runtime struct {
 	syslog              *zap.SugaredLogger
@@ -34,6 +48,12 @@ type Runtime struct {
 	cancel              context.CancelFunc
 	remoteWorkDirectory string
 	remoteSysInfo       *executorv1.SystemInfo
+	remoteImageDigest   string
+	remoteCompressions  []executorv1.Compression
+	remoteMaxStreams    int
+	breakpoints         *breakpoints
+	mu                  sync.Mutex
+	suspendUntil        time.Time
 }
 
 func newRuntime(
@@ -43,7 +63,8 @@ func newRuntime(
 	runtimeID string,
 	client executorv1.ExecutorClient,
 	localWorkFS file.WriteFS,
-	opts *executorv1.RuntimeOpts) *Runtime {
+	opts *executorv1.RuntimeOpts,
+	breakpoints *breakpoints) *Runtime {
 
 	return &Runtime{
 		syslog:      syslog.Named("runtime").With("runtime_id", runtimeID),
@@ -53,6 +74,7 @@ func newRuntime(
 		client:      client,
 		localWorkFS: localWorkFS,
 		opts:        opts,
+		breakpoints: breakpoints,
 	}
 }
 
@@ -78,63 +100,259 @@ func (c *Runtime) SysInfo() *executorv1.SystemInfo {
 
 // Import files and directories from the local filesystem to the remote runtime.
 // Events associated with the import will be published to the configured event stream.
-func (c *Runtime) Import(ctx context.Context, opts *directorv1.ImportOpts) error {
+func (c *Runtime) Import(ctx context.Context, opts *directorv1.ImportOpts) (*directorv1.ImportResponse, error) {
 	importID := uuid.New().String()
 	c.log.Publish(&builtinv1.ImportStartEvent{RuntimeId: c.runtimeID, ImportId: importID}, logOptsFromMeta(opts)...)
-	return WithEndEvent(func() error {
-		stream, err := c.client.Import(ctx)
-		if err != nil {
-			return fmt.Errorf("error opening import stream: %w", err)
-		}
-		c.syslog.Infow("Import stream opened", "src", opts.SrcPath)
-		sendCallback := func(header *executorv1.FileTransferHeader) {
-			if header.IsDir {
-				c.log.Printf("Imported directory src=%s, dest=%s, mode=%s", header.SrcPath, header.DestPath, os.FileMode(header.Mode))
-			} else {
-				c.log.Printf("Imported file src=%s, dest=%s, mode=%s, size=%d", header.SrcPath, header.DestPath, os.FileMode(header.Mode), header.Size)
+	return WithUnaryEndEvent(func() (*directorv1.ImportResponse, error) {
+		srcFS := c.localWorkFS.ReadFS()
+		if opts.Archive {
+			// The archive is extracted to a staging directory, whose contents are then imported.
+			staging, err := c.extractArchive(opts.SrcPath)
+			if err != nil {
+				return nil, err
 			}
+			defer removeStaging(staging)
+			srcFS = file.WriteDirFS(staging).ReadFS()
+			opts = proto.Clone(opts).(*directorv1.ImportOpts)
+			opts.SrcPath = "."
 		}
-		skipCallback := func(path string, isDir bool, excludedBy string) {
-			if isDir {
-				c.log.Printf("Skipped directory import src=%s, excluded_by=%s", path, excludedBy)
-			} else {
-				c.log.Printf("Skipped file import src=%s, excluded_by=%s", path, excludedBy)
+		res, err := c.importFiles(ctx, importID, srcFS, opts, true)
+		if status.Code(err) == codes.DataLoss {
+			// A blob in the executor's blob cache may have been corrupt; it has been evicted.
+			c.log.Printf("Retrying import without the executor's blob cache: %v", err)
+			res, err = c.importFiles(ctx, importID, srcFS, opts, false)
+		}
+		return res, err
+	}, func(res *directorv1.ImportResponse) {
+		c.log.Publish(&builtinv1.ImportEndEvent{RuntimeId: c.runtimeID, ImportId: importID,
+			Status: &builtinv1.ImportEndEvent_Result{Result: &builtinv1.ImportResult{
+				SkippedFiles: res.SkippedFiles,
+				SkippedBytes: res.SkippedBytes,
+				DeletedFiles: res.DeletedFiles,
+				CachedFiles:  res.CachedFiles,
+				CachedBytes:  res.CachedBytes,
+			}}})
+	}, func(err error) {
+		c.log.Publish(&builtinv1.ImportEndEvent{RuntimeId: c.runtimeID, ImportId: importID,
+			Status: &builtinv1.ImportEndEvent_Error{Error: &builtinv1.Error{Message: err.Error()}}})
+	})
+}
+
+// extractArchive extracts the local archive at src to a new staging directory, and returns the directory.
+func (c *Runtime) extractArchive(src string) (string, error) {
+	format, err := file.ArchiveFormatFromPath(src)
+	if err != nil {
+		return "", err
+	}
+	staging, err := os.MkdirTemp("", "knita-import-archive-*")
+	if err != nil {
+		return "", fmt.Errorf("error creating archive staging dir: %w", err)
+	}
+	err = file.ExtractArchive(c.localWorkFS.ReadFS(), filepath.ToSlash(filepath.Clean(src)), format, file.WriteDirFS(staging))
+	if err != nil {
+		removeStaging(staging)
+		return "", fmt.Errorf("error extracting archive %s: %w", src, err)
+	}
+	c.log.Printf("Extracted %s archive %s", format, src)
+	return staging, nil
+}
+
+// removeStaging removes an archive staging directory. Extracted directories keep the modes they have in the archive,
+// so their owner is first given full access to them, as directories that deny it write access cannot be removed from.
+func removeStaging(staging string) {
+	filepath.WalkDir(staging, func(path string, d fs.DirEntry, err error) error {
+		if err == nil && d.IsDir() {
+			os.Chmod(path, 0700)
+		}
+		return nil
+	})
+	os.RemoveAll(staging)
+}
+
+// importFiles performs an import of the files in srcFS. If useBlobs is true, files whose content the executor
+// holds in its blob cache are sent by reference rather than transferred.
+func (c *Runtime) importFiles(ctx context.Context, importID string, srcFS fs.FS, opts *directorv1.ImportOpts, useBlobs bool) (*directorv1.ImportResponse, error) {
+	manifest, err := c.manifest(ctx, file.ManifestRoot(opts.SrcPath, opts.DestPath))
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	sendCallback := func(header *executorv1.FileTransferHeader) {
+		if header.Delete {
+			c.log.Printf("Deleted extraneous path dest=%s", header.DestPath)
+		} else if header.IsDir {
+			c.log.Printf("Imported directory src=%s, dest=%s, mode=%s", header.SrcPath, header.DestPath, os.FileMode(header.Mode))
+		} else {
+			c.log.Printf("Imported file src=%s, dest=%s, mode=%s, size=%d", header.SrcPath, header.DestPath, os.FileMode(header.Mode), header.Size)
+		}
+	}
+	skipCallback := func(path string, isDir bool, excludedBy string) {
+		if isDir {
+			c.log.Printf("Skipped directory import src=%s, excluded_by=%s", path, excludedBy)
+		} else {
+			c.log.Printf("Skipped file import src=%s, excluded_by=%s", path, excludedBy)
+		}
+	}
+	sendOpts := []file.SendOpt{
+		file.WithSendCallback(sendCallback),
+		file.WithSkipCallback(skipCallback)}
+	if len(opts.Excludes) > 0 {
+		sendOpts = append(sendOpts, file.WithExcludes(opts.Excludes))
+	}
+	if len(opts.Includes) > 0 {
+		sendOpts = append(sendOpts, file.WithIncludes(opts.Includes))
+	}
+	if opts.Gitignore {
+		sendOpts = append(sendOpts, file.WithIgnoreFiles(file.GitIgnoreFile, file.KnitaIgnoreFile))
+	} else {
+		sendOpts = append(sendOpts, file.WithIgnoreFiles(file.KnitaIgnoreFile))
+	}
+	if opts.DestPath != "" {
+		sendOpts = append(sendOpts, file.WithDest(opts.DestPath))
+	}
+	if opts.StrictSymlinks {
+		sendOpts = append(sendOpts, file.WithStrictSymlinks())
+	}
+	// Executors that predate compression (and build context imports, which precede the runtime
+	// being opened) advertise no codecs, so files are sent uncompressed.
+	sendOpts = append(sendOpts, file.WithCompression(file.NegotiateCompression(c.remoteCompressions)))
+	if manifest != nil {
+		sendOpts = append(sendOpts, file.WithManifest(manifest, opts.DeleteExtraneous),
+			file.WithManifestDigests(func(paths []string) ([]*executorv1.FileDigest, error) {
+				return c.manifestDigests(ctx, paths)
+			}))
+	}
+	if useBlobs {
+		sendOpts = append(sendOpts, file.WithBlobs(func(digests [][]byte) ([][]byte, error) {
+			return c.haveBlobs(ctx, digests)
+		}))
+	}
+	progress := newTransferProgress(func(progress *builtinv1.TransferProgress) {
+		c.log.Publish(&builtinv1.ImportProgressEvent{RuntimeId: c.runtimeID, ImportId: importID, Progress: progress})
+	})
+	sendOpts = append(sendOpts, file.WithProgress(progress.Add))
+	// The files are counted up front, to report the import's progress against, and then sent without walking src again.
+	sender := file.NewSender(c.syslog, srcFS, nil, c.runtimeID, importID, sendOpts...)
+	files, size, err := sender.Count(opts.SrcPath)
+	if err != nil {
+		return nil, err
+	}
+	progress.SetTotals(files, size)
+	streams := c.importStreams(opts, files, size)
+	if streams > 1 {
+		c.log.Printf("Importing over %d concurrent streams", streams)
+	}
+	// The executor defers applying some directory modes until every stream of the import has closed.
+	streamsCtx := metadata.NewOutgoingContext(ctx, file.ImportStreamsMD(importID, streams))
+	importStreams := make([]executorv1.Executor_ImportClient, streams)
+	transports := make([]file.SendTransport, streams)
+	for i := range streams {
+		importStreams[i], err = c.client.Import(streamsCtx)
+		if err != nil {
+			return nil, fmt.Errorf("error opening import stream: %w", err)
+		}
+		transports[i] = importStreams[i]
+	}
+	c.syslog.Infow("Import streams opened", "src", opts.SrcPath, "streams", streams)
+	sendRes, err := sender.SendStreams(opts.SrcPath, transports...)
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			// The executor closed a stream early; receive its error.
+			for _, stream := range importStreams {
+				if _, recvErr := stream.CloseAndRecv(); recvErr != nil {
+					return nil, recvErr
+				}
 			}
 		}
-		sendOpts := []file.SendOpt{
-			file.WithSendCallback(sendCallback),
-			file.WithSkipCallback(skipCallback)}
-		if len(opts.Excludes) > 0 {
-			sendOpts = append(sendOpts, file.WithExcludes(opts.Excludes))
+		return nil, err
+	}
+	res := &executorv1.ImportResponse{}
+	for _, stream := range importStreams {
+		streamRes, err := stream.CloseAndRecv()
+		if err != nil {
+			return nil, err
 		}
-		if opts.DestPath != "" {
-			sendOpts = append(sendOpts, file.WithDest(opts.DestPath))
+		res.Files = append(res.Files, streamRes.Files...)
+	}
+	progress.Publish()
+	c.log.Printf("Verified %d imported files", len(res.Files))
+	if sendRes.SkippedFiles > 0 {
+		c.log.Printf("Skipped %d unchanged files (%d bytes)", sendRes.SkippedFiles, sendRes.SkippedBytes)
+	}
+	if sendRes.BlobFiles > 0 {
+		c.log.Printf("Materialized %d files (%d bytes) from the executor's blob cache", sendRes.BlobFiles, sendRes.BlobBytes)
+	}
+	return &directorv1.ImportResponse{
+		Files:        res.Files,
+		SkippedFiles: uint32(sendRes.SkippedFiles),
+		SkippedBytes: sendRes.SkippedBytes,
+		DeletedFiles: uint32(sendRes.DeletedFiles),
+		CachedFiles:  uint32(sendRes.BlobFiles),
+		CachedBytes:  sendRes.BlobBytes,
+	}, nil
+}
+
+// importStreams returns the number of concurrent streams to split an import of the given number and
+// combined size of files across.
+func (c *Runtime) importStreams(opts *directorv1.ImportOpts, files int, size uint64) int {
+	if opts.Streams > 0 {
+		return max(1, min(int(opts.Streams), c.remoteMaxStreams))
+	}
+	return file.DefaultStreams(files, size, c.remoteMaxStreams)
+}
+
+// haveBlobs returns the subset of digests whose blobs the executor holds in its blob cache.
+// Returns nil if the executor predates blob caches.
+func (c *Runtime) haveBlobs(ctx context.Context, digests [][]byte) ([][]byte, error) {
+	res, err := c.client.Blobs(ctx, &executorv1.BlobsRequest{RuntimeId: c.runtimeID, Sha256S: digests})
+	if err != nil {
+		if status.Code(err) == codes.Unimplemented {
+			return nil, nil
 		}
-		sender := file.NewSender(c.syslog, c.localWorkFS.ReadFS(), stream, c.runtimeID, importID, sendOpts...)
-		_, err = sender.Send(opts.SrcPath)
+		return nil, err
+	}
+	return res.Have, nil
+}
+
+// manifest requests a manifest of the files that already exist in the remote runtime at or below path.
+// Returns nil if the executor predates manifests, in which case every file is imported.
+func (c *Runtime) manifest(ctx context.Context, path string) ([]*executorv1.ManifestEntry, error) {
+	stream, err := c.client.Manifest(ctx, &executorv1.ManifestRequest{RuntimeId: c.runtimeID, Path: path})
+	if err != nil {
+		return nil, fmt.Errorf("error getting manifest: %w", err)
+	}
+	entries := []*executorv1.ManifestEntry{}
+	for {
+		res, err := stream.Recv()
 		if err != nil {
 			if errors.Is(err, io.EOF) {
-				_, err = stream.CloseAndRecv()
+				return entries, nil
 			}
-			return err
+			if status.Code(err) == codes.Unimplemented {
+				return nil, nil
+			}
+			return nil, fmt.Errorf("error getting manifest: %w", err)
 		}
-		_, err = stream.CloseAndRecv()
-		return err
-	}, func() {
-		c.log.Publish(&builtinv1.ImportEndEvent{RuntimeId: c.runtimeID, ImportId: importID,
-			Status: &builtinv1.ImportEndEvent_Result{Result: &builtinv1.ImportResult{}}})
-	}, func(err error) {
-		c.log.Publish(&builtinv1.ImportEndEvent{RuntimeId: c.runtimeID, ImportId: importID,
-			Status: &builtinv1.ImportEndEvent_Error{Error: &builtinv1.Error{Message: err.Error()}}})
-	})
+		entries = append(entries, res.Entries...)
+	}
+}
+
+// manifestDigests requests the digests of the files at paths in the remote runtime.
+func (c *Runtime) manifestDigests(ctx context.Context, paths []string) ([]*executorv1.FileDigest, error) {
+	res, err := c.client.ManifestDigests(ctx, &executorv1.ManifestDigestsRequest{RuntimeId: c.runtimeID, Paths: paths})
+	if err != nil {
+		return nil, err
+	}
+	return res.Files, nil
 }
 
 // Export files and directories from the remote runtime to the local filesystem.
 // Events associated with the export will be published to the configured event stream.
-func (c *Runtime) Export(ctx context.Context, opts *directorv1.ExportOpts) error {
+func (c *Runtime) Export(ctx context.Context, opts *directorv1.ExportOpts) (*directorv1.ExportResponse, error) {
 	exportID := uuid.New().String()
 	c.log.Publish(&builtinv1.ExportStartEvent{RuntimeId: c.runtimeID, ExportId: exportID}, logOptsFromMeta(opts)...)
-	return WithEndEvent(func() error {
+	return WithUnaryEndEvent(func() (*directorv1.ExportResponse, error) {
 		req := &executorv1.ExportRequest{
 			RuntimeId: c.runtimeID,
 			ExportId:  exportID,
@@ -143,34 +361,105 @@ func (c *Runtime) Export(ctx context.Context, opts *directorv1.ExportOpts) error
 				DestPath: opts.DestPath,
 				Excludes: opts.Excludes,
 			},
+			AcceptCompressions: file.SupportedCompressions,
+		}
+		dest := c.localWorkFS
+		var format file.ArchiveFormat
+		if opts.Archive {
+			// The files are received into a staging directory, which is then packed into the archive.
+			var err error
+			format, err = file.ArchiveFormatFromPath(opts.DestPath)
+			if err != nil {
+				return nil, err
+			}
+			staging, err := os.MkdirTemp("", "knita-export-archive-*")
+			if err != nil {
+				return nil, fmt.Errorf("error creating archive staging dir: %w", err)
+			}
+			defer os.RemoveAll(staging)
+			dest = file.WriteDirFS(staging)
+			req.Opts.DestPath = ""
+		}
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+		streams := 1
+		if opts.Streams > 0 {
+			streams = max(1, min(int(opts.Streams), c.remoteMaxStreams))
+			if streams > 1 {
+				req.StreamCount = uint32(streams)
+			}
+		} else if c.remoteMaxStreams > 1 {
+			// The executor chooses the number of streams, based on the files to export.
+			req.MaxStreams = uint32(c.remoteMaxStreams)
 		}
 		stream, err := c.client.Export(ctx, req)
 		if err != nil {
-			return fmt.Errorf("error opening export stream: %w", err)
+			return nil, fmt.Errorf("error opening export stream: %w", err)
 		}
 		c.syslog.Infow("Export stream opened", "src", req.SrcPath)
-		receivers := make(map[string]*file.Receiver)
-		for {
-			msg, err := stream.Recv()
-			if err != nil {
-				if errors.Is(err, io.EOF) {
-					// TODO verify all success?
-					return nil
+		header, err := stream.Header()
+		if err != nil {
+			return nil, fmt.Errorf("error receiving export header: %w", err)
+		}
+		if req.MaxStreams > 1 {
+			streams = min(file.StreamsFromHeader(header), c.remoteMaxStreams)
+		}
+		progress := newTransferProgress(func(progress *builtinv1.TransferProgress) {
+			c.log.Publish(&builtinv1.ExportProgressEvent{RuntimeId: c.runtimeID, ExportId: exportID, Progress: progress})
+		})
+		// Executors that predate progress reporting do not report the export's totals.
+		if files, size, ok := file.TotalsFromHeader(header); ok {
+			progress.SetTotals(files, size)
+		}
+		if streams > 1 {
+			c.log.Printf("Exporting over %d concurrent streams", streams)
+		}
+		recvOpts := []file.RecvOpt{file.WithRecvProgress(progress.Add)}
+		if opts.StrictSymlinks {
+			recvOpts = append(recvOpts, file.WithRecvStrictSymlinks())
+		}
+		// Directories are left accessible to their owner in archive staging directories, so they can be packed and removed.
+		dirModes := file.NewDirModes()
+		if !opts.Archive {
+			recvOpts = append(recvOpts, file.WithDirModes(dirModes))
+		}
+		files := make([][]*executorv1.FileDigest, streams)
+		err = parallel(streams, cancel, func(i int) error {
+			stream := stream
+			if i > 0 {
+				streamReq := proto.Clone(req).(*executorv1.ExportRequest)
+				streamReq.MaxStreams = 0
+				streamReq.StreamIndex = uint32(i)
+				streamReq.StreamCount = uint32(streams)
+				var err error
+				stream, err = c.client.Export(ctx, streamReq)
+				if err != nil {
+					return fmt.Errorf("error opening export stream: %w", err)
 				}
-				return fmt.Errorf("error receiving from stream: %w", err)
-			}
-			recv, ok := receivers[msg.FileId]
-			if !ok {
-				recv = file.NewReceiver(c.syslog, c.localWorkFS)
-				receivers[msg.FileId] = recv
 			}
-			err = recv.Next(msg)
-			if err != nil {
-				delete(receivers, msg.FileId)
-				return err
+			var err error
+			files[i], err = c.receiveExport(stream, dest, recvOpts)
+			return err
+		})
+		if applyErr := dirModes.Apply(dest); err == nil {
+			err = applyErr
+		}
+		if err != nil {
+			return nil, err
+		}
+		progress.Publish()
+		res := &directorv1.ExportResponse{}
+		for _, f := range files {
+			res.Files = append(res.Files, f...)
+		}
+		c.log.Printf("Verified %d exported files", len(res.Files))
+		if opts.Archive {
+			if err := c.writeArchive(opts.DestPath, format, dest.ReadFS()); err != nil {
+				return nil, err
 			}
 		}
-	}, func() {
+		return res, nil
+	}, func(*directorv1.ExportResponse) {
 		c.log.Publish(&builtinv1.ExportEndEvent{RuntimeId: c.runtimeID, ExportId: exportID,
 			Status: &builtinv1.ExportEndEvent_Result{Result: &builtinv1.ExportResult{}}})
 	}, func(err error) {
@@ -179,16 +468,108 @@ func (c *Runtime) Export(ctx context.Context, opts *directorv1.ExportOpts) error
 	})
 }
 
-// Exec executes a command inside the runtime.
+// writeArchive packs the files in fsys into a new archive at dest in the local work directory.
+func (c *Runtime) writeArchive(dest string, format file.ArchiveFormat, fsys fs.FS) error {
+	if err := c.localWorkFS.MkdirAll(filepath.Dir(dest), 0777); err != nil {
+		return fmt.Errorf("error making directory: %w", err)
+	}
+	f, err := c.localWorkFS.OpenFile(dest, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0644)
+	if err != nil {
+		return fmt.Errorf("error creating archive: %w", err)
+	}
+	if err := file.WriteArchive(f, format, fsys); err != nil {
+		f.Close()
+		return fmt.Errorf("error writing archive %s: %w", dest, err)
+	}
+	if err := f.Close(); err != nil {
+		return fmt.Errorf("error writing archive %s: %w", dest, err)
+	}
+	c.log.Printf("Packed exported files into %s archive %s", format, dest)
+	return nil
+}
+
+// receiveExport receives the files sent over an export stream into dest, with a receiver per file created with recvOpts.
+// Returns the verified digests of the received files.
+func (c *Runtime) receiveExport(stream executorv1.Executor_ExportClient, dest file.WriteFS, recvOpts []file.RecvOpt) ([]*executorv1.FileDigest, error) {
+	receivers := make(map[string]*file.Receiver)
+	var files []*executorv1.FileDigest
+	for {
+		msg, err := stream.Recv()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return files, nil
+			}
+			return nil, fmt.Errorf("error receiving from stream: %w", err)
+		}
+		recv, ok := receivers[msg.FileId]
+		if !ok {
+			recv = file.NewReceiver(c.syslog, dest, recvOpts...)
+			receivers[msg.FileId] = recv
+		}
+		err = recv.Next(msg)
+		if err != nil {
+			delete(receivers, msg.FileId)
+			return nil, err
+		}
+		if recv.State() == file.ReceiveStateDone {
+			delete(receivers, msg.FileId)
+			if digest := recv.Digest(); digest != nil {
+				files = append(files, digest)
+			}
+		}
+	}
+}
+
+// parallel calls fn with each index from 0 to n concurrently, and returns the first error.
+// cancel is called on the first error, so the remaining calls can return early.
+func parallel(n int, cancel context.CancelFunc, fn func(i int) error) error {
+	var (
+		wg       sync.WaitGroup
+		mu       sync.Mutex
+		firstErr error
+	)
+	for i := range n {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := fn(i); err != nil {
+				mu.Lock()
+				defer mu.Unlock()
+				if firstErr == nil {
+					firstErr = err
+					cancel()
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	return firstErr
+}
+
+// Exec executes a command inside the runtime. If opts.Stdin is set, stdin is streamed to the command.
 // Events associated with the exec will be published to the configured event stream.
-func (c *Runtime) Exec(ctx context.Context, execID string, opts *executorv1.ExecOpts) (*executorv1.ExecResponse, error) {
+func (c *Runtime) Exec(ctx context.Context, execID string, opts *executorv1.ExecOpts, stdin io.Reader) (*executorv1.ExecResponse, error) {
 	c.log.Publish(&builtinv1.ExecStartEvent{RuntimeId: c.runtimeID, ExecId: execID, Opts: opts}, logOptsFromMeta(opts)...)
-	return WithUnaryEndEvent(func() (*executorv1.ExecResponse, error) {
+	res, err := WithUnaryEndEvent(func() (*executorv1.ExecResponse, error) {
 		sync, cancel := event.NewSynchronizer(c.log.Stream())
 		defer cancel()
-		req := &executorv1.ExecRequest{RuntimeId: c.runtimeID, ExecId: execID, BarrierId: sync.ID(), Opts: opts}
-		res, err := c.client.Exec(ctx, req)
+		execCtx, cancelExec := context.WithCancel(ctx)
+		defer cancelExec()
+		stream, err := c.client.Exec(execCtx)
 		if err != nil {
+			return nil, fmt.Errorf("error opening exec stream: %w", err)
+		}
+		req := &executorv1.ExecRequest{RuntimeId: c.runtimeID, ExecId: execID, BarrierId: sync.ID(), Opts: opts}
+		if err := stream.Send(req); err != nil {
+			return nil, fmt.Errorf("error sending exec request: %w", err)
+		}
+		if opts.Stdin && stdin != nil {
+			go c.sendStdin(stream, stdin)
+		} else if err := stream.CloseSend(); err != nil {
+			return nil, fmt.Errorf("error closing exec stream: %w", err)
+		}
+		res := &executorv1.ExecResponse{}
+		if err := stream.RecvMsg(res); err != nil {
 			return nil, fmt.Errorf("error in exec: %w", err)
 		}
 		c.syslog.Debugf("Waiting for exec sync point...")
@@ -198,11 +579,122 @@ func (c *Runtime) Exec(ctx context.Context, execID string, opts *executorv1.Exec
 		return res, nil
 	}, func(res *executorv1.ExecResponse) {
 		c.log.Publish(&builtinv1.ExecEndEvent{RuntimeId: c.runtimeID, ExecId: execID,
-			Status: &builtinv1.ExecEndEvent_Result{Result: &builtinv1.ExecResult{ExitCode: res.ExitCode}}})
+			Status: &builtinv1.ExecEndEvent_Result{Result: &builtinv1.ExecResult{ExitCode: res.ExitCode, TimedOut: res.TimedOut, Usage: res.Usage}}})
 	}, func(err error) {
+		if ctx.Err() != nil {
+			c.log.Publish(&builtinv1.ExecEndEvent{RuntimeId: c.runtimeID, ExecId: execID,
+				Status: &builtinv1.ExecEndEvent_Cancelled{Cancelled: &builtinv1.ExecCancelled{}}})
+			return
+		}
 		c.log.Publish(&builtinv1.ExecEndEvent{RuntimeId: c.runtimeID, ExecId: execID,
 			Status: &builtinv1.ExecEndEvent_Error{Error: &builtinv1.Error{Message: err.Error()}}})
 	})
+	if err != nil || !c.breakpoints.onFailure || (res.ExitCode == 0 && !res.TimedOut) {
+		return res, err
+	}
+	reason := fmt.Sprintf("%s failed with exit code %d", execDisplayName(execID, opts), res.ExitCode)
+	if res.TimedOut {
+		reason = fmt.Sprintf("%s timed out", execDisplayName(execID, opts))
+	}
+	pauseRes, err := c.Pause(ctx, reason, 0)
+	if err != nil {
+		return nil, err
+	}
+	if pauseRes.Aborted {
+		return nil, fmt.Errorf("error build aborted")
+	}
+	return res, nil
+}
+
+// Pause pauses the build at a breakpoint so the runtime can be inspected e.g. with knita attach.
+// The runtime's deadline is suspended for the duration of the pause, keeping it alive even if
+// heartbeats are interrupted. Blocks until the build is resumed or aborted, the pause times out,
+// or ctx is cancelled. A zero timeout uses the build's default pause timeout.
+func (c *Runtime) Pause(ctx context.Context, reason string, timeout time.Duration) (*directorv1.PauseResponse, error) {
+	if timeout <= 0 {
+		timeout = c.breakpoints.timeout
+	}
+	c.suspend(timeout + pauseSuspendGrace)
+	defer c.suspend(0)
+	return c.breakpoints.Pause(ctx, c.runtimeID, reason, timeout)
+}
+
+// Signal sends the named signal (e.g. "SIGQUIT") to the processes of an in progress exec.
+func (c *Runtime) Signal(ctx context.Context, execID string, signal string) error {
+	req := &executorv1.SignalRequest{RuntimeId: c.runtimeID, ExecId: execID, Signal: signal}
+	_, err := c.client.Signal(ctx, req)
+	if err != nil {
+		return fmt.Errorf("error signalling exec: %w", err)
+	}
+	return nil
+}
+
+// Attach proxies an interactive session inside the runtime between client and the runtime's executor.
+// req is the client's initial request. Blocks until the session ends or ctx is cancelled.
+func (c *Runtime) Attach(ctx context.Context, req *executorv1.AttachRequest, client directorv1.Director_AttachServer) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	stream, err := c.client.Attach(ctx)
+	if err != nil {
+		return fmt.Errorf("error opening attach stream: %w", err)
+	}
+	if err := stream.Send(&executorv1.AttachRequest{RuntimeId: c.runtimeID, Opts: req.Opts}); err != nil {
+		return fmt.Errorf("error sending attach request: %w", err)
+	}
+	go func() {
+		for {
+			req, err := client.Recv()
+			if err != nil {
+				if !errors.Is(err, io.EOF) {
+					// The client has gone away; end the session.
+					cancel()
+					return
+				}
+				if err := stream.CloseSend(); err != nil {
+					c.syslog.Warnf("Ignoring error closing attach stream: %v", err)
+				}
+				return
+			}
+			if err := stream.Send(&executorv1.AttachRequest{Stdin: req.Stdin, Resize: req.Resize}); err != nil {
+				return
+			}
+		}
+	}()
+	for {
+		res, err := stream.Recv()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			return fmt.Errorf("error in attach: %w", err)
+		}
+		if err := client.Send(res); err != nil {
+			return fmt.Errorf("error sending attach response: %w", err)
+		}
+	}
+}
+
+// sendStdin streams stdin to a remote exec in chunks, closing the send side
+// of the stream once stdin reaches EOF.
+func (c *Runtime) sendStdin(stream executorv1.Executor_ExecClient, stdin io.Reader) {
+	buf := make([]byte, stdinChunkSize)
+	for {
+		n, err := stdin.Read(buf)
+		if n > 0 {
+			if err := stream.Send(&executorv1.ExecRequest{Stdin: buf[:n]}); err != nil {
+				return
+			}
+		}
+		if err != nil {
+			if !errors.Is(err, io.EOF) {
+				c.syslog.Warnf("Closing exec stdin early due to read error: %v", err)
+			}
+			break
+		}
+	}
+	if err := stream.CloseSend(); err != nil {
+		c.syslog.Warnf("Ignoring error closing exec stdin: %v", err)
+	}
 }
 
 // Open the runtime. A runtime must be opened prior to use.
@@ -227,6 +719,14 @@ func (c *Runtime) Open(ctx context.Context) error {
 			cancel()
 			return fmt.Errorf("error waiting for sync point: %w", err)
 		}
+		if build := c.opts.GetDocker().GetBuild(); build != nil {
+			err := c.importBuildContext(ctx, build)
+			if err != nil {
+				c.cancel = nil
+				cancel()
+				return err
+			}
+		}
 		c.syslog.Infow("Opening remote runtime...")
 		openReq := &executorv1.OpenRequest{BuildId: c.buildID, RuntimeId: c.runtimeID, Opts: c.opts}
 		openRes, err := c.client.Open(ctx, openReq)
@@ -237,18 +737,38 @@ func (c *Runtime) Open(ctx context.Context) error {
 		}
 		go c.keepalive()
 		c.syslog.Infow("Opened runtime")
+		c.log.Printf("Opened runtime %s; attach a shell with: knita attach %s", c.runtimeID, c.runtimeID)
 		c.remoteWorkDirectory = openRes.WorkDirectory
 		c.remoteSysInfo = openRes.SysInfo
+		c.remoteImageDigest = openRes.ImageDigest
+		c.remoteCompressions = openRes.Compressions
+		c.remoteMaxStreams = int(openRes.MaxTransferStreams)
 		return nil
 	}, func() {
 		c.log.Publish(&builtinv1.RuntimeOpenEndEvent{RuntimeId: c.runtimeID,
-			Status: &builtinv1.RuntimeOpenEndEvent_Result{Result: &builtinv1.RuntimeOpenResult{}}})
+			Status: &builtinv1.RuntimeOpenEndEvent_Result{Result: &builtinv1.RuntimeOpenResult{ImageDigest: c.remoteImageDigest}}})
 	}, func(err error) {
 		c.log.Publish(&builtinv1.RuntimeOpenEndEvent{RuntimeId: c.runtimeID,
 			Status: &builtinv1.RuntimeOpenEndEvent_Error{Error: &builtinv1.Error{Message: err.Error()}}})
 	})
 }
 
+// importBuildContext imports a Docker build context into the runtime prior to it being opened.
+func (c *Runtime) importBuildContext(ctx context.Context, build *executorv1.DockerBuildOpts) error {
+	c.syslog.Infow("Importing Docker build context...", "src", build.ContextPath)
+	src := filepath.Clean(build.ContextPath)
+	opts := &directorv1.ImportOpts{SrcPath: src}
+	if src != "." {
+		// Import the contents of the context directory to the root of the build context.
+		opts.DestPath = "."
+	}
+	_, err := c.Import(ctx, opts)
+	if err != nil {
+		return fmt.Errorf("error importing Docker build context: %w", err)
+	}
+	return nil
+}
+
 // Close the runtime. The runtime cannot be reused after a call to close.
 func (c *Runtime) Close(ctx context.Context) error {
 	c.log.Publish(&builtinv1.RuntimeCloseStartEvent{RuntimeId: c.runtimeID})
@@ -309,7 +829,7 @@ func (c *Runtime) keepalive() {
 			ctx, cancel := context.WithTimeout(c.ctx, heartbeatTimeout)
 			defer cancel()
 			start := time.Now()
-			res, err := c.client.Heartbeat(ctx, &executorv1.HeartbeatRequest{RuntimeId: c.runtimeID})
+			res, err := c.heartbeat(ctx)
 			if err != nil {
 				if c.ctx.Err() == nil {
 					c.syslog.Warnf("Will retry error heartbeating runtime: %v", err)
@@ -320,12 +840,51 @@ func (c *Runtime) keepalive() {
 			} else {
 				c.syslog.Debugf("Extended runtime deadline by: %d seconds", res.GetExtendedBy().Seconds)
 				remaining := float64(res.GetExtendedBy().GetSeconds()) - time.Now().Sub(start).Seconds()
-				time.Sleep(time.Duration(remaining/3) * time.Second)
+				time.Sleep(min(time.Duration(remaining/3)*time.Second, maxHeartbeatInterval))
 			}
 		}()
 	}
 }
 
+// heartbeat extends the runtime's deadline, suspending it until c.suspendUntil if set.
+func (c *Runtime) heartbeat(ctx context.Context) (*executorv1.HeartbeatResponse, error) {
+	req := &executorv1.HeartbeatRequest{RuntimeId: c.runtimeID}
+	c.mu.Lock()
+	if suspend := time.Until(c.suspendUntil); suspend > 0 {
+		req.Suspend = durationpb.New(suspend)
+	}
+	c.mu.Unlock()
+	return c.client.Heartbeat(ctx, req)
+}
+
+// suspend suspends the runtime's deadline for d, or cancels any existing suspension if d is zero.
+// The executor is notified immediately.
+func (c *Runtime) suspend(d time.Duration) {
+	c.mu.Lock()
+	if d > 0 {
+		c.suspendUntil = time.Now().Add(d)
+	} else {
+		c.suspendUntil = time.Time{}
+	}
+	c.mu.Unlock()
+	ctx, cancel := context.WithTimeout(c.ctx, heartbeatTimeout)
+	defer cancel()
+	if _, err := c.heartbeat(ctx); err != nil {
+		c.syslog.Warnf("Ignoring error updating runtime deadline suspension: %v", err)
+	}
+}
+
+// execDisplayName returns a human-readable name for an exec.
+func execDisplayName(execID string, opts *executorv1.ExecOpts) string {
+	if opts.DisplayName != "" {
+		return opts.DisplayName
+	}
+	if opts.Name != "" {
+		return opts.Name
+	}
+	return execID
+}
+
 // metaCarrier is implemented by every Opts type that carries an OptsMeta.
 type metaCarrier interface {
 	GetMeta() *executorv1.OptsMeta
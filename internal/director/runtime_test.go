@@ -0,0 +1,44 @@
+package director
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+
+	executorv1 "github.com/knita-io/knita/api/executor/v1"
+	"github.com/knita-io/knita/internal/file"
+)
+
+type testExportStream struct {
+	grpc.ClientStream
+	msgs []*executorv1.FileTransfer
+}
+
+func (s *testExportStream) Recv() (*executorv1.FileTransfer, error) {
+	if len(s.msgs) == 0 {
+		return nil, io.EOF
+	}
+	msg := s.msgs[0]
+	s.msgs = s.msgs[1:]
+	return msg, nil
+}
+
+func TestReceiveExportRefusesDeletes(t *testing.T) {
+	dest := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dest, "keep.txt"), []byte("keep"), 0644))
+	stream := &testExportStream{msgs: []*executorv1.FileTransfer{{
+		FileId: "delete",
+		Header: &executorv1.FileTransferHeader{DestPath: "keep.txt", Delete: true},
+	}}}
+	runtime := &Runtime{syslog: zap.NewNop().Sugar()}
+	_, err := runtime.receiveExport(stream, file.WriteDirFS(dest), nil)
+	require.ErrorContains(t, err, "deletions are not accepted")
+	data, err := os.ReadFile(filepath.Join(dest, "keep.txt"))
+	require.NoError(t, err)
+	require.Equal(t, "keep", string(data))
+}
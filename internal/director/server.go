@@ -2,7 +2,9 @@ package director
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"io"
 	"sync"
 
 	"github.com/google/uuid"
@@ -10,6 +12,7 @@ import (
 
 	directorv1 "github.com/knita-io/knita/api/director/v1"
 	builtinv1 "github.com/knita-io/knita/api/events/builtin/v1"
+	executorv1 "github.com/knita-io/knita/api/executor/v1"
 	"github.com/knita-io/knita/internal/event"
 )
 
@@ -49,7 +52,12 @@ func (s *Server) Open(ctx context.Context, req *directorv1.OpenRequest) (*direct
 }
 
 // Exec executes a command inside the specified runtime and streams the output back to the client.
-func (s *Server) Exec(req *directorv1.ExecRequest, stream directorv1.Director_ExecServer) error {
+// If requested, stdin is streamed from the client to the command.
+func (s *Server) Exec(stream directorv1.Director_ExecServer) error {
+	req, err := stream.Recv()
+	if err != nil {
+		return fmt.Errorf("error receiving exec request: %w", err)
+	}
 	if err := validateExecRequest(req); err != nil {
 		return err
 	}
@@ -103,40 +111,113 @@ func (s *Server) Exec(req *directorv1.ExecRequest, stream directorv1.Director_Ex
 		s.syslog.Info("Unsubscribed exec client")
 	}()
 
-	_, err = runtime.Exec(stream.Context(), execID, req.Opts)
+	var stdin io.Reader
+	if req.Opts.Stdin {
+		pr, pw := io.Pipe()
+		defer pr.Close()
+		go receiveStdin(stream, pw)
+		stdin = pr
+	}
+	_, err = runtime.Exec(stream.Context(), execID, req.Opts, stdin)
 	return err
 }
 
-// Import files and directories from the local filesystem to the remote runtime.
-func (s *Server) Import(ctx context.Context, req *directorv1.ImportRequest) (*directorv1.ImportResponse, error) {
-	if err := validateImportRequest(req); err != nil {
+// receiveStdin writes stdin chunks received from an exec stream to w until the client
+// closes its side of the stream, at which point w is closed to signal EOF.
+func receiveStdin(stream directorv1.Director_ExecServer, w *io.PipeWriter) {
+	for {
+		req, err := stream.Recv()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				w.Close()
+			} else {
+				w.CloseWithError(err)
+			}
+			return
+		}
+		if _, err := w.Write(req.Stdin); err != nil {
+			// The exec is no longer reading stdin.
+			return
+		}
+	}
+}
+
+// Attach proxies an interactive session inside a runtime between the client and the runtime's executor.
+func (s *Server) Attach(stream directorv1.Director_AttachServer) error {
+	req, err := stream.Recv()
+	if err != nil {
+		return fmt.Errorf("error receiving attach request: %w", err)
+	}
+	if err := validateAttachRequest(req); err != nil {
+		return err
+	}
+	runtime, err := s.getRuntime(req.RuntimeId)
+	if err != nil {
+		return err
+	}
+	return runtime.Attach(stream.Context(), req, stream)
+}
+
+// Pause pauses the build at a breakpoint so the specified runtime can be inspected.
+// Blocks until the build is resumed or aborted, or the pause times out.
+func (s *Server) Pause(ctx context.Context, req *directorv1.PauseRequest) (*directorv1.PauseResponse, error) {
+	if err := validatePauseRequest(req); err != nil {
+		return nil, err
+	}
+	runtime, err := s.getRuntime(req.RuntimeId)
+	if err != nil {
+		return nil, err
+	}
+	return runtime.Pause(ctx, req.Reason, req.Timeout.AsDuration())
+}
+
+// Resume resumes all paused runtimes in the build, or aborts them if requested.
+func (s *Server) Resume(ctx context.Context, req *directorv1.ResumeRequest) (*directorv1.ResumeResponse, error) {
+	if req == nil {
+		return nil, fmt.Errorf("nil request")
+	}
+	n := s.build.Resume(req.Abort)
+	return &directorv1.ResumeResponse{Resumed: int32(n)}, nil
+}
+
+// Signal sends a signal to the processes of an in progress exec.
+func (s *Server) Signal(ctx context.Context, req *directorv1.SignalRequest) (*directorv1.SignalResponse, error) {
+	if err := validateSignalRequest(req); err != nil {
 		return nil, err
 	}
 	runtime, err := s.getRuntime(req.RuntimeId)
 	if err != nil {
 		return nil, err
 	}
-	err = runtime.Import(ctx, req.Opts)
+	err = runtime.Signal(ctx, req.ExecId, req.Signal)
 	if err != nil {
 		return nil, err
 	}
-	return &directorv1.ImportResponse{}, nil
+	return &directorv1.SignalResponse{}, nil
 }
 
-// Export files and directories from the remote runtime to the local filesystem.
-func (s *Server) Export(ctx context.Context, req *directorv1.ExportRequest) (*directorv1.ExportResponse, error) {
-	if err := validateExportRequest(req); err != nil {
+// Import files and directories from the local filesystem to the remote runtime.
+func (s *Server) Import(ctx context.Context, req *directorv1.ImportRequest) (*directorv1.ImportResponse, error) {
+	if err := validateImportRequest(req); err != nil {
 		return nil, err
 	}
 	runtime, err := s.getRuntime(req.RuntimeId)
 	if err != nil {
 		return nil, err
 	}
-	err = runtime.Export(ctx, req.Opts)
+	return runtime.Import(ctx, req.Opts)
+}
+
+// Export files and directories from the remote runtime to the local filesystem.
+func (s *Server) Export(ctx context.Context, req *directorv1.ExportRequest) (*directorv1.ExportResponse, error) {
+	if err := validateExportRequest(req); err != nil {
+		return nil, err
+	}
+	runtime, err := s.getRuntime(req.RuntimeId)
 	if err != nil {
 		return nil, err
 	}
-	return &directorv1.ExportResponse{}, nil
+	return runtime.Export(ctx, req.Opts)
 }
 
 // Close the runtime. The runtime cannot be reused after a call to close.
@@ -199,8 +280,59 @@ func validateExecRequest(req *directorv1.ExecRequest) error {
 	if req.Opts == nil {
 		return fmt.Errorf("empty opts")
 	}
-	if req.Opts.Name == "" {
-		return fmt.Errorf("empty opts name")
+	if req.Opts.Name == "" && req.Opts.Script == "" {
+		return fmt.Errorf("empty opts name and script")
+	}
+	if req.Opts.Name != "" && req.Opts.Script != "" {
+		return fmt.Errorf("opts name and script are mutually exclusive")
+	}
+	return nil
+}
+
+// validateAttachRequest validates an AttachRequest.
+func validateAttachRequest(req *executorv1.AttachRequest) error {
+	if req == nil {
+		return fmt.Errorf("nil request")
+	}
+	if req.RuntimeId == "" {
+		return fmt.Errorf("empty runtime_id")
+	}
+	if req.Opts == nil {
+		return fmt.Errorf("empty opts")
+	}
+	return nil
+}
+
+// validatePauseRequest validates a PauseRequest.
+func validatePauseRequest(req *directorv1.PauseRequest) error {
+	if req == nil {
+		return fmt.Errorf("nil request")
+	}
+	if req.RuntimeId == "" {
+		return fmt.Errorf("empty runtime_id")
+	}
+	if req.Reason == "" {
+		return fmt.Errorf("empty reason")
+	}
+	if req.Timeout != nil && req.Timeout.AsDuration() < 0 {
+		return fmt.Errorf("negative timeout")
+	}
+	return nil
+}
+
+// validateSignalRequest validates a SignalRequest.
+func validateSignalRequest(req *directorv1.SignalRequest) error {
+	if req == nil {
+		return fmt.Errorf("nil request")
+	}
+	if req.RuntimeId == "" {
+		return fmt.Errorf("empty runtime_id")
+	}
+	if req.ExecId == "" {
+		return fmt.Errorf("empty exec_id")
+	}
+	if req.Signal == "" {
+		return fmt.Errorf("empty signal")
 	}
 	return nil
 }
@@ -237,7 +369,10 @@ func validateExportRequest(req *directorv1.ExportRequest) error {
 	if req.Opts.SrcPath == "" {
 		return fmt.Errorf("empty src_path")
 	}
-	// NOTE: An empty dest path is valid
+	// NOTE: An empty dest path is valid, unless exporting to an archive
+	if req.Opts.Archive && req.Opts.DestPath == "" {
+		return fmt.Errorf("empty dest_path; archive exports require the path of the archive")
+	}
 	return nil
 }
 
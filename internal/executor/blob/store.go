@@ -0,0 +1,307 @@
+package blob
+
+import (
+	"bytes"
+	"container/list"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/knita-io/knita/internal/file"
+)
+
+// DefaultMaxSize is the default maximum combined size of all blobs.
+const DefaultMaxSize = 10 * 1024 * 1024 * 1024
+
+type Config struct {
+	// Dir is the directory blobs are stored in. Defaults to DefaultDir.
+	Dir string
+	// MaxSize is the maximum combined size in bytes of all blobs. When exceeded, the least
+	// recently used blobs are evicted. Zero disables the store.
+	MaxSize int64
+}
+
+// DefaultDir returns the default directory blobs are stored in.
+func DefaultDir() string {
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		dir = os.TempDir()
+	}
+	return filepath.Join(dir, "knita", "blobs")
+}
+
+var _ file.BlobStore = (*Store)(nil)
+
+type entry struct {
+	key  string
+	size int64
+}
+
+// Store is a content-addressable store of the files imported into runtimes, keyed by the SHA-256 digest
+// of their data. Files that are imported again are materialized from the store rather than re-transferred.
+// Blobs are stored at <dir>/<first 2 hex digits>/<hex digest>.
+type Store struct {
+	syslog *zap.SugaredLogger
+	config Config
+	mu     sync.Mutex
+	// lru orders entries from most (front) to least (back) recently used, and entries indexes them by key.
+	lru     *list.List
+	entries map[string]*list.Element
+	size    int64
+}
+
+// NewStore returns a Store, indexing any blobs that were stored by a previous executor process.
+// Blobs are initially ordered by modification time.
+func NewStore(syslog *zap.SugaredLogger, config Config) *Store {
+	if config.Dir == "" {
+		config.Dir = DefaultDir()
+	}
+	s := &Store{
+		syslog:  syslog.Named("blob_store"),
+		config:  config,
+		lru:     list.New(),
+		entries: make(map[string]*list.Element),
+	}
+	if s.Enabled() {
+		if err := s.load(); err != nil {
+			s.syslog.Warnw("Failed to index blob store", "dir", config.Dir, "error", err)
+		}
+	}
+	return s
+}
+
+// Enabled returns true if the store is enabled.
+func (s *Store) Enabled() bool {
+	return s.config.MaxSize > 0
+}
+
+// Size returns the combined size of all blobs.
+func (s *Store) Size() int64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.size
+}
+
+// Has returns the subset of digests that are stored. The stored blobs are marked as recently used,
+// as they are about to be materialized.
+func (s *Store) Has(digests [][]byte) [][]byte {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var have [][]byte
+	for _, digest := range digests {
+		if elem, ok := s.entries[hex.EncodeToString(digest)]; ok {
+			s.lru.MoveToFront(elem)
+			have = append(have, digest)
+		}
+	}
+	return have
+}
+
+// Materialize writes a copy of the blob with the given digest to name in fsys. Blobs are never hard-linked,
+// as builds could then modify them in place. Where supported, the kernel may share the copy's data with the blob
+// on filesystems with copy-on-write (e.g. Btrfs or XFS).
+func (s *Store) Materialize(digest []byte, fsys file.WriteFS, name string, perm os.FileMode) error {
+	key := hex.EncodeToString(digest)
+	path := s.path(key)
+	s.mu.Lock()
+	elem, ok := s.entries[key]
+	if ok {
+		s.lru.MoveToFront(elem)
+	}
+	s.mu.Unlock()
+	if !ok {
+		return fmt.Errorf("error blob not found")
+	}
+	err := fsys.Remove(name)
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("error removing existing file: %w", err)
+	}
+	err = s.copyOut(path, fsys, name, perm)
+	if errors.Is(err, fs.ErrNotExist) {
+		s.Evict(digest)
+	}
+	return err
+}
+
+// Put stores a copy of the contents of name in fsys, whose data has the given digest, as a blob.
+// name is re-hashed as it is copied, and is not stored if it has been modified since it was received.
+// Least recently used blobs are evicted to keep the store within its maximum size.
+func (s *Store) Put(digest []byte, fsys file.WriteFS, name string) error {
+	key := hex.EncodeToString(digest)
+	s.mu.Lock()
+	elem, ok := s.entries[key]
+	if ok {
+		s.lru.MoveToFront(elem)
+	}
+	s.mu.Unlock()
+	if ok {
+		return nil
+	}
+	size, err := s.copyIn(fsys, name, digest)
+	if err != nil {
+		return err
+	}
+	if size > s.config.MaxSize {
+		os.Remove(s.path(key))
+		return nil
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.entries[key]; ok {
+		// Stored concurrently.
+		return nil
+	}
+	s.entries[key] = s.lru.PushFront(&entry{key: key, size: size})
+	s.size += size
+	s.evict()
+	return nil
+}
+
+// Evict removes the blob with the given digest.
+func (s *Store) Evict(digest []byte) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if elem, ok := s.entries[hex.EncodeToString(digest)]; ok {
+		s.remove(elem)
+	}
+}
+
+// evict removes the least recently used blobs until the store is within its maximum size.
+func (s *Store) evict() {
+	for s.size > s.config.MaxSize {
+		elem := s.lru.Back()
+		if elem == nil {
+			return
+		}
+		s.syslog.Debugw("Blob store exceeds max size; evicting blob", "digest", elem.Value.(*entry).key)
+		s.remove(elem)
+	}
+}
+
+// remove removes a blob. The caller must hold s.mu.
+func (s *Store) remove(elem *list.Element) {
+	e := s.lru.Remove(elem).(*entry)
+	delete(s.entries, e.key)
+	s.size -= e.size
+	if err := os.Remove(s.path(e.key)); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		s.syslog.Warnw("Failed to remove blob", "digest", e.key, "error", err)
+	}
+}
+
+// copyIn copies the contents of name in fsys into the store as the blob with the given digest.
+// name is opened without following symlinks, as it could have been replaced by the runtime since it was received,
+// and the blob is only stored if the copied data has the given digest. Returns the size of the blob.
+func (s *Store) copyIn(fsys file.WriteFS, name string, digest []byte) (int64, error) {
+	key := hex.EncodeToString(digest)
+	src, err := fsys.OpenNoFollow(name)
+	if err != nil {
+		return 0, fmt.Errorf("error opening file: %w", err)
+	}
+	defer src.Close()
+	path := s.path(key)
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return 0, fmt.Errorf("error creating blob dir: %w", err)
+	}
+	// Write to a temporary file first, so partially written blobs are never visible.
+	tmp, err := os.CreateTemp(filepath.Dir(path), key+".tmp-*")
+	if err != nil {
+		return 0, fmt.Errorf("error creating blob: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+	hash := sha256.New()
+	size, err := io.Copy(io.MultiWriter(tmp, hash), src)
+	if err != nil {
+		tmp.Close()
+		return 0, fmt.Errorf("error writing blob: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return 0, fmt.Errorf("error writing blob: %w", err)
+	}
+	if actual := hash.Sum(nil); !bytes.Equal(actual, digest) {
+		return 0, &file.ChecksumMismatchError{Path: name, Expected: digest, Actual: actual}
+	}
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return 0, fmt.Errorf("error storing blob: %w", err)
+	}
+	return size, nil
+}
+
+// copyOut copies the blob at path to name in fsys.
+func (s *Store) copyOut(path string, fsys file.WriteFS, name string, perm os.FileMode) error {
+	src, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("error opening blob: %w", err)
+	}
+	defer src.Close()
+	dest, err := fsys.OpenFile(name, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, perm)
+	if err != nil {
+		return fmt.Errorf("error creating file: %w", err)
+	}
+	if _, err := io.Copy(dest, src); err != nil {
+		dest.Close()
+		return fmt.Errorf("error copying blob: %w", err)
+	}
+	return dest.Close()
+}
+
+// load indexes the blobs in the store's directory, discarding any partially written blobs.
+func (s *Store) load() error {
+	type blob struct {
+		key     string
+		size    int64
+		modTime time.Time
+	}
+	var blobs []blob
+	err := filepath.WalkDir(s.config.Dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			if errors.Is(err, fs.ErrNotExist) {
+				return nil
+			}
+			return err
+		}
+		if !d.Type().IsRegular() {
+			return nil
+		}
+		key := d.Name()
+		if digest, err := hex.DecodeString(key); err != nil || len(digest) != 32 {
+			os.Remove(path)
+			return nil
+		}
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		blobs = append(blobs, blob{key: key, size: info.Size(), modTime: info.ModTime()})
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	// Oldest first, so the most recently modified blob ends up at the front.
+	slices.SortFunc(blobs, func(a, b blob) int {
+		return a.modTime.Compare(b.modTime)
+	})
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, b := range blobs {
+		s.entries[b.key] = s.lru.PushFront(&entry{key: b.key, size: b.size})
+		s.size += b.size
+	}
+	s.evict()
+	s.syslog.Infow("Indexed blob store", "dir", s.config.Dir, "blobs", len(s.entries), "size", s.size)
+	return nil
+}
+
+func (s *Store) path(key string) string {
+	return filepath.Join(s.config.Dir, key[:2], key)
+}
@@ -0,0 +1,143 @@
+package blob
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	executorv1 "github.com/knita-io/knita/api/executor/v1"
+	"github.com/knita-io/knita/internal/file"
+)
+
+type testTransport struct {
+	sends []*executorv1.FileTransfer
+}
+
+func (t *testTransport) Send(transfer *executorv1.FileTransfer) error {
+	t.sends = append(t.sends, transfer)
+	return nil
+}
+
+func TestImportFromStore(t *testing.T) {
+	testFS := fstest.MapFS{
+		"vendor/a.go": &fstest.MapFile{Data: bytes.Repeat([]byte("a"), file.MinBlobSize), Mode: 0644},
+		"vendor/b.go": &fstest.MapFile{Data: bytes.Repeat([]byte("b"), file.MinBlobSize*2), Mode: 0755},
+		"small.go":    &fstest.MapFile{Data: []byte("small"), Mode: 0644},
+	}
+	store := NewStore(zap.NewNop().Sugar(), Config{Dir: t.TempDir(), MaxSize: DefaultMaxSize})
+
+	send := func(dir string) (*file.SendResult, error) {
+		transport := &testTransport{}
+		sender := file.NewSender(zap.NewNop().Sugar(), testFS, transport, "runtime", "transfer",
+			file.WithBlobs(func(digests [][]byte) ([][]byte, error) {
+				return store.Has(digests), nil
+			}))
+		res, err := sender.Send(".")
+		require.NoError(t, err)
+		receivers := make(map[string]*file.Receiver)
+		for _, send := range transport.sends {
+			receiver, ok := receivers[send.FileId]
+			if !ok {
+				receiver = file.NewReceiver(zap.NewNop().Sugar(), file.WriteDirFS(dir), file.WithBlobStore(store))
+				receivers[send.FileId] = receiver
+			}
+			if err := receiver.Next(send); err != nil {
+				return nil, err
+			}
+		}
+		for path, f := range testFS {
+			data, err := os.ReadFile(filepath.Join(dir, path))
+			require.NoError(t, err)
+			require.Equal(t, f.Data, data)
+		}
+		return res, nil
+	}
+
+	// The first import transfers everything, storing the larger files as blobs.
+	res, err := send(t.TempDir())
+	require.NoError(t, err)
+	require.Equal(t, 0, res.BlobFiles)
+	require.Equal(t, int64(file.MinBlobSize*3), store.Size())
+
+	// Subsequent imports materialize the larger files from the store.
+	dir := t.TempDir()
+	res, err = send(dir)
+	require.NoError(t, err)
+	require.Equal(t, 2, res.BlobFiles)
+	require.Equal(t, uint64(file.MinBlobSize*3), res.BlobBytes)
+	info, err := os.Stat(filepath.Join(dir, "vendor/b.go"))
+	require.NoError(t, err)
+	require.Equal(t, os.FileMode(0755), info.Mode().Perm())
+
+	// Writing through materialized files leaves the blobs unchanged.
+	for _, path := range []string{"vendor/a.go", "vendor/b.go"} {
+		fh, err := os.OpenFile(filepath.Join(dir, path), os.O_WRONLY, 0)
+		require.NoError(t, err)
+		_, err = fh.WriteAt([]byte("modified"), 0)
+		require.NoError(t, err)
+		require.NoError(t, fh.Close())
+	}
+	require.NoError(t, os.Chmod(filepath.Join(dir, "vendor/b.go"), 0600))
+	res, err = send(t.TempDir())
+	require.NoError(t, err)
+	require.Equal(t, 2, res.BlobFiles)
+	for key := range store.entries {
+		data, err := os.ReadFile(store.path(key))
+		require.NoError(t, err)
+		require.NotContains(t, string(data), "modified")
+	}
+
+	// Blobs that are corrupted in the store are detected and evicted.
+	digestA := sha256.Sum256(testFS["vendor/a.go"].Data)
+	require.NoError(t, os.WriteFile(store.path(hex.EncodeToString(digestA[:])), []byte("corrupt"), 0644))
+	_, err = send(t.TempDir())
+	var blobErr *file.BlobError
+	require.True(t, errors.As(err, &blobErr), "expected blob error, got: %v", err)
+	require.Len(t, store.Has([][]byte{blobErr.Digest}), 0)
+
+	res, err = send(t.TempDir())
+	require.NoError(t, err)
+	require.Equal(t, 1, res.BlobFiles)
+
+	// Least recently used blobs are evicted once the store exceeds its maximum size.
+	// vendor/a.go was stored before vendor/b.go was last materialized.
+	store.mu.Lock()
+	store.config.MaxSize = file.MinBlobSize * 2
+	store.evict()
+	store.mu.Unlock()
+	require.Equal(t, int64(file.MinBlobSize*2), store.Size())
+	require.Len(t, store.Has([][]byte{blobErr.Digest}), 0)
+}
+
+func TestPutVerifiesFile(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("symlinks are not supported on Windows")
+	}
+	store := NewStore(zap.NewNop().Sugar(), Config{Dir: t.TempDir(), MaxSize: DefaultMaxSize})
+	dir := t.TempDir()
+	data := bytes.Repeat([]byte("a"), file.MinBlobSize)
+	digest := sha256.Sum256(data)
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "secret"), data, 0644))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "modified"), []byte("modified"), 0644))
+	require.NoError(t, os.Symlink("secret", filepath.Join(dir, "link")))
+
+	// Files modified since they were received, and symlinks swapped in for them, are not stored.
+	var mismatch *file.ChecksumMismatchError
+	require.ErrorAs(t, store.Put(digest[:], file.WriteDirFS(dir), "modified"), &mismatch)
+	require.Error(t, store.Put(digest[:], file.WriteDirFS(dir), "link"))
+	require.Len(t, store.Has([][]byte{digest[:]}), 0)
+	_, err := os.Stat(store.path(hex.EncodeToString(digest[:])))
+	require.True(t, os.IsNotExist(err))
+
+	require.NoError(t, store.Put(digest[:], file.WriteDirFS(dir), "secret"))
+	require.Len(t, store.Has([][]byte{digest[:]}), 1)
+}
@@ -0,0 +1,355 @@
+package cache
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+const (
+	metaFileName = "meta.json"
+	dataDirName  = "data"
+)
+
+var namePattern = regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9_.-]{0,63}$`)
+
+type Config struct {
+	// Dir is the directory caches are persisted to. Defaults to DefaultDir.
+	Dir string
+	// MaxTotalSize is the maximum combined size in bytes of all caches. When exceeded,
+	// the least recently used caches are evicted. Zero means unlimited.
+	MaxTotalSize int64
+	// DefaultMaxSize is the maximum size in bytes of a single cache that does not request
+	// its own limit. When exceeded, the least recently modified files in the cache are
+	// evicted. Zero means unlimited.
+	DefaultMaxSize int64
+}
+
+// Info describes a cache.
+type Info struct {
+	Name     string
+	Size     int64
+	LastUsed time.Time
+	InUse    bool
+}
+
+type meta struct {
+	LastUsed time.Time `json:"last_used"`
+	Size     int64     `json:"size"`
+}
+
+// DefaultDir returns the default directory caches are persisted to.
+func DefaultDir() string {
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		dir = os.TempDir()
+	}
+	return filepath.Join(dir, "knita", "caches")
+}
+
+// ValidateName returns an error if name is not a valid cache name.
+func ValidateName(name string) error {
+	if !namePattern.MatchString(name) {
+		return fmt.Errorf("invalid cache name %q; must be alphanumeric (plus '_', '.' and '-') and at most 64 characters", name)
+	}
+	return nil
+}
+
+// Manager manages named caches that persist across runtimes.
+// A cache can be leased by a single runtime at a time.
+type Manager struct {
+	syslog *zap.SugaredLogger
+	config Config
+	mu     sync.Mutex
+	inUse  map[string]bool
+}
+
+func NewManager(syslog *zap.SugaredLogger, config Config) *Manager {
+	if config.Dir == "" {
+		config.Dir = DefaultDir()
+	}
+	return &Manager{
+		syslog: syslog.Named("cache_manager"),
+		config: config,
+		inUse:  make(map[string]bool),
+	}
+}
+
+// Lease grants exclusive use of a cache directory until released.
+type Lease struct {
+	manager   *Manager
+	name      string
+	dir       string
+	maxSize   int64
+	ephemeral bool
+}
+
+// Dir returns the directory the cache contents are stored in.
+func (l *Lease) Dir() string {
+	return l.dir
+}
+
+// Ephemeral returns true if the cache was in use by another runtime, and an empty
+// temporary cache that will be discarded on release was leased instead.
+func (l *Lease) Ephemeral() bool {
+	return l.ephemeral
+}
+
+// Release returns the cache to the manager, enforcing size limits.
+func (l *Lease) Release() error {
+	return l.manager.release(l)
+}
+
+// Acquire leases the named cache, creating it if it does not exist. maxSize overrides the
+// configured default maximum size of the cache if greater than zero. If the cache is already
+// leased, an ephemeral cache is leased instead so concurrent writers never share a cache.
+func (m *Manager) Acquire(name string, maxSize int64) (*Lease, error) {
+	if err := ValidateName(name); err != nil {
+		return nil, err
+	}
+	if maxSize <= 0 {
+		maxSize = m.config.DefaultMaxSize
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.inUse[name] {
+		dir, err := os.MkdirTemp("", fmt.Sprintf("knita-cache-%s-*", name))
+		if err != nil {
+			return nil, fmt.Errorf("error creating ephemeral cache dir: %w", err)
+		}
+		m.syslog.Infow("Cache is in use; leasing ephemeral cache", "name", name)
+		return &Lease{manager: m, name: name, dir: dir, ephemeral: true}, nil
+	}
+	dir := filepath.Join(m.config.Dir, name, dataDirName)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, fmt.Errorf("error creating cache dir: %w", err)
+	}
+	m.inUse[name] = true
+	return &Lease{manager: m, name: name, dir: dir, maxSize: maxSize}, nil
+}
+
+func (m *Manager) release(l *Lease) error {
+	if l.ephemeral {
+		return os.RemoveAll(l.dir)
+	}
+	defer func() {
+		m.mu.Lock()
+		delete(m.inUse, l.name)
+		m.mu.Unlock()
+	}()
+	size, err := dirSize(l.dir)
+	if err != nil {
+		return fmt.Errorf("error sizing cache %q: %w", l.name, err)
+	}
+	if l.maxSize > 0 && size > l.maxSize {
+		m.syslog.Infow("Cache exceeds max size; evicting", "name", l.name, "size", size, "max_size", l.maxSize)
+		size, err = evictFiles(l.dir, size, l.maxSize)
+		if err != nil {
+			return fmt.Errorf("error evicting from cache %q: %w", l.name, err)
+		}
+	}
+	err = writeMeta(filepath.Join(m.config.Dir, l.name), &meta{LastUsed: time.Now(), Size: size})
+	if err != nil {
+		return err
+	}
+	return m.enforceMaxTotalSize()
+}
+
+// List returns information about all caches, ordered by name.
+func (m *Manager) List() ([]*Info, error) {
+	entries, err := os.ReadDir(m.config.Dir)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("error listing caches: %w", err)
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var infos []*Info
+	for _, entry := range entries {
+		if !entry.IsDir() || ValidateName(entry.Name()) != nil {
+			continue
+		}
+		info := &Info{Name: entry.Name(), InUse: m.inUse[entry.Name()]}
+		meta, err := readMeta(filepath.Join(m.config.Dir, entry.Name()))
+		if err != nil {
+			return nil, err
+		}
+		if meta != nil {
+			info.Size = meta.Size
+			info.LastUsed = meta.LastUsed
+		}
+		infos = append(infos, info)
+	}
+	return infos, nil
+}
+
+// Purge deletes the named caches, or all caches if no names are specified.
+// Caches that are in use are not purged.
+func (m *Manager) Purge(names ...string) error {
+	if len(names) == 0 {
+		infos, err := m.List()
+		if err != nil {
+			return err
+		}
+		for _, info := range infos {
+			names = append(names, info.Name)
+		}
+	}
+	var res error
+	for _, name := range names {
+		if err := ValidateName(name); err != nil {
+			res = errors.Join(res, err)
+			continue
+		}
+		if err := m.remove(name); err != nil {
+			res = errors.Join(res, err)
+		}
+	}
+	return res
+}
+
+func (m *Manager) remove(name string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.inUse[name] {
+		return fmt.Errorf("error cache %q is in use", name)
+	}
+	err := os.RemoveAll(filepath.Join(m.config.Dir, name))
+	if err != nil {
+		return fmt.Errorf("error removing cache %q: %w", name, err)
+	}
+	return nil
+}
+
+// enforceMaxTotalSize evicts the least recently used caches until the combined
+// size of all caches is within the configured limit.
+func (m *Manager) enforceMaxTotalSize() error {
+	if m.config.MaxTotalSize <= 0 {
+		return nil
+	}
+	infos, err := m.List()
+	if err != nil {
+		return err
+	}
+	var total int64
+	for _, info := range infos {
+		total += info.Size
+	}
+	sort.Slice(infos, func(i, j int) bool {
+		return infos[i].LastUsed.Before(infos[j].LastUsed)
+	})
+	for _, info := range infos {
+		if total <= m.config.MaxTotalSize {
+			break
+		}
+		if info.InUse {
+			continue
+		}
+		m.syslog.Infow("Caches exceed max total size; evicting cache", "name", info.Name, "size", info.Size)
+		if err := m.remove(info.Name); err != nil {
+			return err
+		}
+		total -= info.Size
+	}
+	return nil
+}
+
+func readMeta(dir string) (*meta, error) {
+	data, err := os.ReadFile(filepath.Join(dir, metaFileName))
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("error reading cache meta: %w", err)
+	}
+	m := &meta{}
+	if err := json.Unmarshal(data, m); err != nil {
+		return nil, fmt.Errorf("error decoding cache meta: %w", err)
+	}
+	return m, nil
+}
+
+// writeMeta replaces the cache's meta atomically, so List never reads a partially written meta.
+func writeMeta(dir string, m *meta) error {
+	data, err := json.Marshal(m)
+	if err != nil {
+		return fmt.Errorf("error encoding cache meta: %w", err)
+	}
+	tmp := filepath.Join(dir, metaFileName+".tmp")
+	if err := os.WriteFile(tmp, data, 0644); err != nil {
+		return fmt.Errorf("error writing cache meta: %w", err)
+	}
+	if err := os.Rename(tmp, filepath.Join(dir, metaFileName)); err != nil {
+		return fmt.Errorf("error writing cache meta: %w", err)
+	}
+	return nil
+}
+
+func dirSize(dir string) (int64, error) {
+	var size int64
+	err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.Type().IsRegular() {
+			info, err := d.Info()
+			if err != nil {
+				return err
+			}
+			size += info.Size()
+		}
+		return nil
+	})
+	return size, err
+}
+
+// evictFiles removes the least recently modified files in dir until its size is within maxSize.
+// Returns the resulting size of dir.
+func evictFiles(dir string, size int64, maxSize int64) (int64, error) {
+	type entry struct {
+		path    string
+		size    int64
+		modTime time.Time
+	}
+	var entries []entry
+	err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.Type().IsRegular() {
+			info, err := d.Info()
+			if err != nil {
+				return err
+			}
+			entries = append(entries, entry{path: path, size: info.Size(), modTime: info.ModTime()})
+		}
+		return nil
+	})
+	if err != nil {
+		return size, err
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].modTime.Before(entries[j].modTime)
+	})
+	for _, e := range entries {
+		if size <= maxSize {
+			break
+		}
+		if err := os.Remove(e.path); err != nil {
+			return size, err
+		}
+		size -= e.size
+	}
+	return size, nil
+}
@@ -0,0 +1,126 @@
+package cache
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestReleaseEvictsLeastRecentlyModifiedFiles(t *testing.T) {
+	manager := NewManager(zap.NewNop().Sugar(), Config{Dir: t.TempDir(), DefaultMaxSize: 10})
+	lease, err := manager.Acquire("test", 0)
+	require.NoError(t, err)
+	now := time.Now()
+	for i, name := range []string{"new", "old", "older"} {
+		path := filepath.Join(lease.Dir(), name)
+		require.NoError(t, os.WriteFile(path, []byte("12345"), 0644))
+		modTime := now.Add(-time.Duration(i) * time.Hour)
+		require.NoError(t, os.Chtimes(path, modTime, modTime))
+	}
+	require.NoError(t, lease.Release())
+
+	require.NoFileExists(t, filepath.Join(lease.Dir(), "older"))
+	require.FileExists(t, filepath.Join(lease.Dir(), "old"))
+	require.FileExists(t, filepath.Join(lease.Dir(), "new"))
+	infos, err := manager.List()
+	require.NoError(t, err)
+	require.Len(t, infos, 1)
+	require.Equal(t, int64(10), infos[0].Size)
+}
+
+func TestReleaseRespectsRequestedMaxSize(t *testing.T) {
+	manager := NewManager(zap.NewNop().Sugar(), Config{Dir: t.TempDir(), DefaultMaxSize: 10})
+	lease, err := manager.Acquire("test", 20)
+	require.NoError(t, err)
+	for _, name := range []string{"a", "b", "c"} {
+		require.NoError(t, os.WriteFile(filepath.Join(lease.Dir(), name), []byte("12345"), 0644))
+	}
+	require.NoError(t, lease.Release())
+	size, err := dirSize(lease.Dir())
+	require.NoError(t, err)
+	require.Equal(t, int64(15), size)
+}
+
+func TestReleaseEvictsLeastRecentlyUsedCaches(t *testing.T) {
+	dir := t.TempDir()
+	manager := NewManager(zap.NewNop().Sugar(), Config{Dir: dir, MaxTotalSize: 10})
+	fill := func(name string) {
+		lease, err := manager.Acquire(name, 0)
+		require.NoError(t, err)
+		require.NoError(t, os.WriteFile(filepath.Join(lease.Dir(), "data"), []byte("12345"), 0644))
+		require.NoError(t, lease.Release())
+	}
+	fill("a")
+	fill("b")
+	// Make b less recently used than a, so eviction doesn't simply follow creation order.
+	require.NoError(t, writeMeta(filepath.Join(dir, "b"), &meta{LastUsed: time.Now().Add(-time.Hour), Size: 5}))
+
+	// A cache that is in use is never evicted, even if it is the least recently used.
+	inUse, err := manager.Acquire("c", 0)
+	require.NoError(t, err)
+	require.NoError(t, writeMeta(filepath.Join(dir, "c"), &meta{LastUsed: time.Now().Add(-2 * time.Hour), Size: 5}))
+
+	fill("d")
+	infos, err := manager.List()
+	require.NoError(t, err)
+	var names []string
+	for _, info := range infos {
+		names = append(names, info.Name)
+	}
+	require.Equal(t, []string{"c", "d"}, names)
+	require.NoError(t, inUse.Release())
+}
+
+func TestConcurrentAcquire(t *testing.T) {
+	manager := NewManager(zap.NewNop().Sugar(), Config{Dir: t.TempDir(), DefaultMaxSize: 1024, MaxTotalSize: 2048})
+	// Each cache must only be leased exclusively by one caller at a time.
+	leased := make([]atomic.Int32, 3)
+	var wg sync.WaitGroup
+	errs := make(chan error, 50)
+	for i := 0; i < cap(errs); i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			errs <- func() error {
+				lease, err := manager.Acquire(fmt.Sprintf("cache-%d", i%3), 0)
+				if err != nil {
+					return err
+				}
+				if !lease.Ephemeral() {
+					if n := leased[i%3].Add(1); n > 1 {
+						return fmt.Errorf("cache-%d leased exclusively %d times", i%3, n)
+					}
+				}
+				err = os.WriteFile(filepath.Join(lease.Dir(), fmt.Sprintf("file-%d", i)), make([]byte, 100), 0644)
+				if !lease.Ephemeral() {
+					leased[i%3].Add(-1)
+				}
+				if err != nil {
+					return err
+				}
+				return lease.Release()
+			}()
+		}()
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		require.NoError(t, err)
+	}
+	infos, err := manager.List()
+	require.NoError(t, err)
+	var total int64
+	for _, info := range infos {
+		require.False(t, info.InUse)
+		require.LessOrEqual(t, info.Size, int64(1024))
+		total += info.Size
+	}
+	require.LessOrEqual(t, total, int64(2048))
+}
@@ -0,0 +1,102 @@
+package executor
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/knita-io/knita/internal/file"
+)
+
+// joinedStream is a stream of an export, other than the first, which the first stream sends files over.
+type joinedStream struct {
+	transport file.SendTransport
+	// done receives the result of the export once the first stream has finished sending.
+	done chan error
+}
+
+// pendingExport is an export whose streams are joining its first stream.
+type pendingExport struct {
+	runtimeID string
+	joined    chan *joinedStream
+}
+
+// exports tracks the streams of concurrent exports, so the first stream of each export can walk the files
+// to export once and send them over every stream. Exports are keyed by runtime as well as by ID, as export IDs
+// are chosen by clients, so the streams of an export can only join an export from the same runtime.
+type exports struct {
+	mu      sync.Mutex
+	pending map[string]*pendingExport
+}
+
+func newExports() *exports {
+	return &exports{pending: make(map[string]*pendingExport)}
+}
+
+// get returns the pending export with the given ID, creating it if its first stream has yet to open.
+func (m *exports) get(runtimeID string, exportID string) *pendingExport {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.getLocked(runtimeID, exportID)
+}
+
+// getLocked is get for callers that hold m.mu.
+func (m *exports) getLocked(runtimeID string, exportID string) *pendingExport {
+	key := exportKey(runtimeID, exportID)
+	exp, ok := m.pending[key]
+	if !ok {
+		exp = &pendingExport{runtimeID: runtimeID, joined: make(chan *joinedStream, file.MaxStreams)}
+		m.pending[key] = exp
+	}
+	return exp
+}
+
+// join adds a stream to the export with the given ID, which the export's first stream will send files over.
+func (m *exports) join(runtimeID string, exportID string, transport file.SendTransport) (*joinedStream, error) {
+	stream := &joinedStream{transport: transport, done: make(chan error, 1)}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	select {
+	case m.getLocked(runtimeID, exportID).joined <- stream:
+		return stream, nil
+	default:
+		return nil, fmt.Errorf("error export has more than %d streams", file.MaxStreams)
+	}
+}
+
+// finish stops tracking the export with the given ID once its first stream has finished, and sends the export's
+// result to the streams the first stream took, and to any that have joined since, so none wait for it forever.
+func (m *exports) finish(runtimeID string, exportID string, joined []*joinedStream, err error) {
+	m.mu.Lock()
+	key := exportKey(runtimeID, exportID)
+	if exp, ok := m.pending[key]; ok {
+		delete(m.pending, key)
+	drain:
+		for {
+			select {
+			case j := <-exp.joined:
+				joined = append(joined, j)
+			default:
+				break drain
+			}
+		}
+	}
+	m.mu.Unlock()
+	for _, j := range joined {
+		j.done <- err
+	}
+}
+
+// forget stops tracking the exports from a closed runtime, some of whose streams may never have been opened.
+func (m *exports) forget(runtimeID string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for key, exp := range m.pending {
+		if exp.runtimeID == runtimeID {
+			delete(m.pending, key)
+		}
+	}
+}
+
+func exportKey(runtimeID string, exportID string) string {
+	return runtimeID + "/" + exportID
+}
@@ -0,0 +1,28 @@
+package executor
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestExportsJoin(t *testing.T) {
+	m := newExports()
+	joined, err := m.join("runtime-a", "export", nil)
+	require.NoError(t, err)
+	other, err := m.join("runtime-b", "export", nil)
+	require.NoError(t, err)
+
+	// Streams only join exports from their own runtime.
+	require.Len(t, m.get("runtime-a", "export").joined, 1)
+	require.Len(t, m.get("runtime-b", "export").joined, 1)
+
+	// Streams the first stream has yet to take are sent its result when it fails.
+	failed := errors.New("failed")
+	m.finish("runtime-a", "export", nil, failed)
+	require.Equal(t, failed, <-joined.done)
+	require.Len(t, other.done, 0)
+	m.forget("runtime-b")
+	require.Empty(t, m.pending)
+}
@@ -0,0 +1,101 @@
+package executor
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+
+	"google.golang.org/grpc/metadata"
+
+	"github.com/knita-io/knita/internal/file"
+)
+
+// pendingImport is an import, some of whose streams have yet to close.
+type pendingImport struct {
+	id       string
+	dirModes *file.DirModes
+	// runtimeID and dest are set by the first stream to receive a file.
+	runtimeID string
+	dest      file.WriteFS
+	// open is the number of the import's streams that have yet to close.
+	open int
+}
+
+// imports tracks the streams of concurrent imports, so the modes of the directories they receive
+// can be applied once every stream of the import has closed.
+type imports struct {
+	mu      sync.Mutex
+	pending map[string]*pendingImport
+}
+
+func newImports() *imports {
+	return &imports{pending: make(map[string]*pendingImport)}
+}
+
+// join returns the import that the stream with the given request header belongs to.
+// Streams from directors that do not report their import's ID are tracked as imports of their own.
+// The number of streams the director reports is capped at file.MaxStreams, and imports whose director
+// opens fewer streams than it reported are completed by forget, once their runtime closes.
+func (m *imports) join(header metadata.MD) *pendingImport {
+	id := file.TransferIDFromHeader(header)
+	if id == "" {
+		return &pendingImport{dirModes: file.NewDirModes(), open: 1}
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	imp, ok := m.pending[id]
+	if !ok {
+		imp = &pendingImport{id: id, dirModes: file.NewDirModes(), open: min(file.StreamsFromHeader(header), file.MaxStreams)}
+		m.pending[id] = imp
+	}
+	return imp
+}
+
+// setDest sets the runtime the import is to, if it has not already been set.
+// Returns an error if the import is to another runtime, as import IDs are chosen by directors.
+func (m *imports) setDest(imp *pendingImport, runtimeID string, dest file.WriteFS) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if imp.dest == nil {
+		imp.runtimeID = runtimeID
+		imp.dest = dest
+	} else if imp.runtimeID != runtimeID {
+		return fmt.Errorf("error import %s is to another runtime", imp.id)
+	}
+	return nil
+}
+
+// forget stops tracking the imports to a runtime that is closing, some of whose streams may never have been opened,
+// and applies the modes of the directories they received.
+func (m *imports) forget(runtimeID string) error {
+	m.mu.Lock()
+	var partial []*pendingImport
+	for id, imp := range m.pending {
+		if imp.runtimeID == runtimeID {
+			delete(m.pending, id)
+			partial = append(partial, imp)
+		}
+	}
+	m.mu.Unlock()
+	var err error
+	for _, imp := range partial {
+		err = errors.Join(err, imp.dirModes.Apply(imp.dest))
+	}
+	return err
+}
+
+// leave records that a stream of the import has closed. Once every stream has closed,
+// the modes of the directories the import received are applied.
+func (m *imports) leave(imp *pendingImport) error {
+	m.mu.Lock()
+	imp.open--
+	done := imp.open <= 0
+	if done && imp.id != "" && m.pending[imp.id] == imp {
+		delete(m.pending, imp.id)
+	}
+	m.mu.Unlock()
+	if !done || imp.dest == nil {
+		return nil
+	}
+	return imp.dirModes.Apply(imp.dest)
+}
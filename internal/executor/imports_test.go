@@ -0,0 +1,28 @@
+package executor
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/knita-io/knita/internal/file"
+)
+
+func TestImportsJoin(t *testing.T) {
+	m := newImports()
+	imp := m.join(file.ImportStreamsMD("import", 1000))
+	require.Equal(t, file.MaxStreams, imp.open)
+	require.Same(t, imp, m.join(file.ImportStreamsMD("import", 1000)))
+
+	dest := file.WriteDirFS(t.TempDir())
+	require.NoError(t, m.setDest(imp, "runtime-a", dest))
+	require.NoError(t, m.setDest(imp, "runtime-a", dest))
+	// Streams only join imports to their own runtime.
+	require.Error(t, m.setDest(imp, "runtime-b", dest))
+
+	// Imports whose director opened fewer streams than it reported are completed once their runtime closes.
+	require.NoError(t, m.leave(imp))
+	require.Len(t, m.pending, 1)
+	require.NoError(t, m.forget("runtime-a"))
+	require.Empty(t, m.pending)
+}
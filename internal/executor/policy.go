@@ -0,0 +1,59 @@
+package executor
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	executorv1 "github.com/knita-io/knita/api/executor/v1"
+)
+
+// DockerPolicy restricts the Docker runtime options that builds may request.
+// The zero value permits all options.
+type DockerPolicy struct {
+	// ForbidPrivileged rejects runtimes that request a privileged container.
+	ForbidPrivileged bool
+	// ForbidDockerSocket rejects runtimes that request the Docker socket be mounted.
+	ForbidDockerSocket bool
+	// ForbiddenNetworkModes lists network modes runtimes may not request e.g. "host".
+	ForbiddenNetworkModes []string
+	// ForbiddenCapabilities lists Linux capabilities runtimes may not add.
+	// "ALL" forbids adding any capability. As adding "ALL" or running privileged grants every capability,
+	// both are rejected if any capability is forbidden.
+	ForbiddenCapabilities []string
+}
+
+// check returns an error if opts requests an option that is forbidden by the policy.
+func (p DockerPolicy) check(opts *executorv1.DockerOpts) error {
+	if p.ForbidPrivileged && opts.Privileged {
+		return fmt.Errorf("privileged Docker containers are forbidden by executor policy")
+	}
+	if len(p.ForbiddenCapabilities) > 0 && opts.Privileged {
+		return fmt.Errorf("privileged Docker containers are forbidden by executor policy, as they have forbidden capabilities")
+	}
+	if p.ForbidDockerSocket && opts.MountDockerSocket {
+		return fmt.Errorf("mounting the Docker socket is forbidden by executor policy")
+	}
+	networkMode := opts.NetworkMode
+	if networkMode == "" {
+		networkMode = "bridge"
+	}
+	if slices.Contains(p.ForbiddenNetworkModes, networkMode) {
+		return fmt.Errorf("network mode %q is forbidden by executor policy", networkMode)
+	}
+	for _, capability := range opts.CapAdd {
+		for _, forbidden := range p.ForbiddenCapabilities {
+			if strings.EqualFold(forbidden, "ALL") || strings.EqualFold(capability, "ALL") ||
+				normalizeCapability(forbidden) == normalizeCapability(capability) {
+				return fmt.Errorf("adding capability %q is forbidden by executor policy", capability)
+			}
+		}
+	}
+	return nil
+}
+
+// normalizeCapability returns the canonical form of a Linux capability name,
+// such that "net_admin" and "CAP_NET_ADMIN" compare equal.
+func normalizeCapability(capability string) string {
+	return strings.TrimPrefix(strings.ToUpper(capability), "CAP_")
+}
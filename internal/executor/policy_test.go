@@ -0,0 +1,59 @@
+package executor
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	executorv1 "github.com/knita-io/knita/api/executor/v1"
+)
+
+func TestDockerPolicyCheck(t *testing.T) {
+	strict := DockerPolicy{
+		ForbidPrivileged:      true,
+		ForbidDockerSocket:    true,
+		ForbiddenNetworkModes: []string{"host"},
+		ForbiddenCapabilities: []string{"SYS_ADMIN", "cap_net_admin"},
+	}
+	var table = []struct {
+		name   string
+		policy DockerPolicy
+		opts   *executorv1.DockerOpts
+		err    string
+	}{
+		{name: "zero policy allows everything", policy: DockerPolicy{}, opts: &executorv1.DockerOpts{
+			Privileged: true, MountDockerSocket: true, NetworkMode: "host", CapAdd: []string{"ALL"}}},
+		{name: "defaults", policy: strict, opts: &executorv1.DockerOpts{}},
+		{name: "allowed capability", policy: strict, opts: &executorv1.DockerOpts{CapAdd: []string{"NET_BIND_SERVICE"}}},
+		{name: "dropped capability", policy: strict, opts: &executorv1.DockerOpts{CapDrop: []string{"SYS_ADMIN", "ALL"}}},
+		{name: "allowed network mode", policy: strict, opts: &executorv1.DockerOpts{NetworkMode: "none"}},
+		{name: "privileged", policy: strict, opts: &executorv1.DockerOpts{Privileged: true},
+			err: "privileged Docker containers are forbidden"},
+		{name: "privileged with forbidden capabilities", policy: DockerPolicy{ForbiddenCapabilities: []string{"SYS_ADMIN"}},
+			opts: &executorv1.DockerOpts{Privileged: true}, err: "privileged Docker containers are forbidden"},
+		{name: "docker socket", policy: strict, opts: &executorv1.DockerOpts{MountDockerSocket: true},
+			err: "mounting the Docker socket is forbidden"},
+		{name: "network mode", policy: strict, opts: &executorv1.DockerOpts{NetworkMode: "host"},
+			err: `network mode "host" is forbidden`},
+		{name: "default network mode", policy: DockerPolicy{ForbiddenNetworkModes: []string{"bridge"}},
+			opts: &executorv1.DockerOpts{}, err: `network mode "bridge" is forbidden`},
+		{name: "capability", policy: strict, opts: &executorv1.DockerOpts{CapAdd: []string{"SYS_ADMIN"}},
+			err: `adding capability "SYS_ADMIN" is forbidden`},
+		{name: "capability with prefix and case", policy: strict, opts: &executorv1.DockerOpts{CapAdd: []string{"CAP_net_admin"}},
+			err: `adding capability "CAP_net_admin" is forbidden`},
+		{name: "all capabilities", policy: strict, opts: &executorv1.DockerOpts{CapAdd: []string{"ALL"}},
+			err: `adding capability "ALL" is forbidden`},
+		{name: "forbid all", policy: DockerPolicy{ForbiddenCapabilities: []string{"all"}},
+			opts: &executorv1.DockerOpts{CapAdd: []string{"NET_BIND_SERVICE"}}, err: `adding capability "NET_BIND_SERVICE" is forbidden`},
+	}
+	for _, test := range table {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.policy.check(test.opts)
+			if test.err == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, test.err)
+			}
+		})
+	}
+}
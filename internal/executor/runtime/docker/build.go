@@ -0,0 +1,119 @@
+package docker
+
+import (
+	"archive/tar"
+	"crypto/sha256"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+
+	executorv1 "github.com/knita-io/knita/api/executor/v1"
+)
+
+// buildImageRepository is the local repository built runtime images are tagged into.
+const buildImageRepository = "knita/build"
+
+// buildImageTag returns the tag a runtime image built from contextDir and opts is cached under.
+// The tag is a fingerprint of the build context contents (paths, modes, symlink targets and file
+// data) and the build options, so any change to either results in a new image.
+func buildImageTag(contextDir string, opts *executorv1.DockerBuildOpts) (string, error) {
+	h := sha256.New()
+	err := filepath.WalkDir(contextDir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(contextDir, path)
+		if err != nil {
+			return err
+		}
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(h, "%s\x00%o\x00", filepath.ToSlash(rel), info.Mode())
+		switch {
+		case info.Mode()&fs.ModeSymlink != 0:
+			target, err := os.Readlink(path)
+			if err != nil {
+				return err
+			}
+			fmt.Fprintf(h, "%s\x00", target)
+		case info.Mode().IsRegular():
+			f, err := os.Open(path)
+			if err != nil {
+				return err
+			}
+			defer f.Close()
+			if _, err := io.Copy(h, f); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return "", fmt.Errorf("error fingerprinting build context: %w", err)
+	}
+	fmt.Fprintf(h, "dockerfile\x00%s\x00target\x00%s\x00", opts.Dockerfile, opts.Target)
+	var keys []string
+	for k := range opts.BuildArgs {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		fmt.Fprintf(h, "arg\x00%s\x00%s\x00", k, opts.BuildArgs[k])
+	}
+	return fmt.Sprintf("%s:%x", buildImageRepository, h.Sum(nil)[:16]), nil
+}
+
+// tarBuildContext writes the contents of contextDir to w as a tar archive.
+func tarBuildContext(contextDir string, w io.Writer) error {
+	tw := tar.NewWriter(w)
+	err := filepath.WalkDir(contextDir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(contextDir, path)
+		if err != nil {
+			return err
+		}
+		if rel == "." {
+			return nil
+		}
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		var link string
+		if info.Mode()&fs.ModeSymlink != 0 {
+			link, err = os.Readlink(path)
+			if err != nil {
+				return err
+			}
+		}
+		header, err := tar.FileInfoHeader(info, link)
+		if err != nil {
+			return err
+		}
+		header.Name = filepath.ToSlash(rel)
+		if err := tw.WriteHeader(header); err != nil {
+			return err
+		}
+		if !info.Mode().IsRegular() {
+			return nil
+		}
+		f, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		_, err = io.Copy(tw, f)
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("error archiving build context: %w", err)
+	}
+	return tw.Close()
+}
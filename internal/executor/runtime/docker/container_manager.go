@@ -1,10 +1,12 @@
 package docker
 
 import (
+	"bytes"
 	"context"
 	"encoding/base64"
 	"fmt"
 	"io"
+	"path/filepath"
 	"strings"
 	"time"
 
@@ -18,6 +20,7 @@ import (
 	"github.com/docker/docker/api/types/image"
 	"github.com/docker/docker/api/types/network"
 	"github.com/docker/docker/errdefs"
+	"github.com/google/uuid"
 	"github.com/hashicorp/go-multierror"
 	"github.com/moby/moby/client"
 	"github.com/moby/moby/pkg/stdcopy"
@@ -43,8 +46,19 @@ type ContainerConfig struct {
 	// Aliases is a list of names this container will be resolvable on from
 	// each of the configured networks (if any).
 	Aliases []string
-	Stdout  io.Writer
-	Stderr  io.Writer
+	// NetworkMode is the Docker network mode of the container e.g. "bridge", "host" or "none".
+	// Defaults to the Docker daemon default (bridge).
+	NetworkMode    string
+	User           string
+	GroupAdd       []string
+	CapAdd         []string
+	CapDrop        []string
+	Privileged     bool
+	ReadonlyRootfs bool
+	Tmpfs          map[string]string
+	ShmSize        int64
+	Stdout         io.Writer
+	Stderr         io.Writer
 }
 
 type ExecConfig struct {
@@ -52,10 +66,50 @@ type ExecConfig struct {
 	Command     []string
 	WorkingDir  string
 	Env         []string
-	Stdout      io.Writer
-	Stderr      io.Writer
+	// User is the user (and optionally group) the exec runs as. Defaults to the container's user.
+	User string
+	// Tty allocates a pseudo-terminal for the exec. The terminal's output is written to Stdout.
+	Tty *executorv1.TtyOpts
+	// ResizeC receives new sizes for the exec's pseudo-terminal. Only used if Tty is set.
+	ResizeC <-chan *executorv1.TtyOpts
+	Stdin   io.Reader
+	Stdout  io.Writer
+	Stderr  io.Writer
+	// OS is the operating system of the container. Execs can only be signalled in Linux containers.
+	OS runtime.OS
+	// Marker uniquely identifies the exec's processes so they can be signalled. Generated if empty.
+	Marker string
+	// TerminationSignal is sent to the exec's processes when ctx is cancelled. Defaults to SIGTERM.
+	TerminationSignal string
+	// TerminationGracePeriod is how long the exec's processes are given to exit after being sent
+	// the termination signal, before they are killed. Defaults to runtime.DefaultTerminationGracePeriod.
+	TerminationGracePeriod time.Duration
 }
 
+// execMarkerEnv is set in the environment of every exec to a unique value, which is inherited by
+// all processes the exec spawns. It allows the exec's process tree to be identified for termination,
+// as Docker has no API for signalling an exec.
+const execMarkerEnv = "KNITA_EXEC_MARKER"
+
+// signalExecScript sends the signal named by $1 (e.g. "QUIT") to every process in a Linux container whose
+// environment contains the exec marker $2. It requires sh, tr and grep inside the container, and
+// exits with signalExecNotFound if no processes were found, or signalExecFailed if any could not be signalled.
+const signalExecScript = `found=0
+failed=0
+for p in /proc/[0-9]*; do
+  if tr '\0' '\n' 2>/dev/null < "$p/environ" | grep -qx "` + execMarkerEnv + `=$2"; then
+    found=1
+    kill -s "$1" "${p#/proc/}" || failed=1
+  fi
+done
+[ "$failed" = 1 ] && exit 3
+[ "$found" = 1 ] || exit 2`
+
+const (
+	signalExecNotFound = 2
+	signalExecFailed   = 3
+)
+
 type ContainerManager struct {
 	client *client.Client
 	syslog *zap.SugaredLogger
@@ -157,21 +211,93 @@ func (r *ContainerManager) PullDockerImage(ctx context.Context, log *runtime.Log
 	}
 	defer stream.Close()
 
-	// TODO can output image pull info to the build logs here:
-	// 	Do a list on the image to discover its size
-	//  Intercept stream and output some progress information as it's being read
-	//  Make this a generic util and use it for other pulls
-	res, err := r.client.ImageLoad(ctx, stream, false)
+	progress := newPullProgress(log, opts.ImageUri)
+	err = decodeJSONMessages(stream, progress.Update)
 	if err != nil {
-		return errors.Wrap(err, "error loading image")
+		return fmt.Errorf("error pulling image: %w", err)
 	}
-	defer res.Body.Close()
+	progress.Publish()
 	return nil
 }
 
+// BuildDockerImage builds a Docker image from the build context in contextDir and tags it with tag.
+func (r *ContainerManager) BuildDockerImage(ctx context.Context, log *runtime.Log, contextDir string, tag string, opts *executorv1.DockerBuildOpts) error {
+	buildArgs := make(map[string]*string, len(opts.BuildArgs))
+	for k, v := range opts.BuildArgs {
+		buildArgs[k] = &v
+	}
+	dockerfile := opts.Dockerfile
+	if dockerfile == "" {
+		dockerfile = "Dockerfile"
+	}
+	buildOpts := types.ImageBuildOptions{
+		Tags:        []string{tag},
+		Dockerfile:  filepath.ToSlash(dockerfile),
+		BuildArgs:   buildArgs,
+		Target:      opts.Target,
+		Remove:      true,
+		ForceRemove: true,
+	}
+	pr, pw := io.Pipe()
+	go func() {
+		pw.CloseWithError(tarBuildContext(contextDir, pw))
+	}()
+	defer pr.Close()
+	res, err := r.client.ImageBuild(ctx, pr, buildOpts)
+	if err != nil {
+		return fmt.Errorf("error building image: %w", err)
+	}
+	defer res.Body.Close()
+	w := log.Stdout()
+	defer w.Close()
+	return decodeJSONMessages(res.Body, func(msg *jsonMessage) error {
+		if msg.Stream != "" {
+			_, err := w.Write([]byte(msg.Stream))
+			return err
+		}
+		return nil
+	})
+}
+
+// DockerImageExists returns true if the specified Docker image exists in the local image cache.
+func (r *ContainerManager) DockerImageExists(ctx context.Context, imageURI string) (bool, error) {
+	fil := filters.NewArgs()
+	fil.Add("reference", imageURI)
+	list, err := r.client.ImageList(ctx, image.ListOptions{
+		All:     false,
+		Filters: fil,
+	})
+	if err != nil {
+		return false, fmt.Errorf("error listing images: %w", err)
+	}
+	return len(list) > 0, nil
+}
+
 // GetDockerImageOS returns the type of underlying guest OS the specified Docker image
 // is made from. The docker image must have been pulled first.
 func (r *ContainerManager) GetDockerImageOS(ctx context.Context, imageURI string) (runtime.OS, error) {
+	inspect, err := r.inspectImage(ctx, imageURI)
+	if err != nil {
+		return "", err
+	}
+	return runtime.OS(inspect.Os), nil
+}
+
+// GetDockerImageDigest returns the content digest of the specified Docker image.
+// The repo digest is returned for images that were pulled from a registry, otherwise
+// the image ID is returned. The docker image must have been pulled (or built) first.
+func (r *ContainerManager) GetDockerImageDigest(ctx context.Context, imageURI string) (string, error) {
+	inspect, err := r.inspectImage(ctx, imageURI)
+	if err != nil {
+		return "", err
+	}
+	if len(inspect.RepoDigests) > 0 {
+		return inspect.RepoDigests[0], nil
+	}
+	return inspect.ID, nil
+}
+
+func (r *ContainerManager) inspectImage(ctx context.Context, imageURI string) (*types.ImageInspect, error) {
 	fil := filters.NewArgs()
 	fil.Add("reference", imageURI)
 	list, err := r.client.ImageList(ctx, image.ListOptions{
@@ -179,37 +305,54 @@ func (r *ContainerManager) GetDockerImageOS(ctx context.Context, imageURI string
 		Filters: fil,
 	})
 	if err != nil {
-		return "", fmt.Errorf("error listing images: %w", err)
+		return nil, fmt.Errorf("error listing images: %w", err)
 	}
 	if len(list) == 0 {
-		return "", fmt.Errorf("error image %q does not exist", imageURI)
+		return nil, fmt.Errorf("error image %q does not exist", imageURI)
 	}
 	inspect, _, err := r.client.ImageInspectWithRaw(ctx, list[0].ID)
 	if err != nil {
-		return "", fmt.Errorf("error inspecting image %q: %w", imageURI, err)
+		return nil, fmt.Errorf("error inspecting image %q: %w", imageURI, err)
 	}
-	return runtime.OS(inspect.Os), nil
+	return &inspect, nil
 }
 
 // StartContainer starts a new container in the background and returns its unique ID.
 // Call StopContainer to stop it and free up resources.
-func (r *ContainerManager) StartContainer(ctx context.Context, config ContainerConfig) (string, error) {
+func (r *ContainerManager) StartContainer(ctx context.Context, config ContainerConfig) (id string, err error) {
 	cConfig := &container.Config{
 		Image:      config.ImageURI,
 		Entrypoint: config.Entrypoint,
 		Cmd:        config.Command,
 		WorkingDir: config.WorkingDir,
 		Env:        config.Env,
+		User:       config.User,
 	}
 	hConfig := &container.HostConfig{
-		AutoRemove: false,
-		Binds:      config.Binds,
+		AutoRemove:     false,
+		Binds:          config.Binds,
+		NetworkMode:    container.NetworkMode(config.NetworkMode),
+		GroupAdd:       config.GroupAdd,
+		CapAdd:         config.CapAdd,
+		CapDrop:        config.CapDrop,
+		Privileged:     config.Privileged,
+		ReadonlyRootfs: config.ReadonlyRootfs,
+		Tmpfs:          config.Tmpfs,
+		ShmSize:        config.ShmSize,
 	}
 	nConfig := &network.NetworkingConfig{}
 	res, err := r.client.ContainerCreate(ctx, cConfig, hConfig, nConfig, nil, config.Name) // platform is optional
 	if err != nil {
 		return "", errors.Wrap(err, "error creating container")
 	}
+	defer func() {
+		if err != nil {
+			// The container is not returned to the caller, so it must be removed here or it would leak.
+			if rmErr := r.StopContainer(context.Background(), res.ID); rmErr != nil {
+				r.syslog.Warnw("Failed to remove container that failed to start", "container_id", res.ID, "error", rmErr)
+			}
+		}
+	}()
 	for _, networkID := range config.Networks {
 		nConfig := &network.EndpointSettings{Aliases: config.Aliases}
 		err = r.client.NetworkConnect(ctx, networkID, res.ID, nConfig)
@@ -249,29 +392,73 @@ func (r *ContainerManager) StopContainer(ctx context.Context, containerID string
 
 // Execute a command inside the container.
 // StartContainer must have previously been called.
+// If ctx is cancelled, the command and any processes it spawned are terminated.
 func (r *ContainerManager) Execute(ctx context.Context, config ExecConfig) error {
+	if config.Marker == "" {
+		config.Marker = uuid.New().String()
+	}
+	if config.TerminationSignal == "" {
+		config.TerminationSignal = runtime.DefaultTerminationSignal
+	}
+	if config.TerminationGracePeriod == 0 {
+		config.TerminationGracePeriod = runtime.DefaultTerminationGracePeriod
+	}
 	eConfig := types.ExecConfig{
 		Cmd:          config.Command,
-		Env:          config.Env,
+		Env:          append(append([]string{}, config.Env...), fmt.Sprintf("%s=%s", execMarkerEnv, config.Marker)),
 		WorkingDir:   config.WorkingDir,
+		User:         config.User,
 		Detach:       false,
+		AttachStdin:  config.Stdin != nil,
 		AttachStderr: true,
 		AttachStdout: true,
 	}
+	startCheck := types.ExecStartCheck{}
+	if config.Tty != nil {
+		rows, cols := runtime.TtySize(config.Tty)
+		eConfig.Tty = true
+		eConfig.ConsoleSize = &[2]uint{uint(rows), uint(cols)}
+		startCheck.Tty = true
+		startCheck.ConsoleSize = eConfig.ConsoleSize
+	}
 	createRes, err := r.client.ContainerExecCreate(ctx, config.ContainerID, eConfig)
 	if err != nil {
 		return fmt.Errorf("error creating exec: %w", err)
 	}
-	resp, err := r.client.ContainerExecAttach(ctx, createRes.ID, types.ExecStartCheck{})
+	resp, err := r.client.ContainerExecAttach(ctx, createRes.ID, startCheck)
 	if err != nil {
 		return fmt.Errorf("error attaching exec: %w", err)
 	}
 	defer resp.Close()
-	if config.Stdout != nil || config.Stderr != nil {
-		err = r.pipeContainerLog(resp.Reader, config.Stdout, config.Stderr)
-		if err != nil {
-			return fmt.Errorf("error piping container log.go: %w", err)
-		}
+	if config.Stdin != nil {
+		go func() {
+			io.Copy(resp.Conn, config.Stdin)
+			resp.CloseWrite()
+		}()
+	}
+	if config.Tty != nil && config.ResizeC != nil {
+		resizeCtx, cancelResize := context.WithCancel(ctx)
+		defer cancelResize()
+		go r.resizeExec(resizeCtx, createRes.ID, config.ResizeC)
+	}
+	stdout, stderr := config.Stdout, config.Stderr
+	if stdout == nil {
+		stdout = io.Discard
+	}
+	if stderr == nil {
+		stderr = io.Discard
+	}
+	var pipeDoneC <-chan struct{}
+	if config.Tty != nil {
+		// Tty output is a raw terminal stream, rather than multiplexed stdout and stderr.
+		pipeDoneC = r.pipeRawLogAsync(resp.Reader, stdout)
+	} else {
+		pipeDoneC = r.pipeContainerLogAsync(resp.Reader, stdout, stderr)
+	}
+	select {
+	case <-pipeDoneC:
+	case <-ctx.Done():
+		return &cancelledError{err: ctx.Err(), terminateErr: r.terminateExec(config, pipeDoneC)}
 	}
 	var exitCode int
 	for {
@@ -292,12 +479,144 @@ func (r *ContainerManager) Execute(ctx context.Context, config ExecConfig) error
 	return nil
 }
 
+// resizeExec resizes an exec's pseudo-terminal whenever a new size is received from resizeC, until ctx is done.
+func (r *ContainerManager) resizeExec(ctx context.Context, execID string, resizeC <-chan *executorv1.TtyOpts) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case size, ok := <-resizeC:
+			if !ok {
+				return
+			}
+			rows, cols := runtime.TtySize(size)
+			err := r.client.ContainerExecResize(ctx, execID, container.ResizeOptions{Height: uint(rows), Width: uint(cols)})
+			if err != nil {
+				r.syslog.Warnf("Error resizing exec tty: %v", err)
+			}
+		}
+	}
+}
+
+// terminateExec terminates a cancelled exec's processes, escalating to a kill if they have not
+// exited within the grace period. doneC must close once the exec's output stream closes, which
+// happens once all of its processes have exited. Returns an error if the processes may still be running.
+func (r *ContainerManager) terminateExec(config ExecConfig, doneC <-chan struct{}) error {
+	if config.OS != runtime.OSLinux {
+		r.syslog.Warnf("Unable to terminate cancelled exec in %s container; processes will run until the container is removed", config.OS)
+		return fmt.Errorf("error terminating execs is not supported in %s containers", config.OS)
+	}
+	var signalErr error
+	for _, signal := range []string{config.TerminationSignal, "SIGKILL"} {
+		ctx, cancel := context.WithTimeout(context.Background(), config.TerminationGracePeriod)
+		err := r.SignalExec(ctx, config.ContainerID, config.OS, config.Marker, signal)
+		cancel()
+		if err != nil {
+			r.syslog.Warnf("Error sending %s to cancelled exec: %v", signal, err)
+			signalErr = err
+		}
+		select {
+		case <-doneC:
+			return nil
+		case <-time.After(config.TerminationGracePeriod):
+		}
+	}
+	r.syslog.Warnf("Cancelled exec did not exit after being killed")
+	if signalErr != nil {
+		return signalErr
+	}
+	return fmt.Errorf("error exec did not exit after being killed")
+}
+
+// SignalExec sends the named signal (e.g. "SIGQUIT") to every process inside the container that
+// was spawned by the exec identified by marker. Only Linux containers are supported.
+// As Docker has no API for signalling an exec, the signal is sent by a helper exec, which fails in containers
+// without sh, tr and grep (e.g. distroless images), or without the CAP_KILL capability.
+func (r *ContainerManager) SignalExec(ctx context.Context, containerID string, os runtime.OS, marker string, signal string) error {
+	if os != runtime.OSLinux {
+		return fmt.Errorf("error signalling execs is not supported in %s containers", os)
+	}
+	eConfig := types.ExecConfig{
+		Cmd:          []string{"sh", "-c", signalExecScript, "sh", strings.TrimPrefix(signal, "SIG"), marker},
+		User:         "0",
+		AttachStdout: true,
+		AttachStderr: true,
+	}
+	createRes, err := r.client.ContainerExecCreate(ctx, containerID, eConfig)
+	if err != nil {
+		return fmt.Errorf("error creating signal exec: %w", err)
+	}
+	resp, err := r.client.ContainerExecAttach(ctx, createRes.ID, types.ExecStartCheck{})
+	if err != nil {
+		return fmt.Errorf("error attaching signal exec: %w", err)
+	}
+	defer resp.Close()
+	output := &limitedBuffer{max: 1024}
+	_, err = stdcopy.StdCopy(output, output, resp.Reader)
+	if err != nil {
+		return fmt.Errorf("error reading signal exec output: %w", err)
+	}
+	res, err := r.client.ContainerExecInspect(ctx, createRes.ID)
+	if err != nil {
+		return fmt.Errorf("error inspecting signal exec: %w", err)
+	}
+	switch res.ExitCode {
+	case 0:
+		return nil
+	case signalExecNotFound:
+		return fmt.Errorf("error no running processes found for exec")
+	case signalExecFailed:
+		return fmt.Errorf("error sending %s to exec (the container may lack the CAP_KILL capability): %s", signal, output)
+	default:
+		return fmt.Errorf("error sending %s to exec; signalling requires sh, tr and grep in the container (exit code %d): %s",
+			signal, res.ExitCode, output)
+	}
+}
+
+// limitedBuffer keeps the first max bytes written to it and discards the rest.
+type limitedBuffer struct {
+	buf bytes.Buffer
+	max int
+}
+
+func (b *limitedBuffer) Write(p []byte) (int, error) {
+	if remaining := b.max - b.buf.Len(); remaining > 0 {
+		b.buf.Write(p[:min(len(p), remaining)])
+	}
+	return len(p), nil
+}
+
+func (b *limitedBuffer) String() string {
+	return strings.TrimSpace(b.buf.String())
+}
+
+// cancelledError is returned by Execute when its context is cancelled.
+type cancelledError struct {
+	err error
+	// terminateErr is set if the exec's processes could not be terminated, and may still be running.
+	terminateErr error
+}
+
+func (e *cancelledError) Error() string {
+	if e.terminateErr != nil {
+		return fmt.Sprintf("error exec cancelled: %v (unable to terminate: %v)", e.err, e.terminateErr)
+	}
+	return fmt.Sprintf("error exec cancelled: %v", e.err)
+}
+
+func (e *cancelledError) Unwrap() error {
+	return e.err
+}
+
 type exitError struct {
 	err      error
 	exitCode int
 }
 
 func (e *exitError) Error() string {
+	if e.err == nil {
+		return fmt.Sprintf("exit code %d", e.exitCode)
+	}
 	return e.err.Error()
 }
 
@@ -329,6 +648,18 @@ func (r *ContainerManager) pipeContainerLog(from io.Reader, stdout io.Writer, st
 	return nil
 }
 
+func (r *ContainerManager) pipeRawLogAsync(from io.Reader, to io.Writer) <-chan struct{} {
+	doneC := make(chan struct{})
+	go func() {
+		defer close(doneC)
+		_, err := io.Copy(to, from)
+		if err != nil && !errors.Is(err, io.ErrClosedPipe) {
+			r.syslog.Warnf("Ignoring error piping exec tty; Logs may be incomplete: %s", err)
+		}
+	}()
+	return doneC
+}
+
 func (r *ContainerManager) pipeContainerLogAsync(from io.Reader, stdout io.Writer, stderr io.Writer) <-chan struct{} {
 	doneC := make(chan struct{})
 	go func() {
@@ -0,0 +1,49 @@
+package docker
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// jsonMessage is a single message in a Docker API JSON message stream, as returned by
+// the image build and pull endpoints.
+type jsonMessage struct {
+	Stream   string `json:"stream,omitempty"`
+	Status   string `json:"status,omitempty"`
+	ID       string `json:"id,omitempty"`
+	Progress *struct {
+		Current int64 `json:"current,omitempty"`
+		Total   int64 `json:"total,omitempty"`
+	} `json:"progressDetail,omitempty"`
+	ErrorDetail *struct {
+		Message string `json:"message,omitempty"`
+	} `json:"errorDetail,omitempty"`
+	ErrorMessage string `json:"error,omitempty"`
+}
+
+// decodeJSONMessages decodes each message in the stream r and passes it to fn.
+// Returns an error if the stream reports an error.
+func decodeJSONMessages(r io.Reader, fn func(msg *jsonMessage) error) error {
+	dec := json.NewDecoder(r)
+	for {
+		msg := &jsonMessage{}
+		err := dec.Decode(msg)
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			return fmt.Errorf("error decoding Docker message stream: %w", err)
+		}
+		if msg.ErrorDetail != nil && msg.ErrorDetail.Message != "" {
+			return fmt.Errorf("error from Docker: %s", msg.ErrorDetail.Message)
+		}
+		if msg.ErrorMessage != "" {
+			return fmt.Errorf("error from Docker: %s", msg.ErrorMessage)
+		}
+		if err := fn(msg); err != nil {
+			return err
+		}
+	}
+}
@@ -0,0 +1,77 @@
+package docker
+
+import (
+	"strings"
+	"time"
+
+	builtinv1 "github.com/knita-io/knita/api/events/builtin/v1"
+	"github.com/knita-io/knita/internal/executor/runtime"
+)
+
+// pullProgressInterval is the minimum interval between ImagePullProgressEvents,
+// unless a layer changes status.
+const pullProgressInterval = 250 * time.Millisecond
+
+// pullProgress tracks the per-layer progress of an image pull, logging layer status
+// changes and periodically publishing ImagePullProgressEvents to the runtime log.
+type pullProgress struct {
+	log         *runtime.Log
+	imageURI    string
+	layers      []*builtinv1.ImagePullLayerProgress
+	layersByID  map[string]*builtinv1.ImagePullLayerProgress
+	lastPublish time.Time
+}
+
+func newPullProgress(log *runtime.Log, imageURI string) *pullProgress {
+	return &pullProgress{
+		log:        log,
+		imageURI:   imageURI,
+		layersByID: make(map[string]*builtinv1.ImagePullLayerProgress),
+	}
+}
+
+// Update applies a message from the image pull stream.
+func (p *pullProgress) Update(msg *jsonMessage) error {
+	if msg.Status == "" {
+		return nil
+	}
+	if msg.ID == "" || strings.HasPrefix(msg.Status, "Pulling from") {
+		// Image level status e.g. "Pulling from library/alpine" or "Digest: sha256:..."
+		p.log.Printf("%s", msg.Status)
+		return nil
+	}
+	layer, ok := p.layersByID[msg.ID]
+	if !ok {
+		layer = &builtinv1.ImagePullLayerProgress{LayerId: msg.ID}
+		p.layersByID[msg.ID] = layer
+		p.layers = append(p.layers, layer)
+	}
+	changed := layer.Status != msg.Status
+	layer.Status = msg.Status
+	if msg.Progress != nil {
+		layer.Current = msg.Progress.Current
+		layer.Total = msg.Progress.Total
+	}
+	if changed {
+		p.log.Printf("%s: %s", msg.ID, msg.Status)
+	}
+	if changed || time.Since(p.lastPublish) >= pullProgressInterval {
+		p.Publish()
+	}
+	return nil
+}
+
+// Publish publishes the current progress of the pull.
+func (p *pullProgress) Publish() {
+	p.lastPublish = time.Now()
+	event := &builtinv1.ImagePullProgressEvent{RuntimeId: p.log.RuntimeID(), ImageUri: p.imageURI}
+	for _, layer := range p.layers {
+		event.Layers = append(event.Layers, &builtinv1.ImagePullLayerProgress{
+			LayerId: layer.LayerId,
+			Status:  layer.Status,
+			Current: layer.Current,
+			Total:   layer.Total,
+		})
+	}
+	p.log.Publish(event)
+}
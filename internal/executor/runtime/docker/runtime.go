@@ -4,13 +4,18 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"os"
+	"path"
+	"path/filepath"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/hashicorp/go-multierror"
 	"github.com/moby/moby/client"
 	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/durationpb"
 
 	executorv1 "github.com/knita-io/knita/api/executor/v1"
 	"github.com/knita-io/knita/internal/executor/runtime"
@@ -21,6 +26,11 @@ type runtimeImageConfig struct {
 	OS runtime.OS
 }
 
+const (
+	defaultServiceReadinessInterval = 2 * time.Second
+	defaultServiceReadinessTimeout  = 2 * time.Minute
+)
+
 type runtimeContainerConfig struct {
 	Name              string
 	GuestWorkspaceDir string
@@ -32,21 +42,32 @@ type runtimeContainerConfig struct {
 type Runtime struct {
 	file.WriteFS
 	baseDir          string
+	buildContextDir  string
+	mounts           []runtime.Mount
 	runtimeID        string
 	opts             *executorv1.DockerOpts
+	defaults         runtime.ExecDefaults
 	containerManager *ContainerManager
 	syslog           *zap.SugaredLogger
 	log              *runtime.Log
 	deadline         time.Time
+	mu               sync.Mutex
+	execs            map[string]struct{}
 	state            struct {
 		started         bool
+		imageURI        string
+		imageDigest     string
 		containerID     string
+		networkID       string
+		serviceIDs      []string
 		imageConfig     runtimeImageConfig
 		containerConfig runtimeContainerConfig
 	}
 }
 
-func NewRuntime(syslog *zap.SugaredLogger, log *runtime.Log, runtimeID string, opts *executorv1.DockerOpts, client *client.Client) (*Runtime, error) {
+// NewRuntime creates a new Docker runtime. buildContextDir is the directory containing the
+// build context when the runtime image is built from a Dockerfile, or empty otherwise.
+func NewRuntime(syslog *zap.SugaredLogger, log *runtime.Log, runtimeID string, opts *executorv1.DockerOpts, defaults runtime.ExecDefaults, buildContextDir string, mounts []runtime.Mount, client *client.Client) (*Runtime, error) {
 	baseDir, err := os.MkdirTemp("", "knita-docker-*")
 	if err != nil {
 		return nil, fmt.Errorf("error creating runtime base dir: %w", err)
@@ -57,9 +78,13 @@ func NewRuntime(syslog *zap.SugaredLogger, log *runtime.Log, runtimeID string, o
 		runtimeID:        runtimeID,
 		log:              log,
 		baseDir:          baseDir,
+		buildContextDir:  buildContextDir,
+		mounts:           mounts,
 		WriteFS:          file.WriteDirFS(baseDir),
 		opts:             opts,
+		defaults:         defaults,
 		containerManager: NewContainerManager(syslog, client),
+		execs:            map[string]struct{}{},
 	}, nil
 }
 
@@ -70,17 +95,22 @@ func (r *Runtime) Start(ctx context.Context) error {
 	}
 	r.state.started = true
 
-	pullLog := r.Log().Named("docker_pull")
-	pullLog.Printf("Pulling Docker image...")
-	err := r.containerManager.PullDockerImage(ctx, pullLog, r.opts.Image)
+	imageURI, err := r.prepareImage(ctx)
 	if err != nil {
-		return fmt.Errorf("error pulling Docker image: %w", err)
+		return err
 	}
-	imageOS, err := r.containerManager.GetDockerImageOS(ctx, r.opts.Image.ImageUri)
+	r.state.imageURI = imageURI
+	imageOS, err := r.containerManager.GetDockerImageOS(ctx, imageURI)
 	if err != nil {
 		return fmt.Errorf("error discovering image OS: %w", err)
 	}
 	r.state.imageConfig.OS = imageOS
+	imageDigest, err := r.containerManager.GetDockerImageDigest(ctx, imageURI)
+	if err != nil {
+		return fmt.Errorf("error discovering image digest: %w", err)
+	}
+	r.state.imageDigest = imageDigest
+	r.syslog.Infof("Image digest: %s", imageDigest)
 	config, err := r.prepareJobContainerConfig(ctx)
 	if err != nil {
 		return err
@@ -89,13 +119,37 @@ func (r *Runtime) Start(ctx context.Context) error {
 	r.syslog.Infof("Guest OS: %s", r.state.imageConfig.OS)
 	r.syslog.Infof("Guest Working dir: %s", config.GuestWorkspaceDir)
 	r.syslog.Infof("Binds: %#v", config.Binds)
+	var networks []string
+	if len(r.opts.Services) > 0 {
+		networkID, err := r.containerManager.CreateNetwork(ctx, fmt.Sprintf("knita-%s", r.runtimeID))
+		if err != nil {
+			return err
+		}
+		r.state.networkID = networkID
+		networks = append(networks, networkID)
+		for _, service := range r.opts.Services {
+			err := r.startService(ctx, service)
+			if err != nil {
+				return fmt.Errorf("error starting service %q: %w", service.Name, err)
+			}
+		}
+	}
 	cConfig := ContainerConfig{
-		Name:       fmt.Sprintf("knita-%s", r.runtimeID),
-		ImageURI:   r.opts.Image.ImageUri,
-		Entrypoint: config.PID0Command,
-		WorkingDir: config.GuestWorkspaceDir,
-		Binds:      config.Binds,
-		Networks:   []string{},
+		Name:           fmt.Sprintf("knita-%s", r.runtimeID),
+		ImageURI:       r.state.imageURI,
+		Entrypoint:     config.PID0Command,
+		WorkingDir:     config.GuestWorkspaceDir,
+		Binds:          config.Binds,
+		Networks:       networks,
+		NetworkMode:    r.opts.NetworkMode,
+		User:           r.opts.User,
+		GroupAdd:       r.opts.GroupAdd,
+		CapAdd:         r.opts.CapAdd,
+		CapDrop:        r.opts.CapDrop,
+		Privileged:     r.opts.Privileged,
+		ReadonlyRootfs: r.opts.ReadOnlyRootfs,
+		Tmpfs:          r.opts.Tmpfs,
+		ShmSize:        r.opts.ShmSize,
 		// TODO stderr and stdout
 	}
 	containerID, err := r.containerManager.StartContainer(ctx, cConfig)
@@ -122,6 +176,11 @@ func (r *Runtime) SetDeadline(deadline time.Time) {
 	r.deadline = deadline
 }
 
+// ImageDigest returns the digest of the image the job container was started from.
+func (r *Runtime) ImageDigest() string {
+	return r.state.imageDigest
+}
+
 func (r *Runtime) Directory() string {
 	return r.state.containerConfig.GuestWorkspaceDir
 }
@@ -138,6 +197,18 @@ func (r *Runtime) Close() error {
 			results = multierror.Append(results, fmt.Errorf("error stopping job container: %w", err))
 		}
 	}
+	for _, serviceID := range r.state.serviceIDs {
+		err := r.containerManager.StopContainer(context.TODO(), serviceID)
+		if err != nil {
+			results = multierror.Append(results, fmt.Errorf("error stopping service container: %w", err))
+		}
+	}
+	if r.state.networkID != "" {
+		err := r.containerManager.DeleteNetwork(context.TODO(), r.state.networkID)
+		if err != nil {
+			results = multierror.Append(results, fmt.Errorf("error deleting runtime network: %w", err))
+		}
+	}
 	r.log.Close()
 	r.state.started = false
 	return results.ErrorOrNil()
@@ -145,15 +216,29 @@ func (r *Runtime) Close() error {
 
 // Exec executes a command inside the runtime.
 // Start must have been called before calling Exec.
-func (r *Runtime) Exec(ctx context.Context, execID string, opts *executorv1.ExecOpts) (*runtime.ExecResult, error) {
-	r.syslog.Infow("Executing command", "name", opts.Name, "args", opts.Args)
-	r.Log().ExecSource(execID, true).Printf("Executing command: %s %v", opts.Name, opts.Args)
+func (r *Runtime) Exec(ctx context.Context, execID string, opts *executorv1.ExecOpts, stdin io.Reader) (*runtime.ExecResult, error) {
+	command := r.defaults.ExecCommand(opts, r.state.imageConfig.OS)
+	command = append(command[:1], r.fixArgs(command[1:])...)
+	r.syslog.Infow("Executing command", "name", command[0], "args", command[1:])
+	r.Log().ExecSource(execID, true).Printf("Executing command: %s %v", command[0], command[1:])
 	execLog := r.Log().ExecSource(execID, false)
+	workDir, err := r.workDir(opts.WorkDir)
+	if err != nil {
+		return nil, err
+	}
+	signal, grace := runtime.Termination(opts)
 	execConfig := ExecConfig{
-		ContainerID: r.state.containerID,
-		Command:     append([]string{opts.Name}, opts.Args...),
-		WorkingDir:  r.state.containerConfig.GuestWorkspaceDir,
-		Env:         r.fixEnv(opts.Env),
+		ContainerID:            r.state.containerID,
+		Command:                command,
+		WorkingDir:             workDir,
+		Env:                    r.fixEnv(r.defaults.ExecEnv(opts)),
+		User:                   opts.User,
+		Tty:                    opts.Tty,
+		Stdin:                  stdin,
+		OS:                     r.state.imageConfig.OS,
+		Marker:                 execID,
+		TerminationSignal:      signal,
+		TerminationGracePeriod: grace,
 	}
 
 	w := execLog.Stdout()
@@ -164,15 +249,210 @@ func (r *Runtime) Exec(ctx context.Context, execID string, opts *executorv1.Exec
 	defer w.Close()
 	execConfig.Stderr = w
 
-	err := r.containerManager.Execute(ctx, execConfig)
+	r.mu.Lock()
+	if _, ok := r.execs[execID]; ok {
+		r.mu.Unlock()
+		return nil, fmt.Errorf("error exec %s is already running", execID)
+	}
+	r.execs[execID] = struct{}{}
+	r.mu.Unlock()
+	defer func() {
+		r.mu.Lock()
+		delete(r.execs, execID)
+		r.mu.Unlock()
+	}()
+
+	execCtx := ctx
+	if opts.Timeout != nil {
+		var cancel context.CancelFunc
+		execCtx, cancel = context.WithTimeout(ctx, opts.Timeout.AsDuration())
+		defer cancel()
+	}
+	start := time.Now()
+	stopSampling := r.containerManager.SampleContainerStats(ctx, r.state.containerID)
+	err = r.containerManager.Execute(execCtx, execConfig)
+	usage := &executorv1.ResourceUsage{WallTime: durationpb.New(time.Since(start)), ContainerWide: stopSampling()}
 	if err != nil {
+		var cancelledErr *cancelledError
+		if errors.As(err, &cancelledErr) && cancelledErr.terminateErr != nil {
+			r.Log().ExecSource(execID, true).Printf("Unable to terminate command; its processes may still be running: %v", cancelledErr.terminateErr)
+		}
+		if ctx.Err() != nil {
+			r.Log().ExecSource(execID, true).Printf("Command cancelled")
+			return nil, fmt.Errorf("error command cancelled: %w", ctx.Err())
+		} else if execCtx.Err() != nil {
+			r.Log().ExecSource(execID, true).Printf("Command timed out after %s", opts.Timeout.AsDuration())
+			return &runtime.ExecResult{ExitCode: -1, TimedOut: true, Usage: usage}, nil
+		}
 		var exitErr *exitError
 		if errors.As(err, &exitErr) {
-			return &runtime.ExecResult{ExitCode: int32(exitErr.exitCode)}, nil
+			return &runtime.ExecResult{ExitCode: int32(exitErr.exitCode), Usage: usage}, nil
 		}
 		return nil, fmt.Errorf("error running command: %w", err)
 	}
-	return &runtime.ExecResult{ExitCode: 0}, nil
+	return &runtime.ExecResult{ExitCode: 0, Usage: usage}, nil
+}
+
+// Attach runs an interactive session inside the job container, in a pseudo-terminal.
+// Start must have been called before calling Attach.
+func (r *Runtime) Attach(ctx context.Context, session *runtime.AttachSession) (int32, error) {
+	command := session.Opts.Command
+	if len(command) == 0 {
+		command = runtime.DefaultAttachCommand(r.state.imageConfig.OS)
+	}
+	r.syslog.Infow("Attaching session", "id", session.ID, "command", command)
+	r.Log().Printf("Attaching interactive session: %v", command)
+	defer r.Log().Printf("Detached interactive session")
+	tty := session.Opts.Tty
+	if tty == nil {
+		tty = &executorv1.TtyOpts{}
+	}
+	execConfig := ExecConfig{
+		ContainerID: r.state.containerID,
+		Command:     command,
+		WorkingDir:  r.state.containerConfig.GuestWorkspaceDir,
+		Env:         r.fixEnv(append(append([]string{}, r.defaults.Env...), session.Opts.Env...)),
+		Tty:         tty,
+		ResizeC:     session.ResizeC,
+		Stdin:       session.Stdin,
+		Stdout:      session.Output,
+		OS:          r.state.imageConfig.OS,
+		Marker:      session.ID,
+		// Hang up the terminal, as a terminal emulator would when closed.
+		TerminationSignal: "SIGHUP",
+	}
+	r.mu.Lock()
+	if _, ok := r.execs[session.ID]; ok {
+		r.mu.Unlock()
+		return 0, fmt.Errorf("error exec %s is already running", session.ID)
+	}
+	r.execs[session.ID] = struct{}{}
+	r.mu.Unlock()
+	defer func() {
+		r.mu.Lock()
+		delete(r.execs, session.ID)
+		r.mu.Unlock()
+	}()
+	err := r.containerManager.Execute(ctx, execConfig)
+	if err != nil {
+		var exitErr *exitError
+		if errors.As(err, &exitErr) {
+			return int32(exitErr.exitCode), nil
+		}
+		return 0, fmt.Errorf("error running session: %w", err)
+	}
+	return 0, nil
+}
+
+// Signal sends the named signal to the processes of an in progress exec.
+func (r *Runtime) Signal(ctx context.Context, execID string, signal string) error {
+	r.mu.Lock()
+	_, ok := r.execs[execID]
+	r.mu.Unlock()
+	if !ok {
+		return fmt.Errorf("error exec not found")
+	}
+	r.Log().ExecSource(execID, true).Printf("Sending %s", signal)
+	return r.containerManager.SignalExec(ctx, r.state.containerID, r.state.imageConfig.OS, execID, signal)
+}
+
+// prepareImage pulls or builds the job image and returns its URI.
+func (r *Runtime) prepareImage(ctx context.Context) (string, error) {
+	if r.opts.Build == nil {
+		pullLog := r.Log().Named("docker_pull")
+		pullLog.Printf("Pulling Docker image...")
+		err := r.containerManager.PullDockerImage(ctx, pullLog, r.opts.Image)
+		if err != nil {
+			return "", fmt.Errorf("error pulling Docker image: %w", err)
+		}
+		return r.opts.Image.ImageUri, nil
+	}
+	if r.buildContextDir == "" {
+		return "", fmt.Errorf("error no Docker build context was imported")
+	}
+	buildLog := r.Log().Named("docker_build")
+	tag, err := buildImageTag(r.buildContextDir, r.opts.Build)
+	if err != nil {
+		return "", err
+	}
+	exists, err := r.containerManager.DockerImageExists(ctx, tag)
+	if err != nil {
+		return "", err
+	}
+	if exists {
+		buildLog.Printf("Build context is unchanged; using cached image %s", tag)
+		return tag, nil
+	}
+	buildLog.Printf("Building Docker image %s...", tag)
+	err = r.containerManager.BuildDockerImage(ctx, buildLog, r.buildContextDir, tag, r.opts.Build)
+	if err != nil {
+		return "", fmt.Errorf("error building Docker image: %w", err)
+	}
+	return tag, nil
+}
+
+// startService pulls and starts a service container on the runtime network and
+// blocks until it passes its readiness check (if any).
+func (r *Runtime) startService(ctx context.Context, service *executorv1.DockerServiceOpts) error {
+	serviceLog := r.Log().Named(fmt.Sprintf("service_%s", service.Name))
+	serviceLog.Printf("Pulling Docker image for service %q...", service.Name)
+	err := r.containerManager.PullDockerImage(ctx, serviceLog, service.Image)
+	if err != nil {
+		return fmt.Errorf("error pulling Docker image: %w", err)
+	}
+	serviceOS, err := r.containerManager.GetDockerImageOS(ctx, service.Image.ImageUri)
+	if err != nil {
+		return fmt.Errorf("error discovering service image OS: %w", err)
+	}
+	config := ContainerConfig{
+		Name:     fmt.Sprintf("knita-%s-%s", r.runtimeID, service.Name),
+		ImageURI: service.Image.ImageUri,
+		Command:  service.Command,
+		Env:      service.Env,
+		Networks: []string{r.state.networkID},
+		Aliases:  append([]string{service.Name}, service.Aliases...),
+	}
+	containerID, err := r.containerManager.StartContainer(ctx, config)
+	if err != nil {
+		return err
+	}
+	r.state.serviceIDs = append(r.state.serviceIDs, containerID)
+	if service.Readiness == nil || len(service.Readiness.Command) == 0 {
+		return nil
+	}
+	return r.waitForService(ctx, serviceLog, containerID, serviceOS, service)
+}
+
+// waitForService runs the service's readiness command in its container, whose image is of the given OS,
+// until it succeeds or the readiness timeout elapses.
+func (r *Runtime) waitForService(ctx context.Context, log *runtime.Log, containerID string, serviceOS runtime.OS, service *executorv1.DockerServiceOpts) error {
+	interval := defaultServiceReadinessInterval
+	if service.Readiness.Interval != nil {
+		interval = service.Readiness.Interval.AsDuration()
+	}
+	timeout := defaultServiceReadinessTimeout
+	if service.Readiness.Timeout != nil {
+		timeout = service.Readiness.Timeout.AsDuration()
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	log.Printf("Waiting for service %q to become ready...", service.Name)
+	for {
+		err := r.containerManager.Execute(ctx, ExecConfig{ContainerID: containerID, Command: service.Readiness.Command, OS: serviceOS})
+		if err == nil {
+			log.Printf("Service %q is ready", service.Name)
+			return nil
+		}
+		var exitErr *exitError
+		if !errors.As(err, &exitErr) && ctx.Err() == nil {
+			return fmt.Errorf("error running readiness command: %w", err)
+		}
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("error service did not become ready within %s", timeout)
+		case <-time.After(interval):
+		}
+	}
 }
 
 func (r *Runtime) prepareJobContainerConfig(ctx context.Context) (*runtimeContainerConfig, error) {
@@ -190,8 +470,18 @@ func (r *Runtime) prepareWindowsContainerConfig(ctx context.Context) (*runtimeCo
 	guestWorkingDir := "C:\\controlci\\workspace"
 	binds := []string{
 		fmt.Sprintf("%s:%s:rw", r.baseDir, guestWorkingDir),
+	}
+	for _, mount := range r.mounts {
+		target := mount.Target
+		// Absolute Windows paths have a drive letter or are UNC paths
+		if !strings.Contains(target, ":") && !strings.HasPrefix(target, "\\\\") {
+			target = guestWorkingDir + "\\" + strings.ReplaceAll(target, "/", "\\")
+		}
+		binds = append(binds, fmt.Sprintf("%s:%s:rw", mount.Source, target))
+	}
+	if r.opts.MountDockerSocket {
 		// Windows containers only run on Windows, so use the Windows pipe syntax
-		"\\\\.\\pipe\\docker_engine:\\\\.\\pipe\\docker_engine",
+		binds = append(binds, "\\\\.\\pipe\\docker_engine:\\\\.\\pipe\\docker_engine")
 	}
 	return &runtimeContainerConfig{
 		Name:              r.runtimeID,
@@ -205,9 +495,18 @@ func (r *Runtime) prepareLinuxContainerConfig(ctx context.Context) (*runtimeCont
 	guestWorkingDir := "/tmp/controlci/workspace"
 	binds := []string{
 		fmt.Sprintf("%s:%s:rw", r.baseDir, guestWorkingDir),
+	}
+	for _, mount := range r.mounts {
+		target := mount.Target
+		if !path.IsAbs(target) {
+			target = path.Join(guestWorkingDir, target)
+		}
+		binds = append(binds, fmt.Sprintf("%s:%s:rw", mount.Source, target))
+	}
+	if r.opts.MountDockerSocket {
 		// Linux containers run natively on Linux, and in a Linux VM on Windows and macOS,
 		// so we can always refer to the Linux socket path here
-		"/var/run/docker.sock:/var/run/docker.sock",
+		binds = append(binds, "/var/run/docker.sock:/var/run/docker.sock")
 	}
 	return &runtimeContainerConfig{
 		Name:              r.runtimeID,
@@ -221,17 +520,58 @@ func (r *Runtime) fixEnv(env []string) []string {
 	for i, envVar := range env {
 		parts := strings.SplitN(envVar, "=", 2)
 		if len(parts) == 2 {
-			path, changed, err := r.mapHostPath(runtime.GetHostOS(), parts[1])
-			if err != nil {
-				r.syslog.Warnf("Ignoring error mapping host path for %q: %v", parts[1], err)
-			} else if changed {
-				env[i] = fmt.Sprintf("%s=%s", parts[0], path)
-			}
+			env[i] = fmt.Sprintf("%s=%s", parts[0], r.fixPath(parts[1]))
 		}
 	}
 	return env
 }
 
+func (r *Runtime) fixArgs(args []string) []string {
+	fixed := make([]string, len(args))
+	for i, arg := range args {
+		fixed[i] = r.fixPath(arg)
+	}
+	return fixed
+}
+
+// fixPath maps a path within the runtime's host work directory to its location inside the container.
+// Any other value is returned unchanged.
+func (r *Runtime) fixPath(value string) string {
+	path, changed, err := r.mapHostPath(runtime.GetHostOS(), value)
+	if err != nil {
+		r.syslog.Warnf("Ignoring error mapping host path for %q: %v", value, err)
+		return value
+	}
+	if !changed {
+		return value
+	}
+	return path
+}
+
+// workDir resolves the container directory an exec runs in. dir may be relative to the work directory,
+// or an absolute path within the work directory on the host or in the container.
+func (r *Runtime) workDir(dir string) (string, error) {
+	guestDir := r.state.containerConfig.GuestWorkspaceDir
+	if dir == "" {
+		return guestDir, nil
+	}
+	dir = r.fixPath(dir)
+	sep := "/"
+	if r.state.imageConfig.OS == runtime.OSWindows {
+		sep = "\\"
+	}
+	if dir == guestDir || strings.HasPrefix(dir, guestDir+sep) {
+		return dir, nil
+	}
+	if !filepath.IsLocal(dir) {
+		return "", fmt.Errorf("error work dir must be within the work directory: %s", dir)
+	}
+	if r.state.imageConfig.OS == runtime.OSWindows {
+		return guestDir + "\\" + strings.ReplaceAll(filepath.Clean(dir), "/", "\\"), nil
+	}
+	return path.Join(guestDir, filepath.ToSlash(dir)), nil
+}
+
 func (r *Runtime) mapHostPath(fromOS runtime.OS, path string) (string, bool, error) {
 	if r.state.imageConfig.OS == "" {
 		return "", false, fmt.Errorf("error runtime is not prepared")
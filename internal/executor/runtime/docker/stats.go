@@ -0,0 +1,175 @@
+package docker
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/docker/docker/api/types/container"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	executorv1 "github.com/knita-io/knita/api/executor/v1"
+)
+
+// statsTimeout bounds how long a one-shot container stats request may take.
+const statsTimeout = time.Second * 5
+
+// SampleContainerStats starts sampling the cgroup stats of a container. Call the returned function once
+// the exec being measured completes, to stop sampling and return the resources the whole container consumed
+// in the interim, which includes any other processes in the container. Sampling is best effort; unavailable
+// stats are left unset.
+func (r *ContainerManager) SampleContainerStats(ctx context.Context, containerID string) func() *executorv1.ContainerStats {
+	sampler := &statsSampler{}
+	r.sampleContainerStatsOnce(containerID, sampler)
+	streamCtx, cancel := context.WithCancel(ctx)
+	doneC := make(chan struct{})
+	go func() {
+		defer close(doneC)
+		// The stream delivers a new sample approximately once per second, which catches peak memory usage.
+		res, err := r.client.ContainerStats(streamCtx, containerID, true)
+		if err != nil {
+			r.syslog.Debugf("Ignoring error streaming container stats: %v", err)
+			return
+		}
+		defer res.Body.Close()
+		sampler.windows = res.OSType == "windows"
+		dec := json.NewDecoder(res.Body)
+		for {
+			var stats container.StatsResponse
+			if err := dec.Decode(&stats); err != nil {
+				return
+			}
+			sampler.add(&stats)
+		}
+	}()
+	return func() *executorv1.ContainerStats {
+		cancel()
+		<-doneC
+		r.sampleContainerStatsOnce(containerID, sampler)
+		return sampler.result()
+	}
+}
+
+// sampleContainerStatsOnce adds a single sample of a container's stats to sampler.
+func (r *ContainerManager) sampleContainerStatsOnce(containerID string, sampler *statsSampler) {
+	ctx, cancel := context.WithTimeout(context.Background(), statsTimeout)
+	defer cancel()
+	res, err := r.client.ContainerStatsOneShot(ctx, containerID)
+	if err != nil {
+		r.syslog.Debugf("Ignoring error getting container stats: %v", err)
+		return
+	}
+	defer res.Body.Close()
+	sampler.windows = res.OSType == "windows"
+	var stats container.StatsResponse
+	if err := json.NewDecoder(res.Body).Decode(&stats); err != nil {
+		r.syslog.Debugf("Ignoring error decoding container stats: %v", err)
+		return
+	}
+	sampler.add(&stats)
+}
+
+// statsSampler accumulates samples of a container's stats over the course of an exec.
+// Cumulative stats (e.g. CPU time) are reported as the delta between the first and last samples.
+type statsSampler struct {
+	windows    bool
+	first      *container.StatsResponse
+	last       *container.StatsResponse
+	memoryPeak uint64
+}
+
+func (s *statsSampler) add(stats *container.StatsResponse) {
+	if stats.Read.IsZero() {
+		// The container is not running.
+		return
+	}
+	if s.first == nil {
+		s.first = stats
+	}
+	s.last = stats
+	s.memoryPeak = max(s.memoryPeak, s.memoryUsage(stats))
+}
+
+// result returns the resources consumed between the first and last samples,
+// or nil if fewer than two samples were taken.
+func (s *statsSampler) result() *executorv1.ContainerStats {
+	if s.first == nil || s.first == s.last {
+		return nil
+	}
+	first, last := s.first, s.last
+	firstRead, firstWrite := s.blockIO(first)
+	lastRead, lastWrite := s.blockIO(last)
+	firstRx, firstTx := s.network(first)
+	lastRx, lastTx := s.network(last)
+	return &executorv1.ContainerStats{
+		CpuTime:         s.cpuTime(first.CPUStats.CPUUsage.TotalUsage, last.CPUStats.CPUUsage.TotalUsage),
+		UserCpuTime:     s.cpuTime(first.CPUStats.CPUUsage.UsageInUsermode, last.CPUStats.CPUUsage.UsageInUsermode),
+		SystemCpuTime:   s.cpuTime(first.CPUStats.CPUUsage.UsageInKernelmode, last.CPUStats.CPUUsage.UsageInKernelmode),
+		MemoryPeak:      s.memoryPeak,
+		MemoryLimit:     last.MemoryStats.Limit,
+		BlockReadBytes:  delta(firstRead, lastRead),
+		BlockWriteBytes: delta(firstWrite, lastWrite),
+		NetworkRxBytes:  delta(firstRx, lastRx),
+		NetworkTxBytes:  delta(firstTx, lastTx),
+	}
+}
+
+// cpuTime returns the CPU time consumed between two cumulative CPU usage samples.
+func (s *statsSampler) cpuTime(first uint64, last uint64) *durationpb.Duration {
+	d := delta(first, last)
+	if s.windows {
+		// Reported in 100s of nanoseconds on Windows.
+		d *= 100
+	}
+	return durationpb.New(time.Duration(d))
+}
+
+// memoryUsage returns a container's memory usage, excluding the page cache, in the same way as docker stats.
+func (s *statsSampler) memoryUsage(stats *container.StatsResponse) uint64 {
+	if s.windows {
+		return stats.MemoryStats.PrivateWorkingSet
+	}
+	usage := stats.MemoryStats.Usage
+	// cgroup v1 reports total_inactive_file, and cgroup v2 reports inactive_file.
+	if inactive, ok := stats.MemoryStats.Stats["total_inactive_file"]; ok && inactive < usage {
+		return usage - inactive
+	}
+	if inactive, ok := stats.MemoryStats.Stats["inactive_file"]; ok && inactive < usage {
+		return usage - inactive
+	}
+	return usage
+}
+
+// blockIO returns the cumulative bytes a container has read from and written to block devices.
+func (s *statsSampler) blockIO(stats *container.StatsResponse) (read uint64, write uint64) {
+	if s.windows {
+		return stats.StorageStats.ReadSizeBytes, stats.StorageStats.WriteSizeBytes
+	}
+	for _, entry := range stats.BlkioStats.IoServiceBytesRecursive {
+		switch strings.ToLower(entry.Op) {
+		case "read":
+			read += entry.Value
+		case "write":
+			write += entry.Value
+		}
+	}
+	return read, write
+}
+
+// network returns the cumulative bytes a container has received and transmitted across all its networks.
+func (s *statsSampler) network(stats *container.StatsResponse) (rx uint64, tx uint64) {
+	for _, network := range stats.Networks {
+		rx += network.RxBytes
+		tx += network.TxBytes
+	}
+	return rx, tx
+}
+
+// delta returns the difference between two cumulative counters, or zero if the counter was reset.
+func delta(first uint64, last uint64) uint64 {
+	if last < first {
+		return 0
+	}
+	return last - first
+}
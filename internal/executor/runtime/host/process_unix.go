@@ -0,0 +1,124 @@
+//go:build !windows
+
+package host
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"os/user"
+	stdruntime "runtime"
+	"strconv"
+	"strings"
+	"syscall"
+
+	"golang.org/x/sys/unix"
+)
+
+// setProcessGroup configures cmd to start in a new process group, so that it
+// can be signalled along with any processes it spawns.
+func setProcessGroup(cmd *exec.Cmd) {
+	cmd.SysProcAttr = &syscall.SysProcAttr{Setpgid: true}
+}
+
+// signalProcessGroup sends the named signal (e.g. "SIGTERM") to every process in p's process group.
+func signalProcessGroup(p *os.Process, signal string) error {
+	sig := unix.SignalNum(signal)
+	if sig == 0 {
+		return fmt.Errorf("error unsupported signal: %s", signal)
+	}
+	err := syscall.Kill(-p.Pid, sig)
+	if errors.Is(err, syscall.ESRCH) {
+		// The process group has already exited.
+		return nil
+	}
+	return err
+}
+
+// setUser configures cmd to run as spec, in the form "user", "user:group", "uid" or "uid:gid".
+// The executor must have permission to switch users (typically by running as root).
+func setUser(cmd *exec.Cmd, spec string) error {
+	name, group, hasGroup := strings.Cut(spec, ":")
+	u, err := lookupUser(name)
+	if err != nil {
+		return err
+	}
+	uid, err := strconv.ParseUint(u.Uid, 10, 32)
+	if err != nil {
+		return fmt.Errorf("error parsing uid %q: %w", u.Uid, err)
+	}
+	gidStr := u.Gid
+	if hasGroup {
+		gidStr, err = lookupGroupID(group)
+		if err != nil {
+			return err
+		}
+	}
+	gid, err := strconv.ParseUint(gidStr, 10, 32)
+	if err != nil {
+		return fmt.Errorf("error parsing gid %q: %w", gidStr, err)
+	}
+	cred := &syscall.Credential{Uid: uint32(uid), Gid: uint32(gid)}
+	if groupIDs, err := u.GroupIds(); err == nil {
+		for _, id := range groupIDs {
+			if gid, err := strconv.ParseUint(id, 10, 32); err == nil {
+				cred.Groups = append(cred.Groups, uint32(gid))
+			}
+		}
+	}
+	cmd.SysProcAttr.Credential = cred
+	return nil
+}
+
+// lookupUser looks up a user by name or uid. Unknown uids are permitted, and run with the same gid.
+func lookupUser(name string) (*user.User, error) {
+	u, err := user.Lookup(name)
+	if err == nil {
+		return u, nil
+	}
+	if _, parseErr := strconv.ParseUint(name, 10, 32); parseErr != nil {
+		return nil, fmt.Errorf("error looking up user %q: %w", name, err)
+	}
+	u, err = user.LookupId(name)
+	if err != nil {
+		return &user.User{Uid: name, Gid: name}, nil
+	}
+	return u, nil
+}
+
+// lookupGroupID looks up the gid of a group by name or gid.
+func lookupGroupID(group string) (string, error) {
+	if _, err := strconv.ParseUint(group, 10, 32); err == nil {
+		return group, nil
+	}
+	g, err := user.LookupGroup(group)
+	if err != nil {
+		return "", fmt.Errorf("error looking up group %q: %w", group, err)
+	}
+	return g.Gid, nil
+}
+
+// setControllingTerminal configures cmd to start in a new session, with its stdin as the session's
+// controlling terminal. The session leader also leads a new process group, so the command can
+// still be signalled along with any processes it spawns.
+func setControllingTerminal(cmd *exec.Cmd) {
+	cmd.SysProcAttr.Setpgid = false
+	cmd.SysProcAttr.Setsid = true
+	cmd.SysProcAttr.Setctty = true
+	cmd.SysProcAttr.Ctty = 0
+}
+
+// peakRSS returns the peak resident set size in bytes of an exited process,
+// or of the largest descendant it waited for.
+func peakRSS(state *os.ProcessState) uint64 {
+	rusage, ok := state.SysUsage().(*syscall.Rusage)
+	if !ok {
+		return 0
+	}
+	if stdruntime.GOOS == "darwin" {
+		// Reported in bytes on macOS, and kilobytes elsewhere.
+		return uint64(rusage.Maxrss)
+	}
+	return uint64(rusage.Maxrss) * 1024
+}
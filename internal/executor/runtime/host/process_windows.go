@@ -0,0 +1,43 @@
+//go:build windows
+
+package host
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"strconv"
+	"syscall"
+)
+
+// setProcessGroup configures cmd to start in a new process group, so that it
+// can be terminated along with any processes it spawns.
+func setProcessGroup(cmd *exec.Cmd) {
+	cmd.SysProcAttr = &syscall.SysProcAttr{CreationFlags: syscall.CREATE_NEW_PROCESS_GROUP}
+}
+
+// signalProcessGroup terminates p and its descendants. Windows has no equivalent of
+// signals, so only SIGTERM (a request to exit) and SIGKILL are supported.
+func signalProcessGroup(p *os.Process, signal string) error {
+	switch signal {
+	case "SIGTERM":
+		return exec.Command("taskkill", "/T", "/PID", strconv.Itoa(p.Pid)).Run()
+	case "SIGKILL":
+		return exec.Command("taskkill", "/T", "/F", "/PID", strconv.Itoa(p.Pid)).Run()
+	default:
+		return fmt.Errorf("error unsupported signal on Windows: %s", signal)
+	}
+}
+
+// setUser is not supported on Windows, as starting a process as another user requires their credentials.
+func setUser(cmd *exec.Cmd, user string) error {
+	return fmt.Errorf("error running commands as another user is not supported on Windows")
+}
+
+// setControllingTerminal is a no-op on Windows, where host runtimes do not support ttys.
+func setControllingTerminal(cmd *exec.Cmd) {}
+
+// peakRSS is not supported on Windows, where the usage of exited processes does not include memory.
+func peakRSS(state *os.ProcessState) uint64 {
+	return 0
+}
@@ -0,0 +1,27 @@
+package host
+
+import (
+	"bytes"
+	"fmt"
+	"syscall"
+	"unsafe"
+
+	"golang.org/x/sys/unix"
+)
+
+// unlockPTY grants access to and unlocks the slave end of the pty master fd, returning its path.
+func unlockPTY(fd int) (string, error) {
+	if err := unix.IoctlSetInt(fd, unix.TIOCPTYGRANT, 0); err != nil {
+		return "", fmt.Errorf("error granting pty: %w", err)
+	}
+	if err := unix.IoctlSetInt(fd, unix.TIOCPTYUNLK, 0); err != nil {
+		return "", fmt.Errorf("error unlocking pty: %w", err)
+	}
+	// TIOCPTYGNAME writes the slave's path into a 128 byte buffer.
+	buf := make([]byte, 128)
+	_, _, errno := syscall.Syscall(syscall.SYS_IOCTL, uintptr(fd), uintptr(unix.TIOCPTYGNAME), uintptr(unsafe.Pointer(&buf[0])))
+	if errno != 0 {
+		return "", fmt.Errorf("error getting pty name: %w", errno)
+	}
+	return string(buf[:bytes.IndexByte(buf, 0)]), nil
+}
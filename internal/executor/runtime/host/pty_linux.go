@@ -0,0 +1,19 @@
+package host
+
+import (
+	"fmt"
+
+	"golang.org/x/sys/unix"
+)
+
+// unlockPTY unlocks the slave end of the pty master fd, returning its path.
+func unlockPTY(fd int) (string, error) {
+	if err := unix.IoctlSetPointerInt(fd, unix.TIOCSPTLCK, 0); err != nil {
+		return "", fmt.Errorf("error unlocking pty: %w", err)
+	}
+	n, err := unix.IoctlGetUint32(fd, unix.TIOCGPTN)
+	if err != nil {
+		return "", fmt.Errorf("error getting pty number: %w", err)
+	}
+	return fmt.Sprintf("/dev/pts/%d", n), nil
+}
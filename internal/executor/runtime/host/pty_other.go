@@ -0,0 +1,19 @@
+//go:build !linux && !darwin
+
+package host
+
+import (
+	"fmt"
+	"os"
+	goruntime "runtime"
+)
+
+// openPTY is not supported on this platform.
+func openPTY(rows uint16, cols uint16) (*os.File, *os.File, error) {
+	return nil, nil, fmt.Errorf("error ttys are not supported by host runtimes on %s", goruntime.GOOS)
+}
+
+// resizePTY is not supported on this platform.
+func resizePTY(master *os.File, rows uint16, cols uint16) error {
+	return fmt.Errorf("error ttys are not supported by host runtimes on %s", goruntime.GOOS)
+}
@@ -0,0 +1,58 @@
+//go:build linux || darwin
+
+package host
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"golang.org/x/sys/unix"
+)
+
+// openPTY opens a new pseudo-terminal of the given size, returning its master and slave ends.
+// The master is pollable, so closing it unblocks any in progress reads.
+func openPTY(rows uint16, cols uint16) (*os.File, *os.File, error) {
+	master, err := os.OpenFile("/dev/ptmx", os.O_RDWR|unix.O_NOCTTY, 0)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error opening pty: %w", err)
+	}
+	conn, err := master.SyscallConn()
+	if err != nil {
+		master.Close()
+		return nil, nil, fmt.Errorf("error opening pty: %w", err)
+	}
+	var name string
+	ctlErr := conn.Control(func(fd uintptr) {
+		name, err = unlockPTY(int(fd))
+	})
+	if err = errors.Join(ctlErr, err); err != nil {
+		master.Close()
+		return nil, nil, fmt.Errorf("error configuring pty: %w", err)
+	}
+	if err := resizePTY(master, rows, cols); err != nil {
+		master.Close()
+		return nil, nil, err
+	}
+	slave, err := os.OpenFile(name, os.O_RDWR|unix.O_NOCTTY, 0)
+	if err != nil {
+		master.Close()
+		return nil, nil, fmt.Errorf("error opening pty slave: %w", err)
+	}
+	return master, slave, nil
+}
+
+// resizePTY sets the size of the pseudo-terminal with the given master.
+func resizePTY(master *os.File, rows uint16, cols uint16) error {
+	conn, err := master.SyscallConn()
+	if err != nil {
+		return fmt.Errorf("error resizing pty: %w", err)
+	}
+	ctlErr := conn.Control(func(fd uintptr) {
+		err = unix.IoctlSetWinsize(int(fd), unix.TIOCSWINSZ, &unix.Winsize{Row: rows, Col: cols})
+	})
+	if err = errors.Join(ctlErr, err); err != nil {
+		return fmt.Errorf("error resizing pty: %w", err)
+	}
+	return nil
+}
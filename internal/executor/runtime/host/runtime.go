@@ -4,11 +4,15 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"os"
 	"os/exec"
+	"path/filepath"
+	"sync"
 	"time"
 
 	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/durationpb"
 
 	executorv1 "github.com/knita-io/knita/api/executor/v1"
 	"github.com/knita-io/knita/internal/executor/runtime"
@@ -20,11 +24,17 @@ type Runtime struct {
 	syslog    *zap.SugaredLogger
 	runtimeID string
 	baseDir   string
+	mounts    []runtime.Mount
+	defaults  runtime.ExecDefaults
 	log       *runtime.Log
 	deadline  time.Time
+	mu        sync.Mutex
+	// processes holds the processes of in progress execs, keyed by exec ID.
+	processes map[string]*os.Process
+	closed    bool
 }
 
-func NewRuntime(syslog *zap.SugaredLogger, log *runtime.Log, runtimeID string) (*Runtime, error) {
+func NewRuntime(syslog *zap.SugaredLogger, log *runtime.Log, runtimeID string, mounts []runtime.Mount, defaults runtime.ExecDefaults) (*Runtime, error) {
 	baseDir, err := os.MkdirTemp("", "knita-host-*")
 	if err != nil {
 		return nil, fmt.Errorf("error creating runtime base dir: %w", err)
@@ -33,8 +43,11 @@ func NewRuntime(syslog *zap.SugaredLogger, log *runtime.Log, runtimeID string) (
 		syslog:    syslog.Named("local_runtime"),
 		runtimeID: runtimeID,
 		baseDir:   baseDir,
+		mounts:    mounts,
+		defaults:  defaults,
 		WriteFS:   file.WriteDirFS(baseDir),
 		log:       log,
+		processes: map[string]*os.Process{},
 	}, nil
 }
 
@@ -55,43 +68,323 @@ func (r *Runtime) SetDeadline(deadline time.Time) {
 }
 
 func (r *Runtime) Start(ctx context.Context) error {
+	// Host runtimes cannot bind mount, so mounts are symlinked into the work directory instead.
+	for _, mount := range r.mounts {
+		if !filepath.IsLocal(mount.Target) {
+			return fmt.Errorf("error host runtime mount target must be relative to the work directory: %s", mount.Target)
+		}
+		target := filepath.Join(r.baseDir, mount.Target)
+		err := os.MkdirAll(filepath.Dir(target), 0755)
+		if err != nil {
+			return fmt.Errorf("error creating mount target parent dir: %w", err)
+		}
+		err = os.Symlink(mount.Source, target)
+		if err != nil {
+			return fmt.Errorf("error creating mount: %w", err)
+		}
+	}
 	return nil
 }
 
-func (r *Runtime) Exec(ctx context.Context, execID string, opts *executorv1.ExecOpts) (*runtime.ExecResult, error) {
-	r.syslog.Infow("Executing command", "name", opts.Name, "args", opts.Args)
-	r.Log().ExecSource(execID, true).Printf("Executing command: %s %v", opts.Name, opts.Args)
+func (r *Runtime) Exec(ctx context.Context, execID string, opts *executorv1.ExecOpts, stdin io.Reader) (*runtime.ExecResult, error) {
+	command := r.defaults.ExecCommand(opts, runtime.GetHostOS())
+	r.syslog.Infow("Executing command", "name", command[0], "args", command[1:])
+	r.Log().ExecSource(execID, true).Printf("Executing command: %s %v", command[0], command[1:])
 	execLog := r.Log().ExecSource(execID, false)
 
+	dir, err := r.workDir(opts.WorkDir)
+	if err != nil {
+		return nil, err
+	}
 	env := os.Environ()
-	env = append(env, opts.Env...)
+	env = append(env, r.defaults.ExecEnv(opts)...)
 
-	cmd := exec.CommandContext(ctx, opts.Name, opts.Args...)
-	cmd.Dir = r.baseDir
+	signal, grace := runtime.Termination(opts)
+	cmd := exec.Command(command[0], command[1:]...)
+	cmd.Dir = dir
 	cmd.Env = env
+	// Bound how long Wait blocks on output held open by orphaned descendants.
+	cmd.WaitDelay = grace
+	setProcessGroup(cmd)
+	if opts.User != "" {
+		if err := setUser(cmd, opts.User); err != nil {
+			return nil, err
+		}
+	}
 
-	w := execLog.Stdout()
-	defer w.Close()
-	cmd.Stdout = w
-
-	w = execLog.Stderr()
-	defer w.Close()
-	cmd.Stderr = w
+	stdout := execLog.Stdout()
+	defer stdout.Close()
+	stderr := execLog.Stderr()
+	defer stderr.Close()
 
-	err := cmd.Run()
+	var (
+		stdinPipe io.WriteCloser
+		tty       *os.File
+		ttySlave  *os.File
+	)
+	if opts.Tty != nil {
+		// The command's stdio is attached to the pty slave, and its output is read from the pty master.
+		tty, ttySlave, err = openPTY(runtime.TtySize(opts.Tty))
+		if err != nil {
+			return nil, err
+		}
+		defer tty.Close()
+		defer ttySlave.Close()
+		cmd.Stdin, cmd.Stdout, cmd.Stderr = ttySlave, ttySlave, ttySlave
+		setControllingTerminal(cmd)
+	} else {
+		cmd.Stdout = stdout
+		cmd.Stderr = stderr
+		// Stdin is copied through a pipe rather than assigned to cmd.Stdin, as otherwise
+		// Wait would block until stdin reached EOF, even after the command had exited.
+		if stdin != nil {
+			stdinPipe, err = cmd.StdinPipe()
+			if err != nil {
+				return nil, fmt.Errorf("error creating stdin pipe: %w", err)
+			}
+		}
+	}
+	untrack, err := r.start(execID, cmd)
+	if err != nil {
+		return nil, err
+	}
+	defer untrack()
+	if tty != nil {
+		// Close our copy of the slave, so that reads from the master fail once the command's
+		// processes have all closed it.
+		ttySlave.Close()
+		defer pipeTTY(tty, stdin, stdout, grace)()
+	}
+	if stdinPipe != nil {
+		go func() {
+			io.Copy(stdinPipe, stdin)
+			stdinPipe.Close()
+		}()
+	}
+	start := time.Now()
+	waitC := make(chan error, 1)
+	go func() {
+		waitC <- cmd.Wait()
+	}()
+	var timeoutC <-chan time.Time
+	if opts.Timeout != nil {
+		timer := time.NewTimer(opts.Timeout.AsDuration())
+		defer timer.Stop()
+		timeoutC = timer.C
+	}
+	var timedOut bool
+	select {
+	case err = <-waitC:
+	case <-ctx.Done():
+		r.Log().ExecSource(execID, true).Printf("Command cancelled")
+		r.terminate(execID, cmd.Process, signal, grace, waitC)
+		return nil, fmt.Errorf("error command cancelled: %w", ctx.Err())
+	case <-timeoutC:
+		r.Log().ExecSource(execID, true).Printf("Command timed out after %s", opts.Timeout.AsDuration())
+		err = r.terminate(execID, cmd.Process, signal, grace, waitC)
+		timedOut = true
+	}
+	usage := resourceUsage(cmd.ProcessState, time.Since(start))
 	if err != nil {
 		var exitErr *exec.ExitError
 		if errors.As(err, &exitErr) {
-			return &runtime.ExecResult{ExitCode: int32(exitErr.ExitCode())}, nil
+			return &runtime.ExecResult{ExitCode: int32(exitErr.ExitCode()), TimedOut: timedOut, Usage: usage}, nil
 		}
 		return nil, fmt.Errorf("error running command: %w", err)
 	}
-	return &runtime.ExecResult{ExitCode: 0}, nil
+	return &runtime.ExecResult{ExitCode: 0, TimedOut: timedOut, Usage: usage}, nil
+}
+
+// resourceUsage returns the resources consumed by an exited process.
+func resourceUsage(state *os.ProcessState, wallTime time.Duration) *executorv1.ResourceUsage {
+	usage := &executorv1.ResourceUsage{WallTime: durationpb.New(wallTime)}
+	if state == nil {
+		return usage
+	}
+	usage.UserCpuTime = durationpb.New(state.UserTime())
+	usage.SystemCpuTime = durationpb.New(state.SystemTime())
+	usage.PeakRss = peakRSS(state)
+	return usage
+}
+
+// Attach runs an interactive session inside the runtime, in a pseudo-terminal.
+func (r *Runtime) Attach(ctx context.Context, session *runtime.AttachSession) (int32, error) {
+	command := session.Opts.Command
+	if len(command) == 0 {
+		command = runtime.DefaultAttachCommand(runtime.GetHostOS())
+	}
+	r.syslog.Infow("Attaching session", "id", session.ID, "command", command)
+	r.Log().Printf("Attaching interactive session: %v", command)
+	defer r.Log().Printf("Detached interactive session")
+
+	tty, ttySlave, err := openPTY(runtime.TtySize(session.Opts.Tty))
+	if err != nil {
+		return 0, err
+	}
+	defer tty.Close()
+	defer ttySlave.Close()
+	cmd := exec.Command(command[0], command[1:]...)
+	cmd.Dir = r.baseDir
+	cmd.Env = append(append(os.Environ(), r.defaults.Env...), session.Opts.Env...)
+	cmd.Stdin, cmd.Stdout, cmd.Stderr = ttySlave, ttySlave, ttySlave
+	setProcessGroup(cmd)
+	setControllingTerminal(cmd)
+	untrack, err := r.start(session.ID, cmd)
+	if err != nil {
+		return 0, err
+	}
+	defer untrack()
+	ttySlave.Close()
+	defer pipeTTY(tty, session.Stdin, session.Output, runtime.DefaultTerminationGracePeriod)()
+
+	waitC := make(chan error, 1)
+	go func() {
+		waitC <- cmd.Wait()
+	}()
+	resizeC := session.ResizeC
+	for {
+		select {
+		case err = <-waitC:
+			var exitErr *exec.ExitError
+			if errors.As(err, &exitErr) {
+				return int32(exitErr.ExitCode()), nil
+			}
+			if err != nil {
+				return 0, fmt.Errorf("error running command: %w", err)
+			}
+			return 0, nil
+		case size, ok := <-resizeC:
+			if !ok {
+				resizeC = nil
+				continue
+			}
+			rows, cols := runtime.TtySize(size)
+			if err := resizePTY(tty, rows, cols); err != nil {
+				r.syslog.Warnf("Error resizing session terminal: %v", err)
+			}
+		case <-ctx.Done():
+			// Hang up the terminal, as a terminal emulator would when closed.
+			r.terminate(session.ID, cmd.Process, "SIGHUP", runtime.DefaultTerminationGracePeriod, waitC)
+			return 0, fmt.Errorf("error session cancelled: %w", ctx.Err())
+		}
+	}
+}
+
+// start starts cmd, and tracks its process under id until the returned func is called.
+func (r *Runtime) start(id string, cmd *exec.Cmd) (func(), error) {
+	r.mu.Lock()
+	_, exists := r.processes[id]
+	r.mu.Unlock()
+	if exists {
+		return nil, fmt.Errorf("error exec %s is already running", id)
+	}
+	err := cmd.Start()
+	if err != nil {
+		return nil, fmt.Errorf("error starting command: %w", err)
+	}
+	r.mu.Lock()
+	if r.closed {
+		r.mu.Unlock()
+		signalProcessGroup(cmd.Process, "SIGKILL")
+		cmd.Wait()
+		return nil, fmt.Errorf("error runtime is closed")
+	}
+	r.processes[id] = cmd.Process
+	r.mu.Unlock()
+	return func() {
+		r.mu.Lock()
+		delete(r.processes, id)
+		r.mu.Unlock()
+	}, nil
+}
+
+// pipeTTY copies the output of the pty master tty to w, and stdin (if not nil) to tty.
+// The returned func blocks until the output has been drained, or drainTimeout passes, then closes tty.
+func pipeTTY(tty *os.File, stdin io.Reader, w io.Writer, drainTimeout time.Duration) func() {
+	outputDoneC := make(chan struct{})
+	go func() {
+		defer close(outputDoneC)
+		io.Copy(w, tty)
+	}()
+	if stdin != nil {
+		go func() {
+			io.Copy(tty, stdin)
+			// Terminals signal EOF with ^D rather than by closing.
+			tty.Write([]byte{0x04})
+		}()
+	}
+	return func() {
+		// Bound how long output held open by orphaned descendants is drained for.
+		select {
+		case <-outputDoneC:
+		case <-time.After(drainTimeout):
+		}
+		tty.Close()
+		<-outputDoneC
+	}
+}
+
+// workDir resolves the directory an exec runs in. dir may be relative to the work directory,
+// or an absolute path within it.
+func (r *Runtime) workDir(dir string) (string, error) {
+	if dir == "" {
+		return r.baseDir, nil
+	}
+	if filepath.IsAbs(dir) {
+		rel, err := filepath.Rel(r.baseDir, dir)
+		if err != nil || !filepath.IsLocal(rel) {
+			return "", fmt.Errorf("error work dir must be within the work directory: %s", dir)
+		}
+		return dir, nil
+	}
+	if !filepath.IsLocal(dir) {
+		return "", fmt.Errorf("error work dir must be within the work directory: %s", dir)
+	}
+	return filepath.Join(r.baseDir, dir), nil
+}
+
+// Signal sends the named signal to the process group of an in progress exec.
+func (r *Runtime) Signal(ctx context.Context, execID string, signal string) error {
+	r.mu.Lock()
+	p, ok := r.processes[execID]
+	r.mu.Unlock()
+	if !ok {
+		return fmt.Errorf("error exec not found")
+	}
+	r.Log().ExecSource(execID, true).Printf("Sending %s", signal)
+	return signalProcessGroup(p, signal)
+}
+
+// terminate sends signal to a command's process group, escalating to a kill if the group has
+// not exited within the grace period. Blocks until the command has exited and returns its result.
+func (r *Runtime) terminate(execID string, p *os.Process, signal string, grace time.Duration, waitC <-chan error) error {
+	sysLog := r.Log().ExecSource(execID, true)
+	sysLog.Printf("Terminating command with %s...", signal)
+	if err := signalProcessGroup(p, signal); err != nil {
+		r.syslog.Warnf("Error signalling process group %d: %v", p.Pid, err)
+	}
+	select {
+	case err := <-waitC:
+		return err
+	case <-time.After(grace):
+	}
+	sysLog.Printf("Command did not exit within %s; killing...", grace)
+	if err := signalProcessGroup(p, "SIGKILL"); err != nil {
+		r.syslog.Warnf("Error killing process group %d: %v", p.Pid, err)
+	}
+	return <-waitC
 }
 
 func (r *Runtime) Close() error {
 	var res error
-	// TODO killall
+	r.mu.Lock()
+	r.closed = true
+	for _, p := range r.processes {
+		if err := signalProcessGroup(p, "SIGKILL"); err != nil {
+			res = errors.Join(res, fmt.Errorf("error killing process group %d: %w", p.Pid, err))
+		}
+	}
+	r.mu.Unlock()
 	if r.baseDir != "" {
 		if err := os.RemoveAll(r.baseDir); err != nil {
 			res = errors.Join(res, err)
@@ -20,6 +20,11 @@ func NewLog(stream event.Stream, buildID string, runtimeID string) *Log {
 	}
 }
 
+// RuntimeID returns the ID of the runtime the log belongs to.
+func (l *Log) RuntimeID() string {
+	return l.runtimeID
+}
+
 func (l *Log) Named(name string) *Log {
 	return &Log{
 		BuildLog:  l.BuildLog.Named(name),
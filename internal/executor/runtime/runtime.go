@@ -2,6 +2,8 @@ package runtime
 
 import (
 	"context"
+	"io"
+	"math"
 	"time"
 
 	executorv1 "github.com/knita-io/knita/api/executor/v1"
@@ -15,10 +17,124 @@ type Runtime interface {
 	SetDeadline(deadline time.Time)
 	Log() *Log
 	Start(ctx context.Context) error
-	Exec(ctx context.Context, execID string, opts *executorv1.ExecOpts) (*ExecResult, error)
+	// Exec executes a command inside the runtime. stdin is nil if the command has no stdin.
+	Exec(ctx context.Context, execID string, opts *executorv1.ExecOpts, stdin io.Reader) (*ExecResult, error)
+	// Signal sends the named signal (e.g. "SIGQUIT") to the processes of an in progress exec.
+	Signal(ctx context.Context, execID string, signal string) error
+	// Attach runs an interactive session inside the runtime, in a pseudo-terminal.
+	// Returns the exit code of the session's command.
+	Attach(ctx context.Context, session *AttachSession) (int32, error)
 	Close() error
 }
 
+// AttachSession is an interactive session inside a runtime.
+type AttachSession struct {
+	// ID uniquely identifies the session within the runtime.
+	ID   string
+	Opts *executorv1.AttachOpts
+	// Stdin is written to the session's terminal until it reaches EOF.
+	Stdin io.Reader
+	// Output receives the session's terminal output. Unlike execs, session output is not written to the runtime's log.
+	Output io.Writer
+	// ResizeC receives the new terminal size whenever the client's terminal is resized.
+	ResizeC <-chan *executorv1.TtyOpts
+}
+
 type ExecResult struct {
 	ExitCode int32
+	// TimedOut indicates the command was terminated because it exceeded its timeout.
+	TimedOut bool
+	// Usage describes the resources consumed by the command.
+	Usage *executorv1.ResourceUsage
+}
+
+// Mount makes the host directory Source available at Target inside a runtime.
+// Relative targets are relative to the runtime's work directory.
+type Mount struct {
+	Source string
+	Target string
+}
+
+const (
+	// DefaultTerminationSignal is sent to an exec's processes when it is cancelled or times out.
+	DefaultTerminationSignal = "SIGTERM"
+	// DefaultTerminationGracePeriod is how long an exec's processes are given to exit after
+	// being sent the termination signal, before they are forcibly killed.
+	DefaultTerminationGracePeriod = time.Second * 10
+	// DefaultTtyRows is the height of an exec's pseudo-terminal if unspecified.
+	DefaultTtyRows = 24
+	// DefaultTtyCols is the width of an exec's pseudo-terminal if unspecified.
+	DefaultTtyCols = 80
+)
+
+// ExecDefaults are applied to every exec in a runtime.
+type ExecDefaults struct {
+	// Env is a list of environment variables in the form "key=value". Env set on an exec takes precedence.
+	Env []string
+	// Shell is the command line exec scripts are run with. Defaults to DefaultShell.
+	Shell []string
+}
+
+// NewExecDefaults returns the exec defaults configured by opts.
+func NewExecDefaults(opts *executorv1.RuntimeOpts) ExecDefaults {
+	return ExecDefaults{Env: opts.GetEnv(), Shell: opts.GetShell()}
+}
+
+// ExecEnv returns the environment of an exec, with the exec's env taking precedence over the defaults.
+func (d ExecDefaults) ExecEnv(opts *executorv1.ExecOpts) []string {
+	return append(append([]string{}, d.Env...), opts.Env...)
+}
+
+// ExecCommand returns the command line of an exec. Scripts are run with the default shell for os.
+func (d ExecDefaults) ExecCommand(opts *executorv1.ExecOpts, os OS) []string {
+	if opts.Script == "" {
+		return append([]string{opts.Name}, opts.Args...)
+	}
+	shell := d.Shell
+	if len(shell) == 0 {
+		shell = DefaultShell(os)
+	}
+	return append(append([]string{}, shell...), opts.Script)
+}
+
+// DefaultShell returns the command line scripts are run with when a runtime does not configure a shell.
+func DefaultShell(os OS) []string {
+	if os == OSWindows {
+		return []string{"cmd", "/C"}
+	}
+	return []string{"/bin/sh", "-c"}
+}
+
+// DefaultAttachCommand returns the command line of an attached session that does not specify a command.
+// On Linux and macOS, bash is preferred if it is installed.
+func DefaultAttachCommand(os OS) []string {
+	if os == OSWindows {
+		return []string{"cmd"}
+	}
+	return []string{"/bin/sh", "-c", "if command -v bash >/dev/null 2>&1; then exec bash; else exec sh; fi"}
+}
+
+// Termination returns the signal and grace period used to terminate an exec.
+func Termination(opts *executorv1.ExecOpts) (string, time.Duration) {
+	signal := DefaultTerminationSignal
+	if opts.TerminationSignal != "" {
+		signal = opts.TerminationSignal
+	}
+	grace := DefaultTerminationGracePeriod
+	if opts.TerminationGracePeriod != nil {
+		grace = opts.TerminationGracePeriod.AsDuration()
+	}
+	return signal, grace
+}
+
+// TtySize returns the size of an exec's pseudo-terminal.
+func TtySize(opts *executorv1.TtyOpts) (rows uint16, cols uint16) {
+	rows, cols = DefaultTtyRows, DefaultTtyCols
+	if opts.GetRows() > 0 {
+		rows = uint16(min(opts.GetRows(), math.MaxUint16))
+	}
+	if opts.GetCols() > 0 {
+		cols = uint16(min(opts.GetCols(), math.MaxUint16))
+	}
+	return rows, cols
 }
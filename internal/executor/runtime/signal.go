@@ -0,0 +1,22 @@
+package runtime
+
+import (
+	"fmt"
+	"slices"
+)
+
+// signals are the names of the signals that may be sent to execs.
+var signals = []string{
+	"SIGABRT", "SIGALRM", "SIGBUS", "SIGCHLD", "SIGCONT", "SIGFPE", "SIGHUP", "SIGILL", "SIGINT",
+	"SIGIO", "SIGKILL", "SIGPIPE", "SIGPROF", "SIGPWR", "SIGQUIT", "SIGSEGV", "SIGSTOP", "SIGSYS",
+	"SIGTERM", "SIGTRAP", "SIGTSTP", "SIGTTIN", "SIGTTOU", "SIGURG", "SIGUSR1", "SIGUSR2",
+	"SIGVTALRM", "SIGWINCH", "SIGXCPU", "SIGXFSZ",
+}
+
+// ValidateSignal returns an error if name is not the name of a known signal e.g. "SIGQUIT".
+func ValidateSignal(name string) error {
+	if !slices.Contains(signals, name) {
+		return fmt.Errorf("unknown signal %q", name)
+	}
+	return nil
+}
@@ -2,19 +2,28 @@ package executor
 
 import (
 	"context"
+	"crypto/sha256"
 	"errors"
 	"fmt"
 	"io"
 	"os"
+	"path/filepath"
+	"regexp"
 	stdruntime "runtime"
+	"strings"
+	"time"
 
+	"github.com/google/uuid"
 	"github.com/pbnjay/memory"
 	"go.uber.org/zap"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/protobuf/types/known/durationpb"
 
 	builtinv1 "github.com/knita-io/knita/api/events/builtin/v1"
 	executorv1 "github.com/knita-io/knita/api/executor/v1"
 	"github.com/knita-io/knita/internal/event"
+	"github.com/knita-io/knita/internal/executor/blob"
+	"github.com/knita-io/knita/internal/executor/cache"
 	"github.com/knita-io/knita/internal/executor/runtime"
 	"github.com/knita-io/knita/internal/file"
 )
@@ -24,6 +33,17 @@ type Config struct {
 	Name string
 	// Labels the executor will advertise to the broker.
 	Labels map[string]string
+	// DockerPolicy restricts the Docker runtime options that builds may request.
+	DockerPolicy DockerPolicy
+	// Cache configures the persistent caches runtimes may mount.
+	Cache cache.Config
+	// Blobs configures the store of imported file blobs, which files that are imported again are materialized from.
+	Blobs blob.Config
+}
+
+// imageRuntime is implemented by runtimes that are started from a container image.
+type imageRuntime interface {
+	ImageDigest() string
 }
 
 type Server struct {
@@ -31,6 +51,9 @@ type Server struct {
 	syslog     *zap.SugaredLogger
 	config     Config
 	supervisor *supervisor
+	blobs      *blob.Store
+	imports    *imports
+	exports    *exports
 }
 
 func NewServer(syslog *zap.SugaredLogger, config Config) *Server {
@@ -38,7 +61,10 @@ func NewServer(syslog *zap.SugaredLogger, config Config) *Server {
 	exec := &Server{
 		syslog:     syslog,
 		config:     config,
-		supervisor: newSupervisor(syslog),
+		supervisor: newSupervisor(syslog, cache.NewManager(syslog, config.Cache)),
+		blobs:      blob.NewStore(syslog, config.Blobs),
+		imports:    newImports(),
+		exports:    newExports(),
 	}
 	return exec
 }
@@ -95,36 +121,225 @@ func (s *Server) Open(ctx context.Context, req *executorv1.OpenRequest) (*execut
 		return nil, err
 	}
 	s.syslog.Infow("Opened runtime", "runtime_id", req.RuntimeId)
-	return &executorv1.OpenResponse{WorkDirectory: runtime.Directory(), SysInfo: s.getSysInfo()}, nil
+	res := &executorv1.OpenResponse{
+		WorkDirectory:      runtime.Directory(),
+		SysInfo:            s.getSysInfo(),
+		Compressions:       file.SupportedCompressions,
+		MaxTransferStreams: file.MaxStreams,
+	}
+	if ir, ok := runtime.(imageRuntime); ok {
+		res.ImageDigest = ir.ImageDigest()
+	}
+	return res, nil
 }
 
-func (s *Server) Exec(ctx context.Context, req *executorv1.ExecRequest) (*executorv1.ExecResponse, error) {
+func (s *Server) Exec(stream executorv1.Executor_ExecServer) error {
+	req, err := stream.Recv()
+	if err != nil {
+		return fmt.Errorf("error receiving exec request: %w", err)
+	}
 	if err := validateExecRequest(req); err != nil {
+		return err
+	}
+	runtime, err := s.supervisor.GetRuntime(req.RuntimeId)
+	if err != nil {
+		return err
+	}
+	var stdin io.Reader
+	if req.Opts.Stdin {
+		pr, pw := io.Pipe()
+		defer pr.Close()
+		go receiveStdin(stream, pw)
+		stdin = pr
+	}
+	res, err := runtime.Exec(stream.Context(), req.ExecId, req.Opts, stdin)
+	if err != nil {
+		return err
+	}
+	if res.Usage != nil {
+		runtime.Log().ExecSource(req.ExecId, true).Printf("Resource usage: %s", formatResourceUsage(res.Usage))
+	}
+	runtime.Log().Publish(&builtinv1.SyncPointReachedEvent{BarrierId: req.BarrierId})
+	return stream.SendAndClose(&executorv1.ExecResponse{ExitCode: res.ExitCode, TimedOut: res.TimedOut, Usage: res.Usage})
+}
+
+func (s *Server) Signal(ctx context.Context, req *executorv1.SignalRequest) (*executorv1.SignalResponse, error) {
+	if err := validateSignalRequest(req); err != nil {
 		return nil, err
 	}
 	runtime, err := s.supervisor.GetRuntime(req.RuntimeId)
 	if err != nil {
 		return nil, err
 	}
-	res, err := runtime.Exec(ctx, req.ExecId, req.Opts)
+	err = runtime.Signal(ctx, req.ExecId, req.Signal)
 	if err != nil {
 		return nil, err
 	}
-	runtime.Log().Publish(&builtinv1.SyncPointReachedEvent{BarrierId: req.BarrierId})
-	return &executorv1.ExecResponse{ExitCode: res.ExitCode}, nil
+	return &executorv1.SignalResponse{}, nil
+}
+
+// Attach runs an interactive session inside a runtime, streaming the session's terminal to and from the client.
+func (s *Server) Attach(stream executorv1.Executor_AttachServer) error {
+	req, err := stream.Recv()
+	if err != nil {
+		return fmt.Errorf("error receiving attach request: %w", err)
+	}
+	if err := validateAttachRequest(req); err != nil {
+		return err
+	}
+	rt, err := s.supervisor.GetRuntime(req.RuntimeId)
+	if err != nil {
+		return err
+	}
+	pr, pw := io.Pipe()
+	defer pr.Close()
+	resizeC := make(chan *executorv1.TtyOpts)
+	go receiveAttachInput(stream, pw, resizeC)
+	session := &runtime.AttachSession{
+		ID:      uuid.New().String(),
+		Opts:    req.Opts,
+		Stdin:   pr,
+		Output:  &attachOutputWriter{stream: stream},
+		ResizeC: resizeC,
+	}
+	exitCode, err := rt.Attach(stream.Context(), session)
+	if err != nil {
+		return err
+	}
+	return stream.Send(&executorv1.AttachResponse{Exited: true, ExitCode: exitCode})
+}
+
+// receiveAttachInput writes stdin chunks received from an attach stream to w, and sends terminal
+// resizes to resizeC, until the client closes its side of the stream, at which point w is closed
+// to signal EOF.
+func receiveAttachInput(stream executorv1.Executor_AttachServer, w *io.PipeWriter, resizeC chan<- *executorv1.TtyOpts) {
+	for {
+		req, err := stream.Recv()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				w.Close()
+			} else {
+				w.CloseWithError(err)
+			}
+			return
+		}
+		if req.Resize != nil {
+			select {
+			case resizeC <- req.Resize:
+			case <-stream.Context().Done():
+				return
+			}
+		}
+		if len(req.Stdin) > 0 {
+			if _, err := w.Write(req.Stdin); err != nil {
+				// The session is no longer reading stdin.
+				return
+			}
+		}
+	}
+}
+
+// attachOutputWriter sends terminal output to an attached client.
+type attachOutputWriter struct {
+	stream executorv1.Executor_AttachServer
+}
+
+func (w *attachOutputWriter) Write(p []byte) (int, error) {
+	if err := w.stream.Send(&executorv1.AttachResponse{Output: p}); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+// receiveStdin writes stdin chunks received from an exec stream to w until the client
+// closes its side of the stream, at which point w is closed to signal EOF.
+func receiveStdin(stream executorv1.Executor_ExecServer, w *io.PipeWriter) {
+	for {
+		req, err := stream.Recv()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				w.Close()
+			} else {
+				w.CloseWithError(err)
+			}
+			return
+		}
+		if _, err := w.Write(req.Stdin); err != nil {
+			// The command is no longer reading stdin.
+			return
+		}
+	}
+}
+
+func (s *Server) Manifest(req *executorv1.ManifestRequest, stream executorv1.Executor_ManifestServer) error {
+	if err := validateManifestRequest(req); err != nil {
+		return err
+	}
+	dest, err := s.supervisor.GetImportDest(req.RuntimeId)
+	if err != nil {
+		return err
+	}
+	return file.BuildManifest(dest.ReadFS(), req.Path, func(entries []*executorv1.ManifestEntry) error {
+		return stream.Send(&executorv1.ManifestResponse{Entries: entries})
+	})
+}
+
+func (s *Server) ManifestDigests(ctx context.Context, req *executorv1.ManifestDigestsRequest) (*executorv1.ManifestDigestsResponse, error) {
+	if err := validateManifestDigestsRequest(req); err != nil {
+		return nil, err
+	}
+	dest, err := s.supervisor.GetImportDest(req.RuntimeId)
+	if err != nil {
+		return nil, err
+	}
+	digests, err := file.ManifestDigests(dest.ReadFS(), req.Paths)
+	if err != nil {
+		return nil, err
+	}
+	return &executorv1.ManifestDigestsResponse{Files: digests}, nil
+}
+
+func (s *Server) Blobs(ctx context.Context, req *executorv1.BlobsRequest) (*executorv1.BlobsResponse, error) {
+	if err := validateBlobsRequest(req); err != nil {
+		return nil, err
+	}
+	if _, err := s.supervisor.GetImportDest(req.RuntimeId); err != nil {
+		return nil, err
+	}
+	if !s.blobs.Enabled() {
+		return &executorv1.BlobsResponse{}, nil
+	}
+	return &executorv1.BlobsResponse{Have: s.blobs.Has(req.Sha256S)}, nil
 }
 
 func (s *Server) Import(stream executorv1.Executor_ImportServer) error {
+	header, _ := metadata.FromIncomingContext(stream.Context())
+	imp := s.imports.join(header)
+	closed := false
+	defer func() {
+		if !closed {
+			if leaveErr := s.imports.leave(imp); leaveErr != nil {
+				s.syslog.Warnw("Failed to apply imported directory modes", "error", leaveErr)
+			}
+		}
+	}()
 	var (
-		runtime   runtime.Runtime
+		dest      file.WriteFS
+		runtimeID string
 		importID  string
 		receivers = make(map[string]*file.Receiver)
+		digests   []*executorv1.FileDigest
 	)
 	for {
 		req, err := stream.Recv()
 		if err != nil {
 			if errors.Is(err, io.EOF) {
-				return stream.SendAndClose(&executorv1.ImportResponse{})
+				// The modes of restricted directories are applied once every stream of the import has closed.
+				closed = true
+				if err := s.imports.leave(imp); err != nil {
+					return err
+				}
+				return stream.SendAndClose(&executorv1.ImportResponse{Files: digests})
 			}
 			s.syslog.Errorw("recv error", "error", err)
 			return fmt.Errorf("error in receive: %w", err)
@@ -132,14 +347,21 @@ func (s *Server) Import(stream executorv1.Executor_ImportServer) error {
 		if err = validateFileTransfer(req); err != nil {
 			return err
 		}
-		if runtime == nil {
-			runtime, err = s.supervisor.GetRuntime(req.RuntimeId)
+		if dest == nil {
+			if imp.id != "" && imp.id != req.TransferId {
+				return fmt.Errorf("invalid import id")
+			}
+			dest, err = s.supervisor.GetImportDest(req.RuntimeId)
 			if err != nil {
 				return err
 			}
+			runtimeID = req.RuntimeId
 			importID = req.TransferId
+			if err := s.imports.setDest(imp, runtimeID, dest); err != nil {
+				return err
+			}
 		}
-		if runtime.ID() != req.RuntimeId {
+		if runtimeID != req.RuntimeId {
 			return fmt.Errorf("invalid runtime id")
 		}
 		if importID != req.TransferId {
@@ -147,7 +369,11 @@ func (s *Server) Import(stream executorv1.Executor_ImportServer) error {
 		}
 		receiver, ok := receivers[req.FileId]
 		if !ok {
-			receiver = file.NewReceiver(s.syslog, runtime)
+			recvOpts := []file.RecvOpt{file.WithDirModes(imp.dirModes), file.WithRecvDeletes(), file.WithRecvSetid()}
+			if s.blobs.Enabled() {
+				recvOpts = append(recvOpts, file.WithBlobStore(s.blobs))
+			}
+			receiver = file.NewReceiver(s.syslog, dest, recvOpts...)
 			receivers[req.FileId] = receiver
 		}
 		err = receiver.Next(req)
@@ -157,10 +383,13 @@ func (s *Server) Import(stream executorv1.Executor_ImportServer) error {
 		if err != nil {
 			return err
 		}
+		if digest := receiver.Digest(); digest != nil {
+			digests = append(digests, digest)
+		}
 	}
 }
 
-func (s *Server) Export(req *executorv1.ExportRequest, stream executorv1.Executor_ExportServer) error {
+func (s *Server) Export(req *executorv1.ExportRequest, stream executorv1.Executor_ExportServer) (err error) {
 	if err := validateExportRequest(req); err != nil {
 		return err
 	}
@@ -182,7 +411,10 @@ func (s *Server) Export(req *executorv1.ExportRequest, stream executorv1.Executo
 			runtime.Log().Printf("Skipped file export src=%s, excluded_by=%s", path, excludedBy)
 		}
 	}
-	sendOpts := []file.SendOpt{file.WithSendCallback(sendCallback), file.WithSkipCallback(skipCallback)}
+	sendOpts := []file.SendOpt{
+		file.WithSendCallback(sendCallback),
+		file.WithSkipCallback(skipCallback),
+		file.WithCompression(file.NegotiateCompression(req.AcceptCompressions))}
 	if req.Opts != nil {
 		if len(req.Opts.Excludes) > 0 {
 			sendOpts = append(sendOpts, file.WithExcludes(req.Opts.Excludes))
@@ -191,8 +423,58 @@ func (s *Server) Export(req *executorv1.ExportRequest, stream executorv1.Executo
 			sendOpts = append(sendOpts, file.WithDest(req.Opts.DestPath))
 		}
 	}
+	if req.StreamIndex > 0 {
+		// The export's first stream sends files over this stream too.
+		joined, err := s.exports.join(runtime.ID(), req.ExportId, stream)
+		if err != nil {
+			return err
+		}
+		select {
+		case err := <-joined.done:
+			return err
+		case <-stream.Context().Done():
+			return stream.Context().Err()
+		}
+	}
+	// Streams that have joined this one are sent its result, including if it fails before it has taken them.
+	var joined []*joinedStream
+	defer func() {
+		s.exports.finish(runtime.ID(), req.ExportId, joined, err)
+	}()
+	// Report the number and size of the files to export to the client, so it can report the export's progress.
 	sender := file.NewSender(s.syslog, runtime.ReadFS(), stream, runtime.ID(), req.ExportId, sendOpts...)
-	_, err = sender.Send(req.SrcPath)
+	files, size, err := sender.Count(req.SrcPath)
+	if err != nil {
+		return err
+	}
+	header := file.TotalsHeaderMD(files, size)
+	streams := max(1, int(req.StreamCount))
+	if req.StreamCount <= 1 && req.MaxStreams > 1 {
+		// Choose the number of streams to split the export across, and report it to the client,
+		// which then opens the remaining streams.
+		streams = file.DefaultStreams(files, size, int(req.MaxStreams))
+		header = metadata.Join(header, file.StreamsHeaderMD(streams))
+	}
+	if err := stream.SendHeader(header); err != nil {
+		return fmt.Errorf("error sending header: %w", err)
+	}
+	if streams <= 1 {
+		_, err = sender.Send(req.SrcPath)
+		return err
+	}
+	// Wait for the client to open the remaining streams, then send the files over them all.
+	pending := s.exports.get(runtime.ID(), req.ExportId)
+	transports := []file.SendTransport{stream}
+	for len(transports) < streams {
+		select {
+		case j := <-pending.joined:
+			joined = append(joined, j)
+			transports = append(transports, j.transport)
+		case <-stream.Context().Done():
+			return stream.Context().Err()
+		}
+	}
+	_, err = sender.SendStreams(req.SrcPath, transports...)
 	return err
 }
 
@@ -200,7 +482,7 @@ func (s *Server) Heartbeat(ctx context.Context, req *executorv1.HeartbeatRequest
 	if err := validateHeartbeatRequest(req); err != nil {
 		return nil, err
 	}
-	extendedBy, err := s.supervisor.ExtendRuntime(req.RuntimeId)
+	extendedBy, err := s.supervisor.ExtendRuntime(req.RuntimeId, req.Suspend.AsDuration())
 	if err != nil {
 		return nil, err
 	}
@@ -216,7 +498,12 @@ func (s *Server) Close(ctx context.Context, req *executorv1.CloseRequest) (*exec
 		return nil, err
 	}
 	s.syslog.Infow("Closing runtime", "runtime_id", req.RuntimeId)
+	// Partial imports are completed before the runtime closes, in case its work directory outlives it.
+	if err := s.imports.forget(req.RuntimeId); err != nil {
+		s.syslog.Warnw("Failed to apply imported directory modes", "runtime_id", req.RuntimeId, "error", err)
+	}
 	s.supervisor.CloseRuntime(req.RuntimeId)
+	s.exports.forget(req.RuntimeId)
 	s.syslog.Infow("Closed runtime", "runtime_id", req.RuntimeId)
 	runtime.Log().Publish(&builtinv1.SyncPointReachedEvent{BarrierId: req.BarrierId})
 	return &executorv1.CloseResponse{}, nil
@@ -254,6 +541,40 @@ func (s *Server) getSysInfo() *executorv1.SystemInfo {
 	}
 }
 
+// formatResourceUsage returns a concise, human-readable summary of an exec's resource usage.
+func formatResourceUsage(usage *executorv1.ResourceUsage) string {
+	text := fmt.Sprintf("wall=%s", usage.WallTime.AsDuration().Round(time.Millisecond))
+	if usage.UserCpuTime != nil || usage.SystemCpuTime != nil {
+		text += fmt.Sprintf(", user=%s, system=%s",
+			usage.UserCpuTime.AsDuration().Round(time.Millisecond), usage.SystemCpuTime.AsDuration().Round(time.Millisecond))
+	}
+	if usage.PeakRss > 0 {
+		text += fmt.Sprintf(", peak_rss=%s", formatBytes(usage.PeakRss))
+	}
+	if c := usage.ContainerWide; c != nil {
+		// Container stats include every process in the container, not just the exec's.
+		text += fmt.Sprintf(", container_wide: cpu=%s, memory_peak=%s, block_io=%s/%s, network_io=%s/%s",
+			c.CpuTime.AsDuration().Round(time.Millisecond), formatBytes(c.MemoryPeak),
+			formatBytes(c.BlockReadBytes), formatBytes(c.BlockWriteBytes),
+			formatBytes(c.NetworkRxBytes), formatBytes(c.NetworkTxBytes))
+	}
+	return text
+}
+
+// formatBytes formats n bytes using binary units e.g. "1.5MiB".
+func formatBytes(n uint64) string {
+	const unit = 1024
+	if n < unit {
+		return fmt.Sprintf("%dB", n)
+	}
+	div, exp := uint64(unit), 0
+	for m := n / unit; m >= unit; m /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f%ciB", float64(n)/float64(div), "KMGTPE"[exp])
+}
+
 // validateOpenRequest validates an OpenRequest.
 func (s *Server) validateOpenRequest(req *executorv1.OpenRequest) error {
 	if req == nil {
@@ -265,6 +586,25 @@ func (s *Server) validateOpenRequest(req *executorv1.OpenRequest) error {
 	if req.Opts == nil {
 		return fmt.Errorf("empty opts")
 	}
+	cacheNames := make(map[string]bool)
+	for _, mount := range req.Opts.Caches {
+		if mount == nil {
+			return fmt.Errorf("nil cache mount")
+		}
+		if err := cache.ValidateName(mount.Name); err != nil {
+			return err
+		}
+		if cacheNames[mount.Name] {
+			return fmt.Errorf("duplicate cache name: %s", mount.Name)
+		}
+		cacheNames[mount.Name] = true
+		if mount.Path == "" {
+			return fmt.Errorf("missing cache %q path", mount.Name)
+		}
+		if req.Opts.Type == executorv1.RuntimeType_RUNTIME_HOST && !filepath.IsLocal(mount.Path) {
+			return fmt.Errorf("invalid cache %q path; host runtimes only support paths relative to the work directory", mount.Name)
+		}
+	}
 	switch req.Opts.Type {
 	case executorv1.RuntimeType_RUNTIME_HOST:
 		// NOTE: HostOpts is currently an empty struct
@@ -276,50 +616,60 @@ func (s *Server) validateOpenRequest(req *executorv1.OpenRequest) error {
 		if !ok {
 			return fmt.Errorf("expected Docker opts for runtime type Docker")
 		}
-		if dOpts.Docker.Image == nil {
-			return fmt.Errorf("missing Docker image")
+		if (dOpts.Docker.Image == nil) == (dOpts.Docker.Build == nil) {
+			return fmt.Errorf("exactly one of Docker image or build must be set")
 		}
-		if dOpts.Docker.Image.ImageUri == "" {
-			return fmt.Errorf("missing Docker image uri")
+		if dOpts.Docker.Image != nil {
+			err := validateDockerPullOpts(dOpts.Docker.Image)
+			if err != nil {
+				return err
+			}
 		}
-		switch dOpts.Docker.Image.PullStrategy {
-		case executorv1.DockerPullOpts_PULL_STRATEGY_NEVER:
-		case executorv1.DockerPullOpts_PULL_STRATEGY_ALWAYS:
-		case executorv1.DockerPullOpts_PULL_STRATEGY_NOT_EXISTS:
-		case executorv1.DockerPullOpts_PULL_STRATEGY_UNSPECIFIED:
+		if dOpts.Docker.Build != nil {
+			dockerfile := dOpts.Docker.Build.Dockerfile
+			if dockerfile != "" && !filepath.IsLocal(filepath.FromSlash(dockerfile)) {
+				return fmt.Errorf("invalid Docker build dockerfile; must be relative to the build context")
+			}
+		}
+		switch dOpts.Docker.NetworkMode {
+		case "", "bridge":
+		case "host", "none":
+			if len(dOpts.Docker.Services) > 0 {
+				return fmt.Errorf("services require the Docker bridge network mode")
+			}
 		default:
-			return fmt.Errorf("unknown Docker pull strategy: %s", dOpts.Docker.Image.PullStrategy)
+			return fmt.Errorf("unknown Docker network mode: %s", dOpts.Docker.NetworkMode)
 		}
-		if dOpts.Docker.Image.Auth != nil {
-			if dOpts.Docker.Image.Auth.Auth == nil {
-				return fmt.Errorf("missing Docker image auth")
+		if dOpts.Docker.ShmSize < 0 {
+			return fmt.Errorf("invalid Docker shm size: %d", dOpts.Docker.ShmSize)
+		}
+		for path := range dOpts.Docker.Tmpfs {
+			if !strings.HasPrefix(path, "/") && !filepath.IsAbs(path) {
+				return fmt.Errorf("invalid Docker tmpfs path; must be absolute: %s", path)
 			}
-			switch auth := dOpts.Docker.Image.Auth.Auth.(type) {
-			case *executorv1.DockerPullAuth_Basic:
-				if auth.Basic == nil {
-					return fmt.Errorf("missing Docker image basic auth")
-				}
-				if auth.Basic.Username == "" {
-					return fmt.Errorf("missing Docker image basic username")
-				}
-				if auth.Basic.Password == "" {
-					return fmt.Errorf("missing Docker image basic password")
-				}
-			case *executorv1.DockerPullAuth_AwsEcr:
-				if auth.AwsEcr == nil {
-					return fmt.Errorf("missing Docker image aws ecr")
-				}
-				if auth.AwsEcr.Region == "" {
-					return fmt.Errorf("missing Docker image aws ecr region")
-				}
-				if auth.AwsEcr.AwsAccessKeyId == "" {
-					return fmt.Errorf("missing Docker image aws ecr access key id")
-				}
-				if auth.AwsEcr.AwsSecretKey == "" {
-					return fmt.Errorf("missing Docker image aws ecr secret key")
-				}
-			default:
-				return fmt.Errorf("unknown auth type")
+		}
+		err := s.config.DockerPolicy.check(dOpts.Docker)
+		if err != nil {
+			return err
+		}
+		names := make(map[string]bool)
+		for _, service := range dOpts.Docker.Services {
+			if service == nil {
+				return fmt.Errorf("nil Docker service")
+			}
+			if service.Name == "" {
+				return fmt.Errorf("missing Docker service name")
+			}
+			if !dockerServiceNameRegexp.MatchString(service.Name) {
+				return fmt.Errorf("invalid Docker service name %q; must match %s", service.Name, dockerServiceNameRegexp)
+			}
+			if names[service.Name] {
+				return fmt.Errorf("duplicate Docker service name: %s", service.Name)
+			}
+			names[service.Name] = true
+			err := validateDockerPullOpts(service.Image)
+			if err != nil {
+				return fmt.Errorf("invalid Docker service %q: %w", service.Name, err)
 			}
 		}
 	default:
@@ -328,6 +678,61 @@ func (s *Server) validateOpenRequest(req *executorv1.OpenRequest) error {
 	return nil
 }
 
+// dockerServiceNameRegexp matches valid service names, which form part of the service's container name,
+// and so are restricted to the characters Docker allows in container names.
+var dockerServiceNameRegexp = regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9_.-]*$`)
+
+// validateDockerPullOpts validates a DockerPullOpts.
+func validateDockerPullOpts(opts *executorv1.DockerPullOpts) error {
+	if opts == nil {
+		return fmt.Errorf("missing Docker image")
+	}
+	if opts.ImageUri == "" {
+		return fmt.Errorf("missing Docker image uri")
+	}
+	switch opts.PullStrategy {
+	case executorv1.DockerPullOpts_PULL_STRATEGY_NEVER:
+	case executorv1.DockerPullOpts_PULL_STRATEGY_ALWAYS:
+	case executorv1.DockerPullOpts_PULL_STRATEGY_NOT_EXISTS:
+	case executorv1.DockerPullOpts_PULL_STRATEGY_UNSPECIFIED:
+	default:
+		return fmt.Errorf("unknown Docker pull strategy: %s", opts.PullStrategy)
+	}
+	if opts.Auth != nil {
+		if opts.Auth.Auth == nil {
+			return fmt.Errorf("missing Docker image auth")
+		}
+		switch auth := opts.Auth.Auth.(type) {
+		case *executorv1.DockerPullAuth_Basic:
+			if auth.Basic == nil {
+				return fmt.Errorf("missing Docker image basic auth")
+			}
+			if auth.Basic.Username == "" {
+				return fmt.Errorf("missing Docker image basic username")
+			}
+			if auth.Basic.Password == "" {
+				return fmt.Errorf("missing Docker image basic password")
+			}
+		case *executorv1.DockerPullAuth_AwsEcr:
+			if auth.AwsEcr == nil {
+				return fmt.Errorf("missing Docker image aws ecr")
+			}
+			if auth.AwsEcr.Region == "" {
+				return fmt.Errorf("missing Docker image aws ecr region")
+			}
+			if auth.AwsEcr.AwsAccessKeyId == "" {
+				return fmt.Errorf("missing Docker image aws ecr access key id")
+			}
+			if auth.AwsEcr.AwsSecretKey == "" {
+				return fmt.Errorf("missing Docker image aws ecr secret key")
+			}
+		default:
+			return fmt.Errorf("unknown auth type")
+		}
+	}
+	return nil
+}
+
 // validateCloseRequest validates a CloseRequest.
 func validateCloseRequest(req *executorv1.CloseRequest) error {
 	if req == nil {
@@ -364,12 +769,54 @@ func validateExecRequest(req *executorv1.ExecRequest) error {
 	if req.Opts == nil {
 		return errors.New("nil opts")
 	}
-	if req.Opts.Name == "" {
-		return errors.New("empty name")
+	if req.Opts.Name == "" && req.Opts.Script == "" {
+		return errors.New("empty name and script")
+	}
+	if req.Opts.Name != "" && req.Opts.Script != "" {
+		return errors.New("name and script are mutually exclusive")
+	}
+	if req.Opts.Timeout != nil && req.Opts.Timeout.AsDuration() <= 0 {
+		return errors.New("timeout must be positive")
+	}
+	if req.Opts.TerminationSignal != "" {
+		if err := runtime.ValidateSignal(req.Opts.TerminationSignal); err != nil {
+			return err
+		}
+	}
+	if req.Opts.TerminationGracePeriod != nil && req.Opts.TerminationGracePeriod.AsDuration() < 0 {
+		return errors.New("termination_grace_period must not be negative")
 	}
 	return nil
 }
 
+// validateAttachRequest validates an AttachRequest.
+func validateAttachRequest(req *executorv1.AttachRequest) error {
+	if req == nil {
+		return errors.New("nil request")
+	}
+	if req.RuntimeId == "" {
+		return errors.New("empty runtime_id")
+	}
+	if req.Opts == nil {
+		return errors.New("nil opts")
+	}
+	return nil
+}
+
+// validateSignalRequest validates a SignalRequest.
+func validateSignalRequest(req *executorv1.SignalRequest) error {
+	if req == nil {
+		return errors.New("nil request")
+	}
+	if req.RuntimeId == "" {
+		return errors.New("empty runtime_id")
+	}
+	if req.ExecId == "" {
+		return errors.New("empty exec_id")
+	}
+	return runtime.ValidateSignal(req.Signal)
+}
+
 // validateFileTransfer validates a FileTransfer.
 func validateFileTransfer(req *executorv1.FileTransfer) error {
 	if req == nil {
@@ -388,7 +835,7 @@ func validateFileTransfer(req *executorv1.FileTransfer) error {
 		if req.Header.DestPath == "" {
 			return fmt.Errorf("empty dest_path")
 		}
-		if req.Header.SrcPath == "" {
+		if req.Header.SrcPath == "" && !req.Header.Delete {
 			return fmt.Errorf("empty src_path")
 		}
 	}
@@ -398,6 +845,58 @@ func validateFileTransfer(req *executorv1.FileTransfer) error {
 	return nil
 }
 
+// validateManifestRequest validates a ManifestRequest.
+func validateManifestRequest(req *executorv1.ManifestRequest) error {
+	if req == nil {
+		return fmt.Errorf("nil request")
+	}
+	if req.RuntimeId == "" {
+		return fmt.Errorf("empty runtime_id")
+	}
+	if req.Path == "" {
+		return fmt.Errorf("empty path")
+	}
+	if !filepath.IsLocal(req.Path) {
+		return fmt.Errorf("path must be local to the runtime")
+	}
+	return nil
+}
+
+// validateManifestDigestsRequest validates a ManifestDigestsRequest.
+func validateManifestDigestsRequest(req *executorv1.ManifestDigestsRequest) error {
+	if req == nil {
+		return fmt.Errorf("nil request")
+	}
+	if req.RuntimeId == "" {
+		return fmt.Errorf("empty runtime_id")
+	}
+	if len(req.Paths) > file.MaxManifestDigestsPerRequest {
+		return fmt.Errorf("too many paths; at most %d may be requested at once", file.MaxManifestDigestsPerRequest)
+	}
+	for _, path := range req.Paths {
+		if !filepath.IsLocal(path) {
+			return fmt.Errorf("path must be local to the runtime")
+		}
+	}
+	return nil
+}
+
+// validateBlobsRequest validates a BlobsRequest.
+func validateBlobsRequest(req *executorv1.BlobsRequest) error {
+	if req == nil {
+		return fmt.Errorf("nil request")
+	}
+	if req.RuntimeId == "" {
+		return fmt.Errorf("empty runtime_id")
+	}
+	for _, digest := range req.Sha256S {
+		if len(digest) != sha256.Size {
+			return fmt.Errorf("invalid sha256 digest")
+		}
+	}
+	return nil
+}
+
 // validateExportRequest validates an ExportRequest.
 func validateExportRequest(req *executorv1.ExportRequest) error {
 	if req == nil {
@@ -413,6 +912,12 @@ func validateExportRequest(req *executorv1.ExportRequest) error {
 		return fmt.Errorf("empty src_path")
 	}
 	// NOTE: An empty dest path is valid
+	if req.StreamCount > file.MaxStreams {
+		return fmt.Errorf("stream_count exceeds %d", file.MaxStreams)
+	}
+	if req.StreamCount > 0 && req.StreamIndex >= req.StreamCount {
+		return fmt.Errorf("stream_index out of range")
+	}
 	return nil
 }
 
@@ -432,5 +937,8 @@ func validateHeartbeatRequest(req *executorv1.HeartbeatRequest) error {
 	if req.RuntimeId == "" {
 		return fmt.Errorf("empty runtime_id")
 	}
+	if req.Suspend != nil && req.Suspend.AsDuration() < 0 {
+		return fmt.Errorf("negative suspend")
+	}
 	return nil
 }
@@ -3,7 +3,9 @@ package executor
 import (
 	"context"
 	"fmt"
+	"os"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/moby/moby/client"
@@ -11,18 +13,27 @@ import (
 
 	executorv1 "github.com/knita-io/knita/api/executor/v1"
 	"github.com/knita-io/knita/internal/event"
+	"github.com/knita-io/knita/internal/executor/cache"
 	"github.com/knita-io/knita/internal/executor/runtime"
 	"github.com/knita-io/knita/internal/executor/runtime/docker"
 	"github.com/knita-io/knita/internal/executor/runtime/host"
+	"github.com/knita-io/knita/internal/file"
 )
 
 const deadlineExtensionPeriod = time.Minute * 2
 
-type runtimeFactory func(ctx context.Context, log *runtime.Log, buildID string, runtimeID string, opts *executorv1.RuntimeOpts) (runtime.Runtime, error)
+// maxSuspendPeriod is the longest a runtime's deadline can be suspended for by a single heartbeat.
+const maxSuspendPeriod = time.Hour * 24
+
+type runtimeFactory func(ctx context.Context, log *runtime.Log, buildID string, runtimeID string, opts *executorv1.RuntimeOpts, buildContextDir string, mounts []runtime.Mount) (runtime.Runtime, error)
 
 type pendingRuntime struct {
-	mu  sync.Mutex
-	log *runtime.Log
+	mu sync.Mutex
+	// opening is set while the runtime is being opened, and once it has been opened successfully.
+	opening atomic.Bool
+	log     *runtime.Log
+	// buildContextDir stages files imported before the runtime is opened (if any).
+	buildContextDir string
 }
 
 func newPendingRuntime(syslog *zap.SugaredLogger, buildID string, runtimeID string) *pendingRuntime {
@@ -30,18 +41,33 @@ func newPendingRuntime(syslog *zap.SugaredLogger, buildID string, runtimeID stri
 	return &pendingRuntime{log: runtime.NewLog(stream, buildID, runtimeID)}
 }
 
+// removeBuildContext removes the build context staging directory (if any).
+// It is a no-op if the runtime is currently being opened, as opening removes it.
+func (p *pendingRuntime) removeBuildContext() {
+	if !p.mu.TryLock() {
+		return
+	}
+	defer p.mu.Unlock()
+	if p.buildContextDir != "" {
+		os.RemoveAll(p.buildContextDir)
+		p.buildContextDir = ""
+	}
+}
+
 // supervisor manages the lifecycle of runtimes inside an executor.
 type supervisor struct {
 	syslog          *zap.SugaredLogger
 	runtimeFactory  runtimeFactory
+	caches          *cache.Manager
 	ctx             context.Context
 	ctxCancel       context.CancelFunc
 	mu              sync.RWMutex
 	pendingRuntimes map[string]*pendingRuntime
 	openRuntimes    map[string]runtime.Runtime
+	cacheLeases     map[string][]*cache.Lease
 }
 
-func newSupervisor(syslog *zap.SugaredLogger) *supervisor {
+func newSupervisor(syslog *zap.SugaredLogger, caches *cache.Manager) *supervisor {
 	ctx, cancel := context.WithCancel(context.Background())
 	sup := &supervisor{
 		syslog:          syslog.Named("supervisor"),
@@ -49,6 +75,8 @@ func newSupervisor(syslog *zap.SugaredLogger) *supervisor {
 		ctxCancel:       cancel,
 		pendingRuntimes: map[string]*pendingRuntime{},
 		openRuntimes:    map[string]runtime.Runtime{},
+		cacheLeases:     map[string][]*cache.Lease{},
+		caches:          caches,
 	}
 	sup.runtimeFactory = defaultRuntimeFactory(syslog)
 	go sup.watchdog()
@@ -85,20 +113,41 @@ func (s *supervisor) OpenRuntime(ctx context.Context, buildID string, runtimeID
 		return nil, fmt.Errorf("error locking pending runtime")
 	}
 	defer pending.mu.Unlock()
-	runtime, err := s.runtimeFactory(ctx, pending.log, buildID, runtimeID, opts)
+	pending.opening.Store(true)
+	opened := false
+	defer func() {
+		if !opened {
+			pending.opening.Store(false)
+		}
+	}()
+	defer func() {
+		if pending.buildContextDir != "" {
+			os.RemoveAll(pending.buildContextDir)
+			pending.buildContextDir = ""
+		}
+	}()
+	leases, mounts, err := s.acquireCaches(pending.log, opts.Caches)
+	if err != nil {
+		return nil, err
+	}
+	runtime, err := s.runtimeFactory(ctx, pending.log, buildID, runtimeID, opts, pending.buildContextDir, mounts)
 	if err != nil {
+		s.releaseCaches(leases)
 		return nil, fmt.Errorf("error creating runtime: %w", err)
 	}
 	runtime.SetDeadline(time.Now().Add(deadlineExtensionPeriod))
 	err = runtime.Start(ctx)
 	if err != nil {
 		runtime.Close()
+		s.releaseCaches(leases)
 		return nil, fmt.Errorf("error starting runtime: %w", err)
 	}
 	s.mu.Lock()
 	defer s.mu.Unlock()
 	delete(s.pendingRuntimes, runtimeID)
 	s.openRuntimes[runtimeID] = runtime
+	s.cacheLeases[runtimeID] = leases
+	opened = true
 	return runtime, nil
 }
 
@@ -114,33 +163,76 @@ func (s *supervisor) GetRuntime(id string) (runtime.Runtime, error) {
 	return runtime, nil
 }
 
-// ExtendRuntime pushes out an open runtimes deadline.
+// GetImportDest returns the filesystem that files imported into the runtime with the specified ID
+// should be written to. Open runtimes receive imports into their work directory. Pending runtimes
+// receive imports into a staging directory that becomes the runtime's build context once opened.
+func (s *supervisor) GetImportDest(runtimeID string) (file.WriteFS, error) {
+	s.mu.RLock()
+	runtime, open := s.openRuntimes[runtimeID]
+	pending, ok := s.pendingRuntimes[runtimeID]
+	s.mu.RUnlock()
+	if open {
+		return runtime, nil
+	}
+	if !ok {
+		return nil, fmt.Errorf("error runtime not found")
+	}
+	// Concurrent import streams may wait for each other, but not for the runtime to be opened.
+	if pending.opening.Load() {
+		return nil, fmt.Errorf("error runtime is opening")
+	}
+	pending.mu.Lock()
+	defer pending.mu.Unlock()
+	if pending.opening.Load() {
+		return nil, fmt.Errorf("error runtime is opening")
+	}
+	if pending.buildContextDir == "" {
+		dir, err := os.MkdirTemp("", "knita-build-context-*")
+		if err != nil {
+			return nil, fmt.Errorf("error creating build context dir: %w", err)
+		}
+		pending.buildContextDir = dir
+	}
+	return file.WriteDirFS(pending.buildContextDir), nil
+}
+
+// ExtendRuntime pushes out an open runtimes deadline. If suspend is longer than the
+// standard extension period, the deadline is pushed out by suspend instead, which keeps
+// the runtime alive without heartbeats e.g. while a build is paused for debugging.
 // Returns the amount of time the deadline was extended by.
-func (s *supervisor) ExtendRuntime(runtimeID string) (time.Duration, error) {
+func (s *supervisor) ExtendRuntime(runtimeID string, suspend time.Duration) (time.Duration, error) {
 	s.mu.RLock()
 	defer s.mu.RUnlock()
 	runtime, ok := s.openRuntimes[runtimeID]
 	if !ok {
 		return -1, fmt.Errorf("error runtime not found")
 	}
-	deadline := time.Now().Add(deadlineExtensionPeriod)
+	extension := max(deadlineExtensionPeriod, min(suspend, maxSuspendPeriod))
+	deadline := time.Now().Add(extension)
 	runtime.SetDeadline(deadline)
 	s.syslog.Debugf("Extended runtime %s deadline to: %s", runtime.ID(), deadline)
-	return deadlineExtensionPeriod, nil
+	return extension, nil
 }
 
 // CloseRuntime idempotently closes a runtime (prepared or open).
 func (s *supervisor) CloseRuntime(runtimeID string) {
 	s.mu.Lock()
+	pending, pendingOK := s.pendingRuntimes[runtimeID]
 	delete(s.pendingRuntimes, runtimeID)
 	runtime, ok := s.openRuntimes[runtimeID]
 	delete(s.openRuntimes, runtimeID)
+	leases := s.cacheLeases[runtimeID]
+	delete(s.cacheLeases, runtimeID)
 	s.mu.Unlock()
+	if pendingOK {
+		pending.removeBuildContext()
+	}
 	if ok {
 		if err := runtime.Close(); err != nil {
 			s.syslog.Warnf("Ignoring error closing runtime %s: %v", runtimeID, err)
 		}
 	}
+	s.releaseCaches(leases)
 }
 
 // Stop the supervisor and close all runtimes.
@@ -155,9 +247,45 @@ func (s *supervisor) Stop() {
 		if err != nil {
 			s.syslog.Errorf("Ignoring error closing runtime: %v", err)
 		}
+		s.releaseCaches(s.cacheLeases[runtime.ID()])
+	}
+	for _, pending := range s.pendingRuntimes {
+		pending.removeBuildContext()
 	}
 	s.pendingRuntimes = make(map[string]*pendingRuntime)
 	s.openRuntimes = make(map[string]runtime.Runtime)
+	s.cacheLeases = make(map[string][]*cache.Lease)
+}
+
+// acquireCaches leases the caches requested by a runtime and returns the mounts that make them
+// available inside the runtime.
+func (s *supervisor) acquireCaches(log *runtime.Log, caches []*executorv1.CacheMount) ([]*cache.Lease, []runtime.Mount, error) {
+	var (
+		leases []*cache.Lease
+		mounts []runtime.Mount
+	)
+	for _, mount := range caches {
+		lease, err := s.caches.Acquire(mount.Name, mount.MaxSize)
+		if err != nil {
+			s.releaseCaches(leases)
+			return nil, nil, fmt.Errorf("error acquiring cache %q: %w", mount.Name, err)
+		}
+		if lease.Ephemeral() {
+			log.Printf("Cache %q is in use by another runtime; using an empty temporary cache", mount.Name)
+		}
+		leases = append(leases, lease)
+		mounts = append(mounts, runtime.Mount{Source: lease.Dir(), Target: mount.Path})
+	}
+	return leases, mounts, nil
+}
+
+// releaseCaches releases previously acquired cache leases.
+func (s *supervisor) releaseCaches(leases []*cache.Lease) {
+	for _, lease := range leases {
+		if err := lease.Release(); err != nil {
+			s.syslog.Warnf("Ignoring error releasing cache: %v", err)
+		}
+	}
 }
 
 // watchdog continuously monitors runtimes and terminates any runtime that exceed their deadlines.
@@ -193,10 +321,10 @@ func (s *supervisor) watchdog() {
 }
 
 func defaultRuntimeFactory(syslog *zap.SugaredLogger) runtimeFactory {
-	return func(ctx context.Context, log *runtime.Log, buildID string, runtimeID string, opts *executorv1.RuntimeOpts) (runtime.Runtime, error) {
+	return func(ctx context.Context, log *runtime.Log, buildID string, runtimeID string, opts *executorv1.RuntimeOpts, buildContextDir string, mounts []runtime.Mount) (runtime.Runtime, error) {
 		switch opts.Type {
 		case executorv1.RuntimeType_RUNTIME_HOST:
-			return host.NewRuntime(syslog, log, runtimeID)
+			return host.NewRuntime(syslog, log, runtimeID, mounts, runtime.NewExecDefaults(opts))
 		case executorv1.RuntimeType_RUNTIME_DOCKER:
 			dOpts := opts.GetDocker()
 			if dOpts == nil {
@@ -206,7 +334,7 @@ func defaultRuntimeFactory(syslog *zap.SugaredLogger) runtimeFactory {
 			if err != nil {
 				return nil, fmt.Errorf("error making Docker API client: %w", err)
 			}
-			dRuntime, err := docker.NewRuntime(syslog, log, runtimeID, dOpts, dClient)
+			dRuntime, err := docker.NewRuntime(syslog, log, runtimeID, dOpts, runtime.NewExecDefaults(opts), buildContextDir, mounts, dClient)
 			if err != nil {
 				dClient.Close()
 				return nil, fmt.Errorf("error creating Docker runtime: %w", err)
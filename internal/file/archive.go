@@ -0,0 +1,389 @@
+package file
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// ArchiveFormat is the format of an archive that files are packed into on export, or extracted from on import.
+type ArchiveFormat int
+
+const (
+	ArchiveTar ArchiveFormat = iota + 1
+	ArchiveTarGzip
+	ArchiveZip
+)
+
+func (f ArchiveFormat) String() string {
+	switch f {
+	case ArchiveTar:
+		return "tar"
+	case ArchiveTarGzip:
+		return "tar.gz"
+	case ArchiveZip:
+		return "zip"
+	default:
+		return fmt.Sprintf("ArchiveFormat(%d)", int(f))
+	}
+}
+
+// ArchiveFormatFromPath returns the format of the archive at path, based on its extension:
+// .tar, .tar.gz, .tgz or .zip.
+func ArchiveFormatFromPath(path string) (ArchiveFormat, error) {
+	lower := strings.ToLower(path)
+	switch {
+	case strings.HasSuffix(lower, ".tar"):
+		return ArchiveTar, nil
+	case strings.HasSuffix(lower, ".tar.gz"), strings.HasSuffix(lower, ".tgz"):
+		return ArchiveTarGzip, nil
+	case strings.HasSuffix(lower, ".zip"):
+		return ArchiveZip, nil
+	default:
+		return 0, fmt.Errorf("error unsupported archive format %q; expected a .tar, .tar.gz, .tgz or .zip file", filepath.Base(path))
+	}
+}
+
+// WriteArchive packs the files, directories and symlinks in fsys into an archive of the given format,
+// preserving their modes and modification times. Symlinks are only preserved if fsys implements ReadLinkFS.
+func WriteArchive(w io.Writer, format ArchiveFormat, fsys fs.FS) error {
+	switch format {
+	case ArchiveTar:
+		return writeTar(w, fsys)
+	case ArchiveTarGzip:
+		gw := gzip.NewWriter(w)
+		if err := writeTar(gw, fsys); err != nil {
+			return err
+		}
+		return gw.Close()
+	case ArchiveZip:
+		return writeZip(w, fsys)
+	default:
+		return fmt.Errorf("error unsupported archive format: %s", format)
+	}
+}
+
+// archiveEntry is a file, directory or symlink to pack into an archive.
+type archiveEntry struct {
+	name   string
+	info   fs.FileInfo
+	target string
+}
+
+// walkArchive calls fn for each file, directory and symlink in fsys, excluding the root.
+func walkArchive(fsys fs.FS, fn func(entry *archiveEntry) error) error {
+	return fs.WalkDir(fsys, ".", func(name string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if name == "." {
+			return nil
+		}
+		info, err := lstat(fsys, name)
+		if err != nil {
+			return fmt.Errorf("error stating %s: %w", name, err)
+		}
+		entry := &archiveEntry{name: name, info: info}
+		switch {
+		case info.Mode()&fs.ModeSymlink != 0:
+			entry.target, err = fsys.(ReadLinkFS).ReadLink(name)
+			if err != nil {
+				return fmt.Errorf("error reading symlink %s: %w", name, err)
+			}
+		case info.IsDir(), info.Mode().IsRegular():
+		default:
+			// Devices, pipes and sockets cannot be transferred.
+			return nil
+		}
+		return fn(entry)
+	})
+}
+
+func writeTar(w io.Writer, fsys fs.FS) error {
+	tw := tar.NewWriter(w)
+	err := walkArchive(fsys, func(entry *archiveEntry) error {
+		header, err := tar.FileInfoHeader(entry.info, entry.target)
+		if err != nil {
+			return fmt.Errorf("error creating tar header for %s: %w", entry.name, err)
+		}
+		header.Name = entry.name
+		if entry.info.IsDir() {
+			header.Name += "/"
+		}
+		// Owners are not meaningful on the machine the archive is extracted on.
+		header.Uid, header.Gid, header.Uname, header.Gname = 0, 0, "", ""
+		if err := tw.WriteHeader(header); err != nil {
+			return fmt.Errorf("error writing tar header for %s: %w", entry.name, err)
+		}
+		if entry.info.Mode().IsRegular() {
+			return copyFromFS(tw, fsys, entry.name)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	return tw.Close()
+}
+
+func writeZip(w io.Writer, fsys fs.FS) error {
+	zw := zip.NewWriter(w)
+	err := walkArchive(fsys, func(entry *archiveEntry) error {
+		header, err := zip.FileInfoHeader(entry.info)
+		if err != nil {
+			return fmt.Errorf("error creating zip header for %s: %w", entry.name, err)
+		}
+		header.Name = entry.name
+		if entry.info.IsDir() {
+			header.Name += "/"
+		} else if entry.info.Mode().IsRegular() {
+			header.Method = zip.Deflate
+		}
+		fw, err := zw.CreateHeader(header)
+		if err != nil {
+			return fmt.Errorf("error writing zip header for %s: %w", entry.name, err)
+		}
+		switch {
+		case entry.info.Mode().IsRegular():
+			return copyFromFS(fw, fsys, entry.name)
+		case entry.target != "":
+			// Zip archives store the target of a symlink as its data.
+			_, err = io.WriteString(fw, entry.target)
+			return err
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	return zw.Close()
+}
+
+func copyFromFS(w io.Writer, fsys fs.FS, name string) error {
+	f, err := fsys.Open(name)
+	if err != nil {
+		return fmt.Errorf("error opening %s: %w", name, err)
+	}
+	defer f.Close()
+	if _, err := io.Copy(w, f); err != nil {
+		return fmt.Errorf("error archiving %s: %w", name, err)
+	}
+	return nil
+}
+
+// ExtractArchive extracts the archive at name in fsys, which is of the given format, into dest.
+// Modes, modification times and symlinks are preserved, except for setuid and setgid bits. The modes of
+// directories that deny their owner full access are applied once the archive has been extracted. Entries that resolve outside of dest are refused
+// (see WriteFS), as are symlinks to targets outside of dest.
+func ExtractArchive(fsys fs.FS, name string, format ArchiveFormat, dest WriteFS) error {
+	f, err := fsys.Open(name)
+	if err != nil {
+		return fmt.Errorf("error opening archive: %w", err)
+	}
+	defer f.Close()
+	dirModes := NewDirModes()
+	switch format {
+	case ArchiveTar:
+		err = extractTar(f, dest, dirModes)
+	case ArchiveTarGzip:
+		gr, gzErr := gzip.NewReader(f)
+		if gzErr != nil {
+			return fmt.Errorf("error reading archive: %w", gzErr)
+		}
+		defer gr.Close()
+		err = extractTar(gr, dest, dirModes)
+	case ArchiveZip:
+		err = extractZip(f, dest, dirModes)
+	default:
+		return fmt.Errorf("error unsupported archive format: %s", format)
+	}
+	if err != nil {
+		return err
+	}
+	return dirModes.Apply(dest)
+}
+
+func extractTar(r io.Reader, dest WriteFS, dirModes *DirModes) error {
+	tr := tar.NewReader(r)
+	for {
+		header, err := tr.Next()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			return fmt.Errorf("error reading archive: %w", err)
+		}
+		name, ok := archiveEntryName(header.Name)
+		if !ok {
+			continue
+		}
+		mode := header.FileInfo().Mode()
+		switch header.Typeflag {
+		case tar.TypeDir:
+			err = extractDir(dest, name, mode, dirModes)
+		case tar.TypeReg:
+			err = extractFile(dest, name, mode, header.ModTime, tr)
+		case tar.TypeSymlink:
+			err = extractSymlink(dest, name, header.Linkname)
+		case tar.TypeLink:
+			err = extractHardLink(dest, name, header.Linkname, mode, header.ModTime)
+		default:
+			// Devices, pipes and extended headers are not extracted.
+			continue
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+func extractZip(f fs.File, dest WriteFS, dirModes *DirModes) error {
+	ra, ok := f.(io.ReaderAt)
+	info, err := f.Stat()
+	if err != nil {
+		return fmt.Errorf("error stating archive: %w", err)
+	}
+	if !ok {
+		data, err := io.ReadAll(f)
+		if err != nil {
+			return fmt.Errorf("error reading archive: %w", err)
+		}
+		ra = bytes.NewReader(data)
+	}
+	zr, err := zip.NewReader(ra, info.Size())
+	if err != nil {
+		return fmt.Errorf("error reading archive: %w", err)
+	}
+	for _, zf := range zr.File {
+		name, ok := archiveEntryName(zf.Name)
+		if !ok {
+			continue
+		}
+		mode := zf.Mode()
+		switch {
+		case mode.IsDir():
+			err = extractDir(dest, name, mode, dirModes)
+		case mode&fs.ModeSymlink != 0:
+			err = extractZipSymlink(dest, name, zf)
+		case mode.IsRegular():
+			err = extractZipFile(dest, name, mode, zf)
+		default:
+			continue
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func extractZipFile(dest WriteFS, name string, mode fs.FileMode, zf *zip.File) error {
+	r, err := zf.Open()
+	if err != nil {
+		return fmt.Errorf("error reading %s from archive: %w", name, err)
+	}
+	defer r.Close()
+	return extractFile(dest, name, mode, zf.Modified, r)
+}
+
+func extractZipSymlink(dest WriteFS, name string, zf *zip.File) error {
+	r, err := zf.Open()
+	if err != nil {
+		return fmt.Errorf("error reading %s from archive: %w", name, err)
+	}
+	defer r.Close()
+	target, err := io.ReadAll(io.LimitReader(r, 4096))
+	if err != nil {
+		return fmt.Errorf("error reading %s from archive: %w", name, err)
+	}
+	return extractSymlink(dest, name, string(target))
+}
+
+// archiveEntryName returns the slash-separated name of an archive entry as a native relative path.
+// Returns false for the root entry. Absolute names are returned as they are, so they are refused by WriteFS.
+func archiveEntryName(name string) (string, bool) {
+	name = path.Clean(strings.TrimPrefix(name, "./"))
+	if name == "." || name == "/" {
+		return "", false
+	}
+	return filepath.FromSlash(name), true
+}
+
+func extractDir(dest WriteFS, name string, mode fs.FileMode, dirModes *DirModes) error {
+	if err := dest.MkdirAll(name, 0777); err != nil {
+		return fmt.Errorf("error extracting %s: %w", name, err)
+	}
+	// The owner retains full access until the archive has been extracted, so the directory's contents can be extracted.
+	mode = mode & specialModeMask &^ setidModeMask
+	if err := dest.Chmod(name, mode|0700); err != nil {
+		return fmt.Errorf("error extracting %s: %w", name, err)
+	}
+	if mode&0700 != 0700 {
+		dirModes.add(name, mode)
+	}
+	return nil
+}
+
+func extractFile(dest WriteFS, name string, mode fs.FileMode, mtime time.Time, r io.Reader) error {
+	if err := dest.MkdirAll(filepath.Dir(name), 0777); err != nil {
+		return fmt.Errorf("error extracting %s: %w", name, err)
+	}
+	f, err := dest.OpenFile(name, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, mode.Perm())
+	if err != nil {
+		return fmt.Errorf("error extracting %s: %w", name, err)
+	}
+	if _, err := io.Copy(f, r); err != nil {
+		f.Close()
+		return fmt.Errorf("error extracting %s: %w", name, err)
+	}
+	if err := f.Close(); err != nil {
+		return fmt.Errorf("error extracting %s: %w", name, err)
+	}
+	if err := dest.Chmod(name, mode&specialModeMask&^setidModeMask); err != nil {
+		return fmt.Errorf("error extracting %s: %w", name, err)
+	}
+	if !mtime.IsZero() {
+		if err := dest.Chtimes(name, time.Time{}, mtime); err != nil {
+			return fmt.Errorf("error extracting %s: %w", name, err)
+		}
+	}
+	return nil
+}
+
+func extractSymlink(dest WriteFS, name string, target string) error {
+	if err := dest.MkdirAll(filepath.Dir(name), 0777); err != nil {
+		return fmt.Errorf("error extracting %s: %w", name, err)
+	}
+	err := dest.Remove(name)
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("error extracting %s: %w", name, err)
+	}
+	if err := dest.Symlink(target, name); err != nil {
+		return fmt.Errorf("error extracting %s: %w", name, err)
+	}
+	return nil
+}
+
+// extractHardLink extracts a hard link to a previously extracted file as a copy of it.
+func extractHardLink(dest WriteFS, name string, target string, mode fs.FileMode, mtime time.Time) error {
+	targetName, ok := archiveEntryName(target)
+	if !ok {
+		return fmt.Errorf("error extracting %s: invalid link target %q", name, target)
+	}
+	src, err := dest.OpenFile(targetName, os.O_RDONLY, 0)
+	if err != nil {
+		return fmt.Errorf("error extracting %s: %w", name, err)
+	}
+	defer src.Close()
+	return extractFile(dest, name, mode, mtime, src)
+}
@@ -0,0 +1,102 @@
+package file
+
+import (
+	"archive/tar"
+	"bytes"
+	"errors"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestArchiveRoundTrip(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("symlinks are not supported on Windows")
+	}
+	src := t.TempDir()
+	mtime := time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)
+	require.NoError(t, os.MkdirAll(filepath.Join(src, "dist/bin"), 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(src, "dist/bin/app"), []byte("app"), 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(src, "dist/README"), bytes.Repeat([]byte("readme "), 1024), 0644))
+	require.NoError(t, os.Chmod(filepath.Join(src, "dist/bin/app"), 0755|os.ModeSetuid))
+	require.NoError(t, os.Chtimes(filepath.Join(src, "dist/bin/app"), time.Time{}, mtime))
+	require.NoError(t, os.Symlink("bin/app", filepath.Join(src, "dist/app")))
+	require.NoError(t, os.MkdirAll(filepath.Join(src, "dist/ro"), 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(src, "dist/ro/file"), []byte("file"), 0644))
+	require.NoError(t, os.Chmod(filepath.Join(src, "dist/ro"), 0555))
+	t.Cleanup(func() { os.Chmod(filepath.Join(src, "dist/ro"), 0755) })
+
+	for _, name := range []string{"dist.tar", "dist.tar.gz", "dist.tgz", "dist.zip"} {
+		t.Run(name, func(t *testing.T) {
+			format, err := ArchiveFormatFromPath(name)
+			require.NoError(t, err)
+			archive := filepath.Join(t.TempDir(), name)
+			f, err := os.Create(archive)
+			require.NoError(t, err)
+			require.NoError(t, WriteArchive(f, format, WriteDirFS(src).ReadFS()))
+			require.NoError(t, f.Close())
+
+			dest := t.TempDir()
+			require.NoError(t, ExtractArchive(os.DirFS(filepath.Dir(archive)), name, format, WriteDirFS(dest)))
+			t.Cleanup(func() { os.Chmod(filepath.Join(dest, "dist/ro"), 0755) })
+			// Directories that deny their owner write access are restricted once their contents have been extracted.
+			info, err := os.Stat(filepath.Join(dest, "dist/ro"))
+			require.NoError(t, err)
+			require.Equal(t, os.FileMode(0555), info.Mode().Perm())
+			for _, path := range []string{"dist/bin/app", "dist/README", "dist/ro/file"} {
+				expected, err := os.ReadFile(filepath.Join(src, path))
+				require.NoError(t, err)
+				actual, err := os.ReadFile(filepath.Join(dest, path))
+				require.NoError(t, err)
+				require.Equal(t, expected, actual)
+			}
+			info, err = os.Stat(filepath.Join(dest, "dist/bin/app"))
+			require.NoError(t, err)
+			// Setuid and setgid bits are stripped.
+			require.Equal(t, os.FileMode(0755), info.Mode()&specialModeMask)
+			require.True(t, info.ModTime().Equal(mtime), "expected mtime %s, got %s", mtime, info.ModTime())
+			target, err := os.Readlink(filepath.Join(dest, "dist/app"))
+			require.NoError(t, err)
+			require.Equal(t, "bin/app", target)
+		})
+	}
+
+	_, err := ArchiveFormatFromPath("dist.rar")
+	require.ErrorContains(t, err, "unsupported archive format")
+}
+
+func TestExtractArchiveRefusesEscapes(t *testing.T) {
+	var table = []struct {
+		name   string
+		header *tar.Header
+	}{
+		{name: "parent", header: &tar.Header{Name: "../evil", Typeflag: tar.TypeReg, Mode: 0644}},
+		{name: "absolute", header: &tar.Header{Name: "/tmp/evil", Typeflag: tar.TypeReg, Mode: 0644}},
+		{name: "symlink", header: &tar.Header{Name: "link", Typeflag: tar.TypeSymlink, Linkname: "../../evil"}},
+		{name: "hard link", header: &tar.Header{Name: "link", Typeflag: tar.TypeLink, Linkname: "../evil"}},
+	}
+	for _, test := range table {
+		t.Run(test.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			tw := tar.NewWriter(&buf)
+			require.NoError(t, tw.WriteHeader(test.header))
+			require.NoError(t, tw.Close())
+			archives := t.TempDir()
+			require.NoError(t, os.WriteFile(filepath.Join(archives, "evil.tar"), buf.Bytes(), 0644))
+
+			outer := t.TempDir()
+			dest := filepath.Join(outer, "dest")
+			require.NoError(t, os.Mkdir(dest, 0755))
+			err := ExtractArchive(os.DirFS(archives), "evil.tar", ArchiveTar, WriteDirFS(dest))
+			var escapeErr *PathEscapesRootError
+			require.True(t, errors.As(err, &escapeErr), "expected escape error, got: %v", err)
+			entries, err := os.ReadDir(outer)
+			require.NoError(t, err)
+			require.Len(t, entries, 1)
+		})
+	}
+}
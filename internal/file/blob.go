@@ -0,0 +1,51 @@
+package file
+
+import (
+	"fmt"
+	"os"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// MinBlobSize is the minimum size of the files that are sent by reference to blobs the receiver already holds,
+// and that are stored as blobs once received. Smaller files are cheaper to send than to negotiate.
+const MinBlobSize = 8 * 1024
+
+// maxBlobsPerRequest bounds the number of digests negotiated at once, keeping requests well within message size limits.
+const maxBlobsPerRequest = 10000
+
+// HaveBlobsFunc returns the subset of digests whose blobs the receiver holds.
+type HaveBlobsFunc func(digests [][]byte) ([][]byte, error)
+
+// BlobStore is a content-addressable store of file data, keyed by SHA-256 digest.
+type BlobStore interface {
+	// Materialize writes a copy of the blob with the given digest to name in fs. Blobs are never shared with the
+	// materialized files (e.g. by hard-linking them), so modifying the files does not modify the blobs.
+	Materialize(digest []byte, fs WriteFS, name string, perm os.FileMode) error
+	// Put stores a copy of the contents of name in fs, whose data has the given digest, as a blob.
+	Put(digest []byte, fs WriteFS, name string) error
+	// Evict removes the blob with the given digest e.g. because it was found to be corrupt.
+	Evict(digest []byte)
+}
+
+// BlobError is returned when a file that was sent by reference to a blob cannot be materialized from the
+// receiver's blob store, or the materialized file is corrupt. The transfer should be retried without blobs.
+type BlobError struct {
+	Path   string
+	Digest []byte
+	Err    error
+}
+
+func (e *BlobError) Error() string {
+	return fmt.Sprintf("error materializing %s from blob %x: %v", e.Path, e.Digest, e.Err)
+}
+
+func (e *BlobError) Unwrap() error {
+	return e.Err
+}
+
+// GRPCStatus reports blob errors as data loss when they are returned from gRPC handlers.
+func (e *BlobError) GRPCStatus() *status.Status {
+	return status.New(codes.DataLoss, e.Error())
+}
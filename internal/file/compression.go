@@ -0,0 +1,75 @@
+package file
+
+import (
+	"fmt"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/klauspost/compress/zstd"
+
+	executorv1 "github.com/knita-io/knita/api/executor/v1"
+)
+
+// SupportedCompressions are the codecs this package can compress and decompress file transfers with,
+// in order of preference.
+var SupportedCompressions = []executorv1.Compression{executorv1.Compression_COMPRESSION_ZSTD}
+
+// NegotiateCompression returns the most preferred supported codec that the peer accepts,
+// falling back to no compression if there are none in common.
+func NegotiateCompression(accepted []executorv1.Compression) executorv1.Compression {
+	for _, compression := range SupportedCompressions {
+		if slices.Contains(accepted, compression) {
+			return compression
+		}
+	}
+	return executorv1.Compression_COMPRESSION_NONE
+}
+
+// incompressibleExts are the extensions of file types that are already compressed,
+// which are sent uncompressed as compressing them again wastes CPU for little or no gain.
+var incompressibleExts = map[string]struct{}{
+	".7z": {}, ".apk": {}, ".br": {}, ".bz2": {}, ".deb": {}, ".gif": {}, ".gz": {}, ".jar": {},
+	".jpeg": {}, ".jpg": {}, ".lz4": {}, ".mp3": {}, ".mp4": {}, ".nupkg": {}, ".png": {}, ".rpm": {},
+	".tgz": {}, ".war": {}, ".webm": {}, ".webp": {}, ".whl": {}, ".xz": {}, ".zip": {}, ".zst": {},
+}
+
+// isCompressible returns true if the file at path may benefit from compression.
+func isCompressible(path string) bool {
+	_, ok := incompressibleExts[strings.ToLower(filepath.Ext(path))]
+	return !ok
+}
+
+var (
+	// zstdEncoder and zstdDecoder are safe for concurrent use via EncodeAll and DecodeAll.
+	zstdEncoder, _ = zstd.NewWriter(nil, zstd.WithEncoderLevel(zstd.SpeedFastest), zstd.WithEncoderConcurrency(1))
+	zstdDecoder, _ = zstd.NewReader(nil, zstd.WithDecoderConcurrency(0), zstd.WithDecoderMaxMemory(chunkSize))
+)
+
+// compress compresses a body chunk with the given codec.
+func compress(compression executorv1.Compression, data []byte) ([]byte, error) {
+	switch compression {
+	case executorv1.Compression_COMPRESSION_NONE:
+		return data, nil
+	case executorv1.Compression_COMPRESSION_ZSTD:
+		return zstdEncoder.EncodeAll(data, make([]byte, 0, len(data))), nil
+	default:
+		return nil, fmt.Errorf("error unsupported compression: %s", compression)
+	}
+}
+
+// decompress decompresses a body chunk that was compressed with the given codec.
+func decompress(compression executorv1.Compression, data []byte) ([]byte, error) {
+	switch compression {
+	case executorv1.Compression_COMPRESSION_NONE:
+		return data, nil
+	case executorv1.Compression_COMPRESSION_ZSTD:
+		decoded, err := zstdDecoder.DecodeAll(data, nil)
+		if err != nil {
+			return nil, fmt.Errorf("error decompressing zstd chunk: %w", err)
+		}
+		return decoded, nil
+	default:
+		return nil, fmt.Errorf("error unsupported compression: %s", compression)
+	}
+}
@@ -0,0 +1,50 @@
+package file
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+)
+
+// DirModes defers applying the modes of received directories that deny their owner full access, as the
+// directories' contents could not be received otherwise. The modes are applied by Apply, once every file
+// of the transfer has been received. DirModes is safe for concurrent use by the receivers of a transfer's streams.
+type DirModes struct {
+	mu    sync.Mutex
+	modes map[string]os.FileMode
+}
+
+func NewDirModes() *DirModes {
+	return &DirModes{modes: make(map[string]os.FileMode)}
+}
+
+func (d *DirModes) add(path string, mode os.FileMode) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.modes[filepath.Clean(path)] = mode
+}
+
+// Apply applies the deferred directory modes to fs, deepest directories first,
+// so that restricting a directory does not prevent the modes of its subdirectories being applied.
+func (d *DirModes) Apply(fs WriteFS) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	paths := make([]string, 0, len(d.modes))
+	for path := range d.modes {
+		paths = append(paths, path)
+	}
+	slices.SortFunc(paths, func(a, b string) int {
+		return strings.Count(b, string(filepath.Separator)) - strings.Count(a, string(filepath.Separator))
+	})
+	for _, path := range paths {
+		err := fs.Chmod(path, d.modes[path])
+		if err != nil {
+			return fmt.Errorf("error setting directory mode: %w", err)
+		}
+		delete(d.modes, path)
+	}
+	return nil
+}
@@ -1,17 +1,79 @@
 package file
 
 import (
+	"errors"
+	"fmt"
 	"io"
 	"io/fs"
 	"os"
 	"path/filepath"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
+// maxSymlinks bounds the number of symlinks followed when resolving a path, so symlink loops are detected.
+const maxSymlinks = 255
+
+// WriteFS is a writable file system rooted at a directory. Paths are resolved within the root,
+// including through any symlinks they traverse, and paths that would escape it are refused with a PathEscapesRootError.
 type WriteFS interface {
 	ReadFS() fs.FS
 	Directory() string
 	MkdirAll(path string, perm os.FileMode) error
 	OpenFile(name string, flag int, perm os.FileMode) (File, error)
+	// OpenNoFollow opens name for reading, without following it if it is a symbolic link.
+	// Returns an error unless name is a regular file.
+	OpenNoFollow(name string) (File, error)
+	Remove(name string) error
+	RemoveAll(name string) error
+	Chtimes(name string, atime time.Time, mtime time.Time) error
+	// Symlink creates name as a symbolic link to target.
+	Symlink(target string, name string) error
+	Chmod(name string, mode os.FileMode) error
+}
+
+// PathEscapesRootError is returned by a WriteFS when a path resolves outside of its root directory,
+// because it is absolute, has too many ".." elements, or traverses a symlink that points outside of the root.
+type PathEscapesRootError struct {
+	Path string
+}
+
+func (e *PathEscapesRootError) Error() string {
+	return fmt.Sprintf("error path %s escapes the root directory", e.Path)
+}
+
+// GRPCStatus reports path escapes as permission denied when they are returned from gRPC handlers.
+func (e *PathEscapesRootError) GRPCStatus() *status.Status {
+	return status.New(codes.PermissionDenied, e.Error())
+}
+
+// ReadLinkFS is implemented by file systems that support symbolic links.
+// Files in file systems that do not are treated as regular files or directories.
+type ReadLinkFS interface {
+	fs.FS
+	// ReadLink returns the target of the symbolic link name.
+	ReadLink(name string) (string, error)
+	// Lstat returns information about name, without following it if it is a symbolic link.
+	Lstat(name string) (fs.FileInfo, error)
+}
+
+// specialModeMask is the mask of the mode bits that transfers preserve.
+const specialModeMask = fs.ModePerm | fs.ModeSetuid | fs.ModeSetgid | fs.ModeSticky
+
+// setidModeMask is the mask of the setuid and setgid bits, which are stripped from received and extracted files
+// unless the receiver opts in to them (see WithRecvSetid), as they would run with their owner's privileges.
+const setidModeMask = fs.ModeSetuid | fs.ModeSetgid
+
+// lstat returns information about name in fsys, without following it if it is a symbolic link
+// and fsys supports symbolic links.
+func lstat(fsys fs.FS, name string) (fs.FileInfo, error) {
+	if rfs, ok := fsys.(ReadLinkFS); ok {
+		return rfs.Lstat(name)
+	}
+	return fs.Stat(fsys, name)
 }
 
 type File interface {
@@ -26,7 +88,7 @@ func WriteDirFS(baseDir string) WriteFS {
 type writeDirFs struct{ baseDir string }
 
 func (r *writeDirFs) ReadFS() fs.FS {
-	return os.DirFS(r.baseDir)
+	return &dirFS{FS: os.DirFS(r.baseDir), baseDir: r.baseDir}
 }
 
 func (r *writeDirFs) Directory() string {
@@ -34,11 +96,216 @@ func (r *writeDirFs) Directory() string {
 }
 
 func (r *writeDirFs) MkdirAll(path string, perm os.FileMode) error {
-	return os.MkdirAll(filepath.Join(r.baseDir, path), perm)
+	resolved, err := r.resolve(path, true)
+	if err != nil {
+		return err
+	}
+	return os.MkdirAll(resolved, perm)
 }
 
 func (r *writeDirFs) OpenFile(name string, flag int, perm os.FileMode) (File, error) {
-	return os.OpenFile(filepath.Join(r.baseDir, name), flag, perm)
+	resolved, err := r.resolve(name, true)
+	if err != nil {
+		return nil, err
+	}
+	return os.OpenFile(resolved, flag, perm)
+}
+
+func (r *writeDirFs) OpenNoFollow(name string) (File, error) {
+	resolved, err := r.resolve(name, false)
+	if err != nil {
+		return nil, err
+	}
+	info, err := os.Lstat(resolved)
+	if err != nil {
+		return nil, err
+	}
+	if !info.Mode().IsRegular() {
+		return nil, fmt.Errorf("error %s is not a regular file", name)
+	}
+	f, err := os.OpenFile(resolved, os.O_RDONLY|openNoFollowFlags, 0)
+	if err != nil {
+		return nil, err
+	}
+	// name could have been replaced since it was checked.
+	opened, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return nil, err
+	}
+	if !opened.Mode().IsRegular() || !os.SameFile(info, opened) {
+		f.Close()
+		return nil, fmt.Errorf("error %s was replaced while opening it", name)
+	}
+	return f, nil
+}
+
+func (r *writeDirFs) Remove(name string) error {
+	resolved, err := r.resolve(name, false)
+	if err != nil {
+		return err
+	}
+	return os.Remove(resolved)
+}
+
+func (r *writeDirFs) RemoveAll(name string) error {
+	resolved, err := r.resolve(name, false)
+	if err != nil {
+		return err
+	}
+	return os.RemoveAll(resolved)
+}
+
+func (r *writeDirFs) Chtimes(name string, atime time.Time, mtime time.Time) error {
+	resolved, err := r.resolve(name, true)
+	if err != nil {
+		return err
+	}
+	return os.Chtimes(resolved, atime, mtime)
+}
+
+// Symlink creates name as a symbolic link to target. Targets that resolve outside of the root are refused,
+// so the link cannot later be used to escape it.
+func (r *writeDirFs) Symlink(target string, name string) error {
+	root, err := r.root()
+	if err != nil {
+		return err
+	}
+	resolved, err := r.resolveIn(root, root, name, false)
+	if err != nil {
+		return err
+	}
+	if isRooted(target) {
+		return &PathEscapesRootError{Path: target}
+	}
+	// The target is relative to the directory the link is created in.
+	if _, err := r.resolveIn(root, filepath.Dir(resolved), target, true); err != nil {
+		return err
+	}
+	return os.Symlink(target, resolved)
+}
+
+func (r *writeDirFs) Chmod(name string, mode os.FileMode) error {
+	resolved, err := r.resolve(name, true)
+	if err != nil {
+		return err
+	}
+	return os.Chmod(resolved, mode)
+}
+
+// root returns the absolute path of the root directory, with any symlinks in it resolved.
+func (r *writeDirFs) root() (string, error) {
+	root, err := filepath.Abs(r.baseDir)
+	if err != nil {
+		return "", fmt.Errorf("error resolving root directory: %w", err)
+	}
+	resolved, err := filepath.EvalSymlinks(root)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return root, nil
+		}
+		return "", fmt.Errorf("error resolving root directory: %w", err)
+	}
+	return resolved, nil
+}
+
+// resolve returns the host path of name within the root directory. See resolveIn.
+func (r *writeDirFs) resolve(name string, follow bool) (string, error) {
+	root, err := r.root()
+	if err != nil {
+		return "", err
+	}
+	return r.resolveIn(root, root, name, follow)
+}
+
+// resolveIn returns the host path of name relative to dir, which must be a resolved path within root.
+// Symlinks are resolved one element at a time, in the same way as the operating system resolves them,
+// and the final element of name is only resolved if follow is true. Returns a PathEscapesRootError if
+// name, or any symlink it traverses, resolves outside of root. Elements that do not exist yet are taken as they are.
+// Paths are resolved before they are used, so concurrent modifications of the root directory are not protected against.
+func (r *writeDirFs) resolveIn(root string, dir string, name string, follow bool) (string, error) {
+	if isRooted(name) {
+		return "", &PathEscapesRootError{Path: name}
+	}
+	path := dir
+	pending := splitPath(name)
+	links := 0
+	for len(pending) > 0 {
+		elem := pending[0]
+		pending = pending[1:]
+		switch elem {
+		case "", ".":
+			continue
+		case "..":
+			if path == root {
+				return "", &PathEscapesRootError{Path: name}
+			}
+			path = filepath.Dir(path)
+			continue
+		}
+		next := filepath.Join(path, elem)
+		if len(pending) == 0 && !follow {
+			path = next
+			continue
+		}
+		info, err := os.Lstat(next)
+		if err != nil || info.Mode()&fs.ModeSymlink == 0 {
+			path = next
+			continue
+		}
+		links++
+		if links > maxSymlinks {
+			return "", fmt.Errorf("error resolving %s: too many symlinks", name)
+		}
+		target, err := os.Readlink(next)
+		if err != nil {
+			return "", fmt.Errorf("error reading symlink: %w", err)
+		}
+		if filepath.IsAbs(target) {
+			rel, err := filepath.Rel(root, target)
+			if err != nil || !filepath.IsLocal(rel) && rel != "." {
+				return "", &PathEscapesRootError{Path: name}
+			}
+			path = root
+			target = rel
+		} else if isRooted(target) {
+			return "", &PathEscapesRootError{Path: name}
+		}
+		pending = append(splitPath(target), pending...)
+	}
+	return path, nil
+}
+
+// isRooted returns true if path is absolute, or is relative to a root other than the current directory
+// e.g. a volume or the root of the current volume on Windows.
+func isRooted(path string) bool {
+	return filepath.IsAbs(path) || filepath.VolumeName(path) != "" || strings.HasPrefix(filepath.ToSlash(path), "/")
+}
+
+// splitPath splits a path into its elements, without cleaning it. Cleaning a path before its symlinks
+// are resolved can change the file it refers to, as ".." elements are resolved lexically.
+func splitPath(path string) []string {
+	return strings.Split(filepath.ToSlash(path), "/")
+}
+
+// dirFS is an os.DirFS that supports symbolic links.
+type dirFS struct {
+	fs.FS
+	baseDir string
+}
+
+func (d *dirFS) ReadLink(name string) (string, error) {
+	if !fs.ValidPath(name) {
+		return "", &fs.PathError{Op: "readlink", Path: name, Err: fs.ErrInvalid}
+	}
+	return os.Readlink(filepath.Join(d.baseDir, filepath.FromSlash(name)))
+}
+
+func (d *dirFS) Lstat(name string) (fs.FileInfo, error) {
+	if !fs.ValidPath(name) {
+		return nil, &fs.PathError{Op: "lstat", Path: name, Err: fs.ErrInvalid}
+	}
+	return os.Lstat(filepath.Join(d.baseDir, filepath.FromSlash(name)))
 }
 
 type Logger interface {
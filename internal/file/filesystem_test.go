@@ -0,0 +1,140 @@
+package file
+
+import (
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/knita-io/knita/api/executor/v1"
+)
+
+// setupEscapeTest creates a root directory containing symlinks that point outside of it, next to a directory
+// containing a sentinel file, as e.g. a build step might. Returns the parent directory and the root directory.
+func setupEscapeTest(t testing.TB) (string, string) {
+	if runtime.GOOS == "windows" {
+		t.Skip("symlinks are not supported on Windows")
+	}
+	outer := t.TempDir()
+	root := filepath.Join(outer, "root")
+	require.NoError(t, os.MkdirAll(filepath.Join(root, "sub"), 0755))
+	require.NoError(t, os.MkdirAll(filepath.Join(outer, "outside"), 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(outer, "outside", "sentinel"), []byte("sentinel"), 0644))
+	require.NoError(t, os.Symlink("..", filepath.Join(root, "up")))
+	require.NoError(t, os.Symlink(filepath.Join(outer, "outside"), filepath.Join(root, "abs")))
+	require.NoError(t, os.Symlink("sub", filepath.Join(root, "inside")))
+	require.NoError(t, os.Symlink("../up", filepath.Join(root, "sub", "back")))
+	require.NoError(t, os.Symlink("loop", filepath.Join(root, "loop")))
+	return outer, root
+}
+
+// requireOutsideUnchanged requires that nothing outside of the root directory was created, modified or removed.
+func requireOutsideUnchanged(t testing.TB, outer string) {
+	var paths []string
+	err := filepath.WalkDir(outer, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if path == filepath.Join(outer, "root") {
+			return fs.SkipDir
+		}
+		rel, err := filepath.Rel(outer, path)
+		require.NoError(t, err)
+		paths = append(paths, filepath.ToSlash(rel))
+		return nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{".", "outside", "outside/sentinel"}, paths)
+	data, err := os.ReadFile(filepath.Join(outer, "outside", "sentinel"))
+	require.NoError(t, err)
+	require.Equal(t, "sentinel", string(data))
+}
+
+func TestWriteDirFSRefusesEscapes(t *testing.T) {
+	outer, root := setupEscapeTest(t)
+	fsys := WriteDirFS(root)
+
+	var table = []struct {
+		name    string
+		escapes bool
+	}{
+		{name: "file"},
+		{name: "sub/../file"},
+		{name: "inside/file"},
+		{name: "../file", escapes: true},
+		{name: "sub/../../file", escapes: true},
+		{name: "/file", escapes: true},
+		{name: filepath.Join(outer, "outside", "sentinel"), escapes: true},
+		{name: "up/outside/sentinel", escapes: true},
+		{name: "abs/sentinel", escapes: true},
+		{name: "sub/back/outside/sentinel", escapes: true},
+		{name: "inside/back/file", escapes: true},
+	}
+
+	for _, test := range table {
+		t.Run(test.name, func(t *testing.T) {
+			f, err := fsys.OpenFile(test.name, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0644)
+			if test.escapes {
+				var escapeErr *PathEscapesRootError
+				require.True(t, errors.As(err, &escapeErr), "expected escape error, got: %v", err)
+			} else {
+				require.NoError(t, err)
+				require.NoError(t, f.Close())
+			}
+			requireOutsideUnchanged(t, outer)
+		})
+	}
+
+	_, err := fsys.OpenFile("loop/file", os.O_CREATE|os.O_WRONLY, 0644)
+	require.ErrorContains(t, err, "too many symlinks")
+
+	// Symlinks are only created if their targets resolve within the root.
+	require.NoError(t, fsys.Symlink("../file", "sub/link"))
+	require.NoError(t, fsys.Symlink("inside/../sub", "link"))
+	var escapeErr *PathEscapesRootError
+	require.True(t, errors.As(fsys.Symlink("../outside", "sub/back/link"), &escapeErr))
+	require.True(t, errors.As(fsys.Symlink("up", "link2"), &escapeErr))
+	require.True(t, errors.As(fsys.Symlink("sub/back", "link3"), &escapeErr))
+
+	// Removing a symlink removes the link, not its target.
+	require.NoError(t, fsys.RemoveAll("up"))
+	require.NoError(t, fsys.Remove("abs"))
+	requireOutsideUnchanged(t, outer)
+}
+
+func FuzzReceiveDestPath(f *testing.F) {
+	f.Add("file", "", false, false)
+	f.Add("../file", "", false, false)
+	f.Add("up/outside/sentinel", "", false, false)
+	f.Add("abs/sentinel", "", false, false)
+	f.Add("abs", "", false, true)
+	f.Add("sub/back/outside", "", true, false)
+	f.Add("up/outside", "", false, true)
+	f.Add("link", "../outside/sentinel", false, false)
+	f.Add("sub/back/link", "outside", false, false)
+	f.Add("inside/link", "../../outside", false, false)
+
+	f.Fuzz(func(t *testing.T, destPath string, linkTarget string, isDir bool, del bool) {
+		outer, root := setupEscapeTest(t)
+		header := &v1.FileTransferHeader{DestPath: destPath, IsDir: isDir, Delete: del, Mode: 0755}
+		if linkTarget != "" {
+			header.Type = v1.FileType_FILE_TYPE_SYMLINK
+			header.LinkTarget = linkTarget
+		}
+		receiver := NewReceiver(zap.NewNop().Sugar(), WriteDirFS(root))
+		// Errors are expected for most inputs; the receiver must never write outside of the root.
+		_ = receiver.Next(&v1.FileTransfer{
+			Header:  header,
+			Body:    &v1.FileTransferBody{Data: []byte("escaped")},
+			Trailer: &v1.FileTransferTrailer{},
+		})
+		requireOutsideUnchanged(t, outer)
+		_, err := os.Stat(root)
+		require.NoError(t, err)
+	})
+}
@@ -0,0 +1,193 @@
+package file
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"path"
+	"strings"
+
+	"github.com/bmatcuk/doublestar/v4"
+)
+
+const (
+	// KnitaIgnoreFile is the name of the ignore file that lists paths to leave out of imports.
+	KnitaIgnoreFile = ".knitaignore"
+	// GitIgnoreFile is the name of Git's ignore file, which may optionally be honoured by imports.
+	GitIgnoreFile = ".gitignore"
+)
+
+// ignorePattern is a pattern parsed from a line of an ignore file.
+type ignorePattern struct {
+	// pattern is a doublestar pattern, relative to the directory containing the ignore file.
+	pattern string
+	// negate re-includes paths that match the pattern.
+	negate bool
+	// dirOnly only matches directories.
+	dirOnly bool
+	// contentsOnly only matches the contents of the directory the pattern ends in, not the directory itself.
+	contentsOnly bool
+	// source identifies the ignore file and line the pattern was parsed from.
+	source string
+}
+
+// ignoreMatcher matches paths against the patterns in ignore files, using .gitignore semantics: an ignore file
+// applies to the directory that contains it and all directories below, patterns in deeper ignore files take
+// precedence over shallower ones, and within an ignore file the last matching pattern wins.
+// Ignore files are read as the directories that contain them are first matched against.
+type ignoreMatcher struct {
+	fs    fs.FS
+	names []string
+	dirs  map[string][]*ignorePattern
+}
+
+func newIgnoreMatcher(fsys fs.FS, names []string) *ignoreMatcher {
+	return &ignoreMatcher{fs: fsys, names: names, dirs: make(map[string][]*ignorePattern)}
+}
+
+// Match returns true if the slash-separated path name is ignored, along with the ignore file and line that
+// ignored it.
+func (m *ignoreMatcher) Match(name string, isDir bool) (bool, string, error) {
+	name = path.Clean(name)
+	if name == "." {
+		return false, "", nil
+	}
+	var dirs []string
+	for dir := path.Dir(name); ; dir = path.Dir(dir) {
+		dirs = append(dirs, dir)
+		if dir == "." || dir == "/" {
+			break
+		}
+	}
+	var ignored bool
+	var ignoredBy string
+	for i := len(dirs) - 1; i >= 0; i-- {
+		patterns, err := m.patterns(dirs[i])
+		if err != nil {
+			return false, "", err
+		}
+		rel := name
+		if dirs[i] != "." {
+			rel = strings.TrimPrefix(name, dirs[i]+"/")
+		}
+		for _, pattern := range patterns {
+			if pattern.match(rel, isDir) {
+				ignored = !pattern.negate
+				ignoredBy = pattern.source
+			}
+		}
+	}
+	if !ignored {
+		return false, "", nil
+	}
+	return true, ignoredBy, nil
+}
+
+// patterns returns the patterns in the ignore files in dir, in order of precedence.
+func (m *ignoreMatcher) patterns(dir string) ([]*ignorePattern, error) {
+	if patterns, ok := m.dirs[dir]; ok {
+		return patterns, nil
+	}
+	var patterns []*ignorePattern
+	for _, name := range m.names {
+		filePath := path.Join(dir, name)
+		data, err := fs.ReadFile(m.fs, filePath)
+		if err != nil {
+			if errors.Is(err, fs.ErrNotExist) {
+				continue
+			}
+			return nil, fmt.Errorf("error reading ignore file %s: %w", filePath, err)
+		}
+		patterns = append(patterns, parseIgnoreFile(filePath, string(data))...)
+	}
+	m.dirs[dir] = patterns
+	return patterns, nil
+}
+
+// parseIgnoreFile parses the patterns in the contents of an ignore file. Invalid patterns are skipped, as Git does.
+func parseIgnoreFile(filePath string, data string) []*ignorePattern {
+	var patterns []*ignorePattern
+	for i, line := range strings.Split(data, "\n") {
+		pattern, ok := parseIgnorePattern(line)
+		if !ok {
+			continue
+		}
+		pattern.source = fmt.Sprintf("%s:%d: %s", filePath, i+1, strings.TrimSpace(line))
+		patterns = append(patterns, pattern)
+	}
+	return patterns
+}
+
+func parseIgnorePattern(line string) (*ignorePattern, bool) {
+	line = strings.TrimSuffix(line, "\r")
+	if line == "" || strings.HasPrefix(line, "#") {
+		return nil, false
+	}
+	// Trailing spaces are ignored, unless they are escaped.
+	trimmed := strings.TrimRight(line, " ")
+	if trimmed != line && strings.HasSuffix(trimmed, "\\") && !strings.HasSuffix(trimmed, "\\\\") {
+		trimmed += " "
+	}
+	line = trimmed
+	pattern := &ignorePattern{}
+	if strings.HasPrefix(line, "!") {
+		pattern.negate = true
+		line = line[1:]
+	}
+	if strings.HasSuffix(line, "/") {
+		pattern.dirOnly = true
+		line = strings.TrimRight(line, "/")
+	}
+	if line == "" {
+		return nil, false
+	}
+	// Patterns containing a slash are relative to the directory containing the ignore file,
+	// while other patterns match at any depth below it.
+	if strings.Contains(line, "/") {
+		line = strings.TrimPrefix(line, "/")
+	} else {
+		line = "**/" + line
+	}
+	if strings.HasSuffix(line, "/**") {
+		pattern.contentsOnly = true
+	}
+	pattern.pattern = escapeAlternation(line)
+	if !doublestar.ValidatePattern(pattern.pattern) {
+		return nil, false
+	}
+	return pattern, true
+}
+
+func (p *ignorePattern) match(rel string, isDir bool) bool {
+	if p.dirOnly && !isDir {
+		return false
+	}
+	match, _ := doublestar.Match(p.pattern, rel)
+	if match && p.contentsOnly {
+		// Unlike in .gitignore, dir/** also matches dir itself in doublestar.
+		self, _ := doublestar.Match(strings.TrimSuffix(p.pattern, "/**"), rel)
+		return !self
+	}
+	return match
+}
+
+// escapeAlternation escapes the braces in pattern, which doublestar treats as alternation but .gitignore does not.
+func escapeAlternation(pattern string) string {
+	var b strings.Builder
+	for i := 0; i < len(pattern); i++ {
+		switch c := pattern[i]; c {
+		case '\\':
+			b.WriteByte(c)
+			if i+1 < len(pattern) {
+				i++
+				b.WriteByte(pattern[i])
+			}
+		case '{', '}':
+			b.WriteByte('\\')
+			b.WriteByte(c)
+		default:
+			b.WriteByte(c)
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,123 @@
+package file
+
+import (
+	"sort"
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestIgnoreMatcher(t *testing.T) {
+	testFS := fstest.MapFS{
+		".gitignore": &fstest.MapFile{Data: []byte("# Build output\n" +
+			"node_modules/\n" +
+			"/dist\n" +
+			"*.log\n" +
+			"!keep.log\n" +
+			"cache/**\n" +
+			"!cache/keep\n" +
+			"trailing\\ \n" +
+			"{braces}\n" +
+			"\\#hash\r\n")},
+		".knitaignore":     &fstest.MapFile{Data: []byte("secrets.env\n")},
+		"web/.gitignore":   &fstest.MapFile{Data: []byte("!debug.log\ngenerated/*.js\n")},
+		"web/.knitaignore": &fstest.MapFile{Data: []byte("debug.log\n")},
+	}
+	var table = []struct {
+		path    string
+		isDir   bool
+		ignored bool
+		by      string
+	}{
+		{path: "node_modules", isDir: true, ignored: true, by: ".gitignore:2: node_modules/"},
+		{path: "web/node_modules", isDir: true, ignored: true},
+		{path: "node_modules", isDir: false, ignored: false},
+		{path: "dist", isDir: true, ignored: true},
+		{path: "web/dist", isDir: true, ignored: false},
+		{path: "app.log", ignored: true},
+		{path: "web/logs/app.log", ignored: true},
+		{path: "keep.log", ignored: false},
+		{path: "cache", isDir: true, ignored: false},
+		{path: "cache/data", ignored: true},
+		{path: "cache/keep", ignored: false},
+		{path: "trailing ", ignored: true},
+		{path: "trailing", ignored: false},
+		{path: "{braces}", ignored: true},
+		{path: "braces", ignored: false},
+		{path: "#hash", ignored: true},
+		{path: "secrets.env", ignored: true, by: ".knitaignore:1: secrets.env"},
+		{path: "web/generated/app.js", ignored: true, by: "web/.gitignore:2: generated/*.js"},
+		{path: "web/generated/nested/app.js", ignored: false},
+		{path: "generated/app.js", ignored: false},
+		// Patterns in the deeper directory take precedence, and .knitaignore is read after .gitignore.
+		{path: "web/debug.log", ignored: true, by: "web/.knitaignore:1: debug.log"},
+		{path: "main.go", ignored: false},
+	}
+	matcher := newIgnoreMatcher(testFS, []string{GitIgnoreFile, KnitaIgnoreFile})
+	for _, test := range table {
+		ignored, by, err := matcher.Match(test.path, test.isDir)
+		require.NoError(t, err)
+		require.Equal(t, test.ignored, ignored, "path: %q, ignored by: %s", test.path, by)
+		if test.by != "" {
+			require.Equal(t, test.by, by)
+		}
+	}
+}
+
+func TestSendWithIgnoreFilesAndIncludes(t *testing.T) {
+	testFS := fstest.MapFS{
+		".gitignore":                 &fstest.MapFile{Data: []byte("node_modules/\ndist/\n")},
+		"main.go":                    &fstest.MapFile{Data: []byte("main")},
+		"README.md":                  &fstest.MapFile{Data: []byte("readme")},
+		"node_modules/dep/index.js":  &fstest.MapFile{Data: []byte("dep")},
+		"dist/app":                   &fstest.MapFile{Data: []byte("app")},
+		"pkg/util/util.go":           &fstest.MapFile{Data: []byte("util")},
+		"pkg/util/testdata/data.txt": &fstest.MapFile{Data: []byte("data")},
+		"docs/guide.md":              &fstest.MapFile{Data: []byte("guide")},
+	}
+	var table = []struct {
+		src  string
+		opts []SendOpt
+		out  []string
+	}{
+		{
+			src:  ".",
+			opts: []SendOpt{WithIgnoreFiles(GitIgnoreFile)},
+			out: []string{".gitignore", "README.md", "docs", "docs/guide.md", "main.go", "pkg", "pkg/util",
+				"pkg/util/testdata", "pkg/util/testdata/data.txt", "pkg/util/util.go"},
+		},
+		{
+			// An ignored src is sent when it is sent explicitly.
+			src:  "dist",
+			opts: []SendOpt{WithIgnoreFiles(GitIgnoreFile)},
+			out:  []string{"dist", "dist/app"},
+		},
+		{
+			src:  ".",
+			opts: []SendOpt{WithIncludes([]string{"**/*.go", "docs"})},
+			out:  []string{"docs", "docs/guide.md", "main.go", "pkg/util/util.go"},
+		},
+		{
+			src: ".",
+			opts: []SendOpt{WithIgnoreFiles(GitIgnoreFile), WithIncludes([]string{"pkg/util", "dist"}),
+				WithExcludes([]string{"pkg/util/testdata"})},
+			out: []string{"pkg/util", "pkg/util/util.go"},
+		},
+	}
+	for _, test := range table {
+		transport := &testSendTransport{}
+		sender := NewSender(zap.NewNop().Sugar(), testFS, transport, "runtime", "transfer", test.opts...)
+		_, err := sender.Send(test.src)
+		require.NoError(t, err)
+		var out []string
+		for _, send := range transport.sends {
+			if send.Header != nil {
+				out = append(out, send.Header.DestPath)
+			}
+		}
+		sort.Strings(out)
+		require.Equal(t, test.out, out)
+	}
+}
@@ -0,0 +1,137 @@
+package file
+
+import (
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"path/filepath"
+	"runtime"
+	"strings"
+
+	"github.com/bmatcuk/doublestar/v4"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	executorv1 "github.com/knita-io/knita/api/executor/v1"
+)
+
+// ManifestRoot returns the path at or below which sending src to dest may write files. It is
+// the path to request a manifest for, prior to an incremental send.
+func ManifestRoot(src string, dest string) string {
+	if dest != "" {
+		return filepath.Clean(dest)
+	}
+	if isGlob, _ := isGlob(src); isGlob {
+		base, _ := doublestar.SplitPattern(src)
+		return filepath.Clean(base)
+	}
+	return filepath.Clean(src)
+}
+
+// maxManifestEntriesPerBatch bounds the number of manifest entries BuildManifest emits at once, keeping
+// responses well within message size limits.
+const maxManifestEntriesPerBatch = 1000
+
+// MaxManifestDigestsPerRequest bounds the number of digests requested from a receiver at once (see
+// ManifestDigestsFunc), keeping requests and responses well within message size limits.
+const MaxManifestDigestsPerRequest = 1000
+
+// ManifestDigestsFunc returns the SHA-256 digests of the files at paths on the receiver, which are manifest entry paths.
+// Paths that are not regular files are omitted.
+type ManifestDigestsFunc func(paths []string) ([]*executorv1.FileDigest, error)
+
+// BuildManifest builds a manifest of the files and directories in fsys at or below root, passing its entries
+// to fn in batches. Other file types (e.g. symlinks) are omitted. Files are not read, so digests must be
+// requested separately where needed (see ManifestDigests). The manifest is empty if root does not exist.
+func BuildManifest(fsys fs.FS, root string, fn func(entries []*executorv1.ManifestEntry) error) error {
+	root = filepath.Clean(root)
+	if _, err := fs.Stat(fsys, root); err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil
+		}
+		return fmt.Errorf("error stating manifest root: %w", err)
+	}
+	var entries []*executorv1.ManifestEntry
+	err := fs.WalkDir(fsys, root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !d.IsDir() && !d.Type().IsRegular() {
+			return nil
+		}
+		info, err := d.Info()
+		if err != nil {
+			return fmt.Errorf("error stating %s: %w", path, err)
+		}
+		entry := &executorv1.ManifestEntry{Path: path, IsDir: d.IsDir(), Mtime: timestamppb.New(info.ModTime())}
+		if !d.IsDir() {
+			entry.Size = uint64(info.Size())
+		}
+		if runtime.GOOS != "windows" {
+			entry.Mode = proto.Uint32(uint32(info.Mode().Perm()))
+		}
+		entries = append(entries, entry)
+		if len(entries) == maxManifestEntriesPerBatch {
+			err := fn(entries)
+			entries = nil
+			return err
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("error building manifest: %w", err)
+	}
+	if len(entries) > 0 {
+		return fn(entries)
+	}
+	return nil
+}
+
+// ManifestDigests returns the SHA-256 digests of the regular files at paths in fsys. Other paths are omitted.
+func ManifestDigests(fsys fs.FS, paths []string) ([]*executorv1.FileDigest, error) {
+	var digests []*executorv1.FileDigest
+	for _, path := range paths {
+		info, err := lstat(fsys, path)
+		if err != nil {
+			if errors.Is(err, fs.ErrNotExist) {
+				continue
+			}
+			return nil, fmt.Errorf("error stating %s: %w", path, err)
+		}
+		if !info.Mode().IsRegular() {
+			continue
+		}
+		digest, err := hashFile(fsys, path)
+		if err != nil {
+			return nil, err
+		}
+		digests = append(digests, &executorv1.FileDigest{Path: path, Size: uint64(info.Size()), Sha256: digest})
+	}
+	return digests, nil
+}
+
+// hashFile returns the SHA-256 digest of a file's data.
+func hashFile(fsys fs.FS, path string) ([]byte, error) {
+	fh, err := fsys.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("error opening file %s: %w", path, err)
+	}
+	defer fh.Close()
+	hash := sha256.New()
+	if _, err := io.Copy(hash, fh); err != nil {
+		return nil, fmt.Errorf("error reading file %s: %w", path, err)
+	}
+	return hash.Sum(nil), nil
+}
+
+// isWithin returns true if path is equal to or below any of dirs.
+func isWithin(path string, dirs []string) bool {
+	for _, dir := range dirs {
+		if path == dir || dir == "." || strings.HasPrefix(path, dir+"/") {
+			return true
+		}
+	}
+	return false
+}
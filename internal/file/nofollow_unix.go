@@ -0,0 +1,8 @@
+//go:build !windows
+
+package file
+
+import "syscall"
+
+// openNoFollowFlags are the flags OpenNoFollow opens files with. O_NONBLOCK prevents opening a FIFO from blocking.
+const openNoFollowFlags = syscall.O_NOFOLLOW | syscall.O_NONBLOCK
@@ -0,0 +1,5 @@
+package file
+
+// openNoFollowFlags are the flags OpenNoFollow opens files with. Windows has no equivalent of O_NOFOLLOW,
+// so OpenNoFollow relies on comparing the opened file with the unfollowed path instead.
+const openNoFollowFlags = 0
@@ -0,0 +1,42 @@
+package file
+
+import (
+	"strconv"
+
+	"google.golang.org/grpc/metadata"
+)
+
+// ProgressFunc is called as a transfer progresses, with the number of files and bytes of file data that have been
+// transferred since it was last called. A file is counted once it has been transferred in full, while its bytes are
+// counted as they are transferred. It may be called concurrently by the Senders or Receivers of concurrent streams.
+type ProgressFunc func(files int, bytes uint64)
+
+const (
+	// TotalFilesHeader and TotalBytesHeader are the gRPC response headers in which an exporter reports the
+	// number and combined size of the files it will export, so the client can report its progress.
+	TotalFilesHeader = "knita-transfer-total-files"
+	TotalBytesHeader = "knita-transfer-total-bytes"
+)
+
+// TotalsHeaderMD returns a response header reporting the number and combined size of the files to be exported.
+func TotalsHeaderMD(files int, bytes uint64) metadata.MD {
+	return metadata.Pairs(TotalFilesHeader, strconv.Itoa(files), TotalBytesHeader, strconv.FormatUint(bytes, 10))
+}
+
+// TotalsFromHeader returns the number and combined size of the files an exporter reported in its response header.
+// Returns false if it did not report them.
+func TotalsFromHeader(header metadata.MD) (int, uint64, bool) {
+	files, bytes := header.Get(TotalFilesHeader), header.Get(TotalBytesHeader)
+	if len(files) == 0 || len(bytes) == 0 {
+		return 0, 0, false
+	}
+	n, err := strconv.Atoi(files[0])
+	if err != nil || n < 0 {
+		return 0, 0, false
+	}
+	size, err := strconv.ParseUint(bytes[0], 10, 64)
+	if err != nil {
+		return 0, 0, false
+	}
+	return n, size, true
+}
@@ -1,11 +1,20 @@
 package file
 
 import (
+	"bytes"
+	"crypto/sha256"
+	"errors"
 	"fmt"
+	"hash"
+	"io"
+	"io/fs"
 	"os"
 	"path/filepath"
+	"time"
 
 	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	executorv1 "github.com/knita-io/knita/api/executor/v1"
 )
@@ -26,7 +35,13 @@ type RecvOpt interface {
 }
 
 type RecvOpts struct {
-	cb RecvCallback
+	cb             RecvCallback
+	blobs          BlobStore
+	progress       ProgressFunc
+	dirModes       *DirModes
+	strictSymlinks bool
+	deletes        bool
+	setid          bool
 }
 
 type withRecvCallback struct {
@@ -41,6 +56,102 @@ func WithRecvCallback(cb RecvCallback) RecvOpt {
 	return &withRecvCallback{cb: cb}
 }
 
+type withBlobStore struct {
+	store BlobStore
+}
+
+func (o *withBlobStore) Apply(opts *RecvOpts) {
+	opts.blobs = o.store
+}
+
+// WithBlobStore materializes files that are sent by reference to blobs from store,
+// and stores the files that are received in full as blobs, so they need not be sent again.
+func WithBlobStore(store BlobStore) RecvOpt {
+	return &withBlobStore{store: store}
+}
+
+type withRecvProgress struct {
+	fn ProgressFunc
+}
+
+func (o *withRecvProgress) Apply(opts *RecvOpts) {
+	opts.progress = o.fn
+}
+
+// WithRecvProgress reports the progress of the receive to fn. Files that are materialized from blobs are
+// reported as received in full.
+func WithRecvProgress(fn ProgressFunc) RecvOpt {
+	return &withRecvProgress{fn: fn}
+}
+
+type withDirModes struct {
+	modes *DirModes
+}
+
+func (o *withDirModes) Apply(opts *RecvOpts) {
+	opts.dirModes = o.modes
+}
+
+// WithDirModes defers applying the modes of directories that deny their owner full access to modes,
+// which the caller applies once the transfer is complete. Without it, such directories are left
+// accessible to their owner.
+func WithDirModes(modes *DirModes) RecvOpt {
+	return &withDirModes{modes: modes}
+}
+
+type withRecvStrictSymlinks struct{}
+
+func (o *withRecvStrictSymlinks) Apply(opts *RecvOpts) {
+	opts.strictSymlinks = true
+}
+
+// WithRecvStrictSymlinks fails the receive of symlinks whose targets are absolute, or resolve outside of the
+// destination root, rather than skipping them with a warning.
+func WithRecvStrictSymlinks() RecvOpt {
+	return &withRecvStrictSymlinks{}
+}
+
+type withRecvDeletes struct{}
+
+func (o *withRecvDeletes) Apply(opts *RecvOpts) {
+	opts.deletes = true
+}
+
+// WithRecvDeletes accepts deletions from the sender (see WithManifest), which remove any path under the
+// destination root. Without it, deletions are refused, so only trusted senders may delete files.
+func WithRecvDeletes() RecvOpt {
+	return &withRecvDeletes{}
+}
+
+type withRecvSetid struct{}
+
+func (o *withRecvSetid) Apply(opts *RecvOpts) {
+	opts.setid = true
+}
+
+// WithRecvSetid preserves the setuid and setgid bits of received files and directories. Without it, they are stripped,
+// so only trusted senders may create files that run with their owner's privileges.
+func WithRecvSetid() RecvOpt {
+	return &withRecvSetid{}
+}
+
+// ChecksumMismatchError is returned when the SHA-256 digest of a received file does not match the
+// digest computed by its sender, indicating the file was corrupted in transit. The corrupt file is removed.
+type ChecksumMismatchError struct {
+	Path     string
+	Expected []byte
+	Actual   []byte
+}
+
+func (e *ChecksumMismatchError) Error() string {
+	return fmt.Sprintf("error checksum mismatch for %s: expected sha256 %x, got %x", e.Path, e.Expected, e.Actual)
+}
+
+// GRPCStatus reports checksum mismatches as data loss when they are returned from gRPC handlers.
+func (e *ChecksumMismatchError) GRPCStatus() *status.Status {
+	return status.New(codes.DataLoss, e.Error())
+}
+
 type Receiver struct {
 	syslog *zap.SugaredLogger
 	opts   *RecvOpts
@@ -48,6 +159,13 @@ type Receiver struct {
 	state  ReceiveState
 	fh     File
 	header *executorv1.FileTransferHeader
+	// hash is the digest of the data written so far, which is valid while the body is received in order.
+	hash   hash.Hash
+	hashed uint64
+	rehash bool
+	digest []byte
+	// skipped is true if the file was skipped, rather than received.
+	skipped bool
 }
 
 func NewReceiver(syslog *zap.SugaredLogger, fs WriteFS, opts ...RecvOpt) *Receiver {
@@ -62,6 +180,15 @@ func (i *Receiver) State() ReceiveState {
 	return i.state
 }
 
+// Digest returns the verified digest of the received file, or nil if the file has not been
+// received yet, is a directory, or was sent without a digest.
+func (i *Receiver) Digest() *executorv1.FileDigest {
+	if i.state != ReceiveStateDone || i.header == nil || i.header.IsDir || i.digest == nil {
+		return nil
+	}
+	return &executorv1.FileDigest{Path: i.header.DestPath, Size: i.header.Size, Sha256: i.digest}
+}
+
 func (i *Receiver) Next(req *executorv1.FileTransfer) (err error) {
 	defer func() {
 		if err != nil {
@@ -78,12 +205,45 @@ func (i *Receiver) Next(req *executorv1.FileTransfer) (err error) {
 			return fmt.Errorf("error header expected")
 		}
 		i.header = req.Header
-		if req.Header.IsDir {
-			mode := os.FileMode(req.Header.Mode)
+		if req.Header.Delete {
+			if !i.opts.deletes {
+				return fmt.Errorf("error refusing to delete %s: deletions are not accepted", req.Header.DestPath)
+			}
+			if filepath.Clean(req.Header.DestPath) == "." {
+				return fmt.Errorf("error refusing to delete the root directory")
+			}
+			err = i.fs.RemoveAll(req.Header.DestPath)
+			if err != nil {
+				return fmt.Errorf("error deleting %s: %w", req.Header.DestPath, err)
+			}
+			i.state = ReceiveStateAwaitingTrailer
+			if req.Trailer != nil {
+				return i.Next(req)
+			}
+		} else if req.Header.Type == executorv1.FileType_FILE_TYPE_SYMLINK {
+			err = i.symlink(req.Header)
+			if err != nil {
+				return err
+			}
+			i.state = ReceiveStateAwaitingTrailer
+			if req.Trailer != nil {
+				return i.Next(req)
+			}
+		} else if req.Header.IsDir {
+			mode := i.mode(req.Header.Mode)
 			err = i.fs.MkdirAll(req.Header.DestPath, mode.Perm())
 			if err != nil {
 				return fmt.Errorf("error making directory: %w", err)
 			}
+			// MkdirAll leaves existing directories as they are, and is subject to the umask.
+			// The owner retains full access until the transfer is complete, so the directory's contents can be received.
+			err = i.fs.Chmod(req.Header.DestPath, mode|0700)
+			if err != nil {
+				return fmt.Errorf("error setting directory mode: %w", err)
+			}
+			if mode&0700 != 0700 && i.opts.dirModes != nil {
+				i.opts.dirModes.add(req.Header.DestPath, mode)
+			}
 			i.state = ReceiveStateAwaitingTrailer
 			if req.Trailer != nil {
 				return i.Next(req)
@@ -94,13 +254,19 @@ func (i *Receiver) Next(req *executorv1.FileTransfer) (err error) {
 			if err != nil {
 				return fmt.Errorf("error making directory: %w", err)
 			}
-			file, err := i.fs.OpenFile(req.Header.DestPath, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, mode.Perm())
+			if req.Header.BlobSha256 != nil {
+				return i.materialize(req)
+			}
+			// The file is opened for reading too, so its digest can be recomputed if the body arrives out of order.
+			file, err := i.fs.OpenFile(req.Header.DestPath, os.O_CREATE|os.O_TRUNC|os.O_RDWR, mode.Perm())
 			if err != nil {
 				return fmt.Errorf("error creating file: %w", err)
 			}
 			i.fh = file
+			i.hash = sha256.New()
 			i.state = ReceiveStateAwaitingBody
-			if req.Body != nil {
+			// Empty files are sent as a header and trailer, without a body.
+			if req.Body != nil || req.Trailer != nil {
 				return i.Next(req)
 			}
 		}
@@ -118,10 +284,25 @@ func (i *Receiver) Next(req *executorv1.FileTransfer) (err error) {
 			if err != nil {
 				return fmt.Errorf("error seeking to offset %d: %w", req.Body.Offset, err)
 			}
-			_, err = i.fh.Write(req.Body.Data)
+			var data []byte
+			data, err = decompress(i.header.Compression, req.Body.Data)
+			if err != nil {
+				i.discard()
+				return err
+			}
+			_, err = i.fh.Write(data)
 			if err != nil {
 				return fmt.Errorf("error writing data: %w", err)
 			}
+			if i.opts.progress != nil {
+				i.opts.progress(0, uint64(len(data)))
+			}
+			if req.Body.Offset == i.hashed {
+				i.hash.Write(data)
+				i.hashed += uint64(len(data))
+			} else {
+				i.rehash = true
+			}
 			if req.Trailer != nil {
 				i.state = ReceiveStateAwaitingTrailer
 				if req.Trailer != nil {
@@ -133,8 +314,10 @@ func (i *Receiver) Next(req *executorv1.FileTransfer) (err error) {
 		if req.Trailer == nil {
 			return fmt.Errorf("error trailer expected")
 		}
-		if req.Trailer.Md5 != nil {
-			i.syslog.Warn("MD5 set but verification not implemented")
+		if !i.header.IsDir && !i.header.Delete && i.header.Type != executorv1.FileType_FILE_TYPE_SYMLINK {
+			if err := i.verify(req.Trailer); err != nil {
+				return err
+			}
 		}
 		i.state = ReceiveStateDone
 		return i.Next(req)
@@ -145,15 +328,165 @@ func (i *Receiver) Next(req *executorv1.FileTransfer) (err error) {
 				return fmt.Errorf("error closing file: %w", err)
 			}
 			i.fh = nil
+			// OpenFile is subject to the umask and ignores the special mode bits of existing files.
+			err = i.fs.Chmod(i.header.DestPath, i.mode(i.header.Mode))
+			if err != nil {
+				return fmt.Errorf("error setting file mode: %w", err)
+			}
+			if i.header.Mtime != nil {
+				// A zero access time leaves it unchanged.
+				err = i.fs.Chtimes(i.header.DestPath, time.Time{}, i.header.Mtime.AsTime())
+				if err != nil {
+					return fmt.Errorf("error setting modification time: %w", err)
+				}
+			}
 		}
-		if i.header.IsDir {
+		if i.opts.blobs != nil && i.header.BlobSha256 == nil && i.digest != nil && i.header.Size >= MinBlobSize {
+			err := i.opts.blobs.Put(i.digest, i.fs, i.header.DestPath)
+			if err != nil {
+				i.syslog.Warnw("Failed to store blob", "path", i.header.DestPath, "error", err)
+			}
+		}
+		if i.header.Delete {
+			i.syslog.Infow("Deleted path", "path", i.header.DestPath)
+		} else if i.header.IsDir {
 			i.syslog.Infow("Received directory", "path", i.header.DestPath)
+		} else if i.skipped {
+			// The skip was logged as a warning, but it still counts towards the transfer's progress.
+		} else if i.header.Type == executorv1.FileType_FILE_TYPE_SYMLINK {
+			i.syslog.Infow("Received symlink", "path", i.header.DestPath, "target", i.header.LinkTarget)
 		} else {
 			i.syslog.Infow("Received file", "path", i.header.DestPath, "size", i.header.Size)
 		}
-		if i.opts.cb != nil {
+		if i.opts.progress != nil && !i.header.Delete && !i.header.IsDir {
+			var materialized uint64
+			if i.header.BlobSha256 != nil {
+				materialized = i.header.Size
+			}
+			i.opts.progress(1, materialized)
+		}
+		if i.opts.cb != nil && !i.skipped {
 			i.opts.cb(i.header)
 		}
 	}
 	return nil
 }
+
+// verify verifies the received file against the digest in the sender's trailer.
+// The file is removed if verification fails.
+func (i *Receiver) verify(trailer *executorv1.FileTransferTrailer) error {
+	if trailer.Sha256 == nil {
+		i.syslog.Warnw("File sent without a digest; unable to verify", "path", i.header.DestPath)
+		return nil
+	}
+	if i.rehash {
+		if err := i.rehashFile(); err != nil {
+			return err
+		}
+	}
+	actual := i.hash.Sum(nil)
+	if bytes.Equal(actual, trailer.Sha256) {
+		i.digest = actual
+		return nil
+	}
+	i.discard()
+	mismatch := &ChecksumMismatchError{Path: i.header.DestPath, Expected: trailer.Sha256, Actual: actual}
+	if i.header.BlobSha256 != nil {
+		// The blob was corrupted after it was stored e.g. it was modified in place through a hard link.
+		i.opts.blobs.Evict(i.header.BlobSha256)
+		return &BlobError{Path: i.header.DestPath, Digest: i.header.BlobSha256, Err: mismatch}
+	}
+	return mismatch
+}
+
+// discard closes and removes a corrupt file.
+func (i *Receiver) discard() {
+	i.fh.Close()
+	i.fh = nil
+	if err := i.fs.Remove(i.header.DestPath); err != nil {
+		i.syslog.Warnw("Failed to remove corrupt file", "path", i.header.DestPath, "error", err)
+	}
+}
+
+// materialize materializes a file that was sent by reference to a blob from the blob store.
+// The materialized file is then verified in the same way as a file that was received in full.
+// mode returns the mode to apply to a received file or directory, given the mode in its header.
+func (i *Receiver) mode(headerMode uint32) os.FileMode {
+	mode := os.FileMode(headerMode) & specialModeMask
+	if !i.opts.setid {
+		mode &^= setidModeMask
+	}
+	return mode
+}
+
+func (i *Receiver) materialize(req *executorv1.FileTransfer) error {
+	header := req.Header
+	if i.opts.blobs == nil {
+		return fmt.Errorf("error %s was sent by reference to a blob, but there is no blob store", header.DestPath)
+	}
+	err := i.opts.blobs.Materialize(header.BlobSha256, i.fs, header.DestPath, i.mode(header.Mode))
+	if err != nil {
+		return &BlobError{Path: header.DestPath, Digest: header.BlobSha256, Err: err}
+	}
+	file, err := i.fs.OpenFile(header.DestPath, os.O_RDONLY, 0)
+	if err != nil {
+		return fmt.Errorf("error opening file: %w", err)
+	}
+	i.fh = file
+	i.hash = sha256.New()
+	i.rehash = true
+	i.state = ReceiveStateAwaitingTrailer
+	if req.Trailer != nil {
+		return i.Next(req)
+	}
+	return nil
+}
+
+// symlink creates the symlink described by header, replacing any existing file.
+// Symlinks whose targets are absolute, or resolve outside of the destination root, are skipped with a warning,
+// or refused if WithRecvStrictSymlinks is set.
+func (i *Receiver) symlink(header *executorv1.FileTransferHeader) error {
+	target := header.LinkTarget
+	if target == "" {
+		return fmt.Errorf("error symlink %s has no target", header.DestPath)
+	}
+	if SymlinkEscapes(header.DestPath, target) {
+		if i.opts.strictSymlinks {
+			return fmt.Errorf("error symlink %s -> %s escapes the destination root", header.DestPath, target)
+		}
+		i.skipped = true
+		i.syslog.Warnw("Skipped symlink that escapes the destination root", "path", header.DestPath, "target", target)
+		return nil
+	}
+	err := i.fs.MkdirAll(filepath.Dir(header.DestPath), 0777)
+	if err != nil {
+		return fmt.Errorf("error making directory: %w", err)
+	}
+	err = i.fs.Remove(header.DestPath)
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("error removing existing file: %w", err)
+	}
+	err = i.fs.Symlink(target, header.DestPath)
+	if err != nil {
+		return fmt.Errorf("error creating symlink: %w", err)
+	}
+	return nil
+}
+
+// SymlinkEscapes returns true if the target of the symlink at path is absolute,
+// or resolves outside of the root that path is relative to.
+func SymlinkEscapes(path string, target string) bool {
+	return filepath.IsAbs(target) || !filepath.IsLocal(filepath.Join(filepath.Dir(path), target))
+}
+
+// rehashFile recomputes the digest of the received file from disk.
+func (i *Receiver) rehashFile() error {
+	if _, err := i.fh.Seek(0, io.SeekStart); err != nil {
+		return fmt.Errorf("error seeking to start of file: %w", err)
+	}
+	i.hash = sha256.New()
+	if _, err := io.Copy(i.hash, i.fh); err != nil {
+		return fmt.Errorf("error reading file: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,288 @@
+package file
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+	"testing/fstest"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/knita-io/knita/api/executor/v1"
+)
+
+func TestReceiveVerifiesChecksum(t *testing.T) {
+	testFS := fstest.MapFS{
+		"empty.txt": &fstest.MapFile{Data: []byte{}},
+		"small.txt": &fstest.MapFile{Data: []byte("hello")},
+		"large.bin": &fstest.MapFile{Data: make([]byte, chunkSize*2+1)},
+	}
+
+	var table = []struct {
+		name    string
+		corrupt func(sends []*v1.FileTransfer)
+		err     bool
+	}{
+		{
+			name:    "intact",
+			corrupt: func(sends []*v1.FileTransfer) {},
+		},
+		{
+			name: "out of order",
+			corrupt: func(sends []*v1.FileTransfer) {
+				// Swap the bodies of the first two parts; the file's contents are unchanged.
+				sends[0].Body, sends[1].Body = sends[1].Body, sends[0].Body
+			},
+		},
+		{
+			name: "corrupt body",
+			corrupt: func(sends []*v1.FileTransfer) {
+				sends[1].Body.Data[0] ^= 0xff
+			},
+			err: true,
+		},
+		{
+			name: "corrupt digest",
+			corrupt: func(sends []*v1.FileTransfer) {
+				sends[len(sends)-1].Trailer.Sha256[0] ^= 0xff
+			},
+			err: true,
+		},
+	}
+
+	for _, test := range table {
+		t.Run(test.name, func(t *testing.T) {
+			for _, src := range []string{"empty.txt", "small.txt", "large.bin"} {
+				transport := &testSendTransport{}
+				sender := NewSender(zap.NewNop().Sugar(), testFS, transport, "runtime", "transfer")
+				_, err := sender.Send(src)
+				require.NoError(t, err)
+				if len(transport.sends) > 1 {
+					test.corrupt(transport.sends)
+				} else if test.err {
+					// Files sent in a single part can only have their digest corrupted.
+					transport.sends[0].Trailer.Sha256[0] ^= 0xff
+				}
+
+				dir := t.TempDir()
+				receiver := NewReceiver(zap.NewNop().Sugar(), WriteDirFS(dir))
+				for _, send := range transport.sends {
+					err = receiver.Next(send)
+					if err != nil {
+						break
+					}
+				}
+				if test.err {
+					var mismatch *ChecksumMismatchError
+					require.True(t, errors.As(err, &mismatch), "expected checksum mismatch for %s, got: %v", src, err)
+					require.Equal(t, codes.DataLoss, status.Code(err))
+					_, err = os.Stat(filepath.Join(dir, src))
+					require.True(t, os.IsNotExist(err), "expected corrupt file %s to be removed", src)
+					continue
+				}
+				require.NoError(t, err)
+				require.Equal(t, ReceiveStateDone, receiver.State())
+				digest := receiver.Digest()
+				require.NotNil(t, digest)
+				require.Equal(t, src, digest.Path)
+				data, err := os.ReadFile(filepath.Join(dir, src))
+				require.NoError(t, err)
+				require.Equal(t, testFS[src].Data, data)
+			}
+		})
+	}
+}
+
+func TestReceiveCompressed(t *testing.T) {
+	testFS := fstest.MapFS{
+		"empty.txt":   &fstest.MapFile{Data: []byte{}},
+		"large.txt":   &fstest.MapFile{Data: bytes.Repeat([]byte("knita "), chunkSize)},
+		"archive.tgz": &fstest.MapFile{Data: bytes.Repeat([]byte("knita "), 1024)},
+	}
+
+	var table = []struct {
+		src         string
+		compression v1.Compression
+	}{
+		{src: "empty.txt", compression: v1.Compression_COMPRESSION_ZSTD},
+		{src: "large.txt", compression: v1.Compression_COMPRESSION_ZSTD},
+		{src: "archive.tgz", compression: v1.Compression_COMPRESSION_NONE},
+	}
+
+	for _, test := range table {
+		t.Run(test.src, func(t *testing.T) {
+			transport := &testSendTransport{}
+			compression := NegotiateCompression([]v1.Compression{v1.Compression_COMPRESSION_ZSTD})
+			sender := NewSender(zap.NewNop().Sugar(), testFS, transport, "runtime", "transfer", WithCompression(compression))
+			_, err := sender.Send(test.src)
+			require.NoError(t, err)
+			require.Equal(t, test.compression, transport.sends[0].Header.Compression)
+			sent := 0
+			for _, send := range transport.sends {
+				sent += len(send.GetBody().GetData())
+			}
+			if test.compression != v1.Compression_COMPRESSION_NONE && sent > 0 {
+				require.Less(t, sent, len(testFS[test.src].Data))
+			}
+
+			dir := t.TempDir()
+			receiver := NewReceiver(zap.NewNop().Sugar(), WriteDirFS(dir))
+			for _, send := range transport.sends {
+				require.NoError(t, receiver.Next(send))
+			}
+			require.Equal(t, ReceiveStateDone, receiver.State())
+			data, err := os.ReadFile(filepath.Join(dir, test.src))
+			require.NoError(t, err)
+			require.Equal(t, testFS[test.src].Data, data)
+		})
+	}
+}
+
+func TestReceiveSymlinksAndModes(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("symlinks and special mode bits are not supported on Windows")
+	}
+	src := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(src, "dir"), 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(src, "dir/tool"), []byte("tool"), 0755))
+	require.NoError(t, os.Chmod(filepath.Join(src, "dir/tool"), 0755|os.ModeSetgid))
+	require.NoError(t, os.Chmod(filepath.Join(src, "dir"), 0755|os.ModeSticky))
+	mtime := time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)
+	require.NoError(t, os.Chtimes(filepath.Join(src, "dir/tool"), time.Time{}, mtime))
+	require.NoError(t, os.Symlink("tool", filepath.Join(src, "dir/link")))
+	require.NoError(t, os.Symlink("../dir", filepath.Join(src, "dir/parent")))
+	// Directories that deny their owner access are only restricted once their contents have been received.
+	require.NoError(t, os.MkdirAll(filepath.Join(src, "dir/ro/sub"), 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(src, "dir/ro/sub/file"), []byte("file"), 0644))
+	require.NoError(t, os.Chmod(filepath.Join(src, "dir/ro/sub"), 0500))
+	require.NoError(t, os.Chmod(filepath.Join(src, "dir/ro"), 0555))
+	t.Cleanup(func() {
+		os.Chmod(filepath.Join(src, "dir/ro"), 0755)
+		os.Chmod(filepath.Join(src, "dir/ro/sub"), 0755)
+	})
+
+	transport := &testSendTransport{}
+	sender := NewSender(zap.NewNop().Sugar(), WriteDirFS(src).ReadFS(), transport, "runtime", "transfer")
+	_, err := sender.Send("dir")
+	require.NoError(t, err)
+
+	dest := t.TempDir()
+	dirModes := NewDirModes()
+	receive(t, dest, transport.sends, WithDirModes(dirModes), WithRecvSetid())
+	t.Cleanup(func() {
+		os.Chmod(filepath.Join(dest, "dir/ro"), 0755)
+		os.Chmod(filepath.Join(dest, "dir/ro/sub"), 0755)
+	})
+	require.NoError(t, dirModes.Apply(WriteDirFS(dest)))
+	data, err := os.ReadFile(filepath.Join(dest, "dir/ro/sub/file"))
+	require.NoError(t, err)
+	require.Equal(t, "file", string(data))
+	for dir, mode := range map[string]os.FileMode{"dir/ro": 0555, "dir/ro/sub": 0500} {
+		info, err := os.Stat(filepath.Join(dest, dir))
+		require.NoError(t, err)
+		require.Equal(t, mode, info.Mode().Perm(), dir)
+	}
+
+	info, err := os.Stat(filepath.Join(dest, "dir/tool"))
+	require.NoError(t, err)
+	require.Equal(t, 0755|os.ModeSetgid, info.Mode()&specialModeMask)
+	require.True(t, info.ModTime().Equal(mtime))
+	info, err = os.Stat(filepath.Join(dest, "dir"))
+	require.NoError(t, err)
+	require.Equal(t, 0755|os.ModeSticky, info.Mode()&specialModeMask)
+	// Setuid and setgid bits are stripped by receivers that do not opt in to them.
+	stripped := t.TempDir()
+	receive(t, stripped, transport.sends)
+	info, err = os.Stat(filepath.Join(stripped, "dir/tool"))
+	require.NoError(t, err)
+	require.Equal(t, os.FileMode(0755), info.Mode()&specialModeMask)
+	for link, target := range map[string]string{"dir/link": "tool", "dir/parent": "../dir"} {
+		actual, err := os.Readlink(filepath.Join(dest, link))
+		require.NoError(t, err)
+		require.Equal(t, target, actual)
+	}
+
+	// Symlinks that escape the destination root are skipped, or refused if strict.
+	for _, target := range []string{"../../etc/passwd", "/etc/passwd"} {
+		escape := &v1.FileTransfer{
+			Header:  &v1.FileTransferHeader{Type: v1.FileType_FILE_TYPE_SYMLINK, DestPath: "dir/escape", LinkTarget: target},
+			Trailer: &v1.FileTransferTrailer{},
+		}
+		require.NoError(t, NewReceiver(zap.NewNop().Sugar(), WriteDirFS(dest)).Next(escape))
+		_, err = os.Lstat(filepath.Join(dest, "dir/escape"))
+		require.True(t, os.IsNotExist(err))
+		err = NewReceiver(zap.NewNop().Sugar(), WriteDirFS(dest), WithRecvStrictSymlinks()).Next(escape)
+		require.ErrorContains(t, err, "escapes the destination root")
+		_, err = os.Lstat(filepath.Join(dest, "dir/escape"))
+		require.True(t, os.IsNotExist(err))
+	}
+	require.NoError(t, os.Symlink("/usr/bin/env", filepath.Join(src, "dir/env")))
+	sender = NewSender(zap.NewNop().Sugar(), WriteDirFS(src).ReadFS(), &testSendTransport{}, "runtime", "transfer", WithStrictSymlinks())
+	_, err = sender.Send("dir")
+	require.ErrorContains(t, err, "escapes the destination root")
+}
+
+func receive(t *testing.T, dir string, sends []*v1.FileTransfer, opts ...RecvOpt) {
+	receivers := make(map[string]*Receiver)
+	for _, send := range sends {
+		receiver, ok := receivers[send.FileId]
+		if !ok {
+			receiver = NewReceiver(zap.NewNop().Sugar(), WriteDirFS(dir), opts...)
+			receivers[send.FileId] = receiver
+		}
+		require.NoError(t, receiver.Next(send))
+	}
+}
+
+func FuzzSendReceive(f *testing.F) {
+	f.Add([]byte(""), false, uint16(0))
+	f.Add([]byte("hello"), false, uint16(1))
+	f.Add(bytes.Repeat([]byte("knita "), 100), true, uint16(0))
+	f.Add(bytes.Repeat([]byte("knita "), 100), true, uint16(7))
+
+	f.Fuzz(func(t *testing.T, data []byte, compress bool, corrupt uint16) {
+		testFS := fstest.MapFS{"file": &fstest.MapFile{Data: data, Mode: 0644}}
+		var opts []SendOpt
+		if compress {
+			opts = append(opts, WithCompression(v1.Compression_COMPRESSION_ZSTD))
+		}
+		transport := &testSendTransport{}
+		sender := NewSender(zap.NewNop().Sugar(), testFS, transport, "runtime", "transfer", opts...)
+		_, err := sender.Send("file")
+		require.NoError(t, err)
+		corrupted := false
+		if corrupt > 0 && len(transport.sends[0].GetBody().GetData()) > 0 {
+			body := transport.sends[0].Body.Data
+			body[int(corrupt)%len(body)] ^= byte(corrupt>>8) | 1
+			corrupted = true
+		}
+
+		dir := t.TempDir()
+		receiver := NewReceiver(zap.NewNop().Sugar(), WriteDirFS(dir))
+		for _, send := range transport.sends {
+			err = receiver.Next(send)
+			if err != nil {
+				break
+			}
+		}
+		if !corrupted {
+			require.NoError(t, err)
+		}
+		// Corrupt data may still decompress to the original data, but is otherwise always detected.
+		if err == nil {
+			received, err := os.ReadFile(filepath.Join(dir, "file"))
+			require.NoError(t, err)
+			require.Equal(t, data, received)
+		} else {
+			_, err = os.Stat(filepath.Join(dir, "file"))
+			require.True(t, os.IsNotExist(err), "expected corrupt file to be removed")
+		}
+	})
+}
@@ -1,15 +1,20 @@
 package file
 
 import (
+	"bytes"
+	"crypto/sha256"
 	"fmt"
 	"io/fs"
 	"os"
 	"path/filepath"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/bmatcuk/doublestar/v4"
 	"github.com/google/uuid"
 	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	executorv1 "github.com/knita-io/knita/api/executor/v1"
 )
@@ -25,10 +30,19 @@ type SendOpt interface {
 }
 
 type SendOpts struct {
-	sendCallback SendCallback
-	skipCallback SkipCallback
-	excludes     []string
-	dest         string
+	sendCallback     SendCallback
+	skipCallback     SkipCallback
+	excludes         []string
+	includes         []string
+	ignoreFiles      []string
+	dest             string
+	compression      executorv1.Compression
+	manifest         []*executorv1.ManifestEntry
+	manifestDigests  ManifestDigestsFunc
+	deleteExtraneous bool
+	haveBlobs        HaveBlobsFunc
+	progress         ProgressFunc
+	strictSymlinks   bool
 }
 
 type withSendCallback struct {
@@ -67,6 +81,36 @@ func WithExcludes(excludes []string) SendOpt {
 	return &withExcludes{excludes: excludes}
 }
 
+type withIncludes struct {
+	includes []string
+}
+
+func (o *withIncludes) Apply(opts *SendOpts) {
+	opts.includes = append(opts.includes, o.includes...)
+}
+
+// WithIncludes only sends the files and directories that match, or are within a directory that matches,
+// one of includes. Includes are matched like excludes, and excludes take precedence over them.
+// Paths that are not included are skipped without calling the skip callback, and are never deleted.
+func WithIncludes(includes []string) SendOpt {
+	return &withIncludes{includes: includes}
+}
+
+type withIgnoreFiles struct {
+	names []string
+}
+
+func (o *withIgnoreFiles) Apply(opts *SendOpts) {
+	opts.ignoreFiles = append(opts.ignoreFiles, o.names...)
+}
+
+// WithIgnoreFiles skips the files and directories that are ignored by the ignore files with the given names
+// (e.g. GitIgnoreFile), which are read from the directories being sent and interpreted with .gitignore semantics.
+// The src being sent is never ignored itself, only its contents are. Ignored paths are never deleted.
+func WithIgnoreFiles(names ...string) SendOpt {
+	return &withIgnoreFiles{names: names}
+}
+
 type withDest struct {
 	dest string
 }
@@ -79,11 +123,122 @@ func WithDest(dest string) SendOpt {
 	return &withDest{dest: dest}
 }
 
+type withCompression struct {
+	compression executorv1.Compression
+}
+
+func (o *withCompression) Apply(opts *SendOpts) {
+	opts.compression = o.compression
+}
+
+// WithCompression compresses the body chunks of sent files with the given codec, which must have been
+// negotiated with the receiver (see NegotiateCompression). Files of already compressed types are sent uncompressed.
+func WithCompression(compression executorv1.Compression) SendOpt {
+	return &withCompression{compression: compression}
+}
+
+type withManifest struct {
+	entries          []*executorv1.ManifestEntry
+	deleteExtraneous bool
+}
+
+func (o *withManifest) Apply(opts *SendOpts) {
+	opts.manifest = o.entries
+	opts.deleteExtraneous = o.deleteExtraneous
+}
+
+// WithManifest skips sending files that the receiver already holds, as reported by its manifest
+// (see BuildManifest). Files with the same size, mode and modification time are assumed to be unchanged.
+// If deleteExtraneous is true, entries in the manifest that were not sent, within the directories that were
+// sent, are deleted from the receiver, rsync-style. Excluded paths are never deleted.
+func WithManifest(entries []*executorv1.ManifestEntry, deleteExtraneous bool) SendOpt {
+	return &withManifest{entries: entries, deleteExtraneous: deleteExtraneous}
+}
+
+type withManifestDigests struct {
+	fn ManifestDigestsFunc
+}
+
+func (o *withManifestDigests) Apply(opts *SendOpts) {
+	opts.manifestDigests = o.fn
+}
+
+// WithManifestDigests compares the digests of files whose manifest entries (see WithManifest) match in all but
+// modification time, rather than sending them. Before sending, fn is called to request the receiver's digests.
+func WithManifestDigests(fn ManifestDigestsFunc) SendOpt {
+	return &withManifestDigests{fn: fn}
+}
+
+type withBlobs struct {
+	have HaveBlobsFunc
+}
+
+func (o *withBlobs) Apply(opts *SendOpts) {
+	opts.haveBlobs = o.have
+}
+
+// WithBlobs sends files by reference to blobs the receiver already holds in its blob store, rather than
+// sending their data. Before sending, the digests of all files of at least MinBlobSize are computed and
+// have is called to determine which blobs the receiver holds.
+func WithBlobs(have HaveBlobsFunc) SendOpt {
+	return &withBlobs{have: have}
+}
+
+type withProgress struct {
+	fn ProgressFunc
+}
+
+func (o *withProgress) Apply(opts *SendOpts) {
+	opts.progress = o.fn
+}
+
+// WithProgress reports the progress of the send to fn. Files that are skipped as unchanged, or sent by reference
+// to blobs, are reported as transferred in full. Use Count to determine the totals to report progress against.
+func WithProgress(fn ProgressFunc) SendOpt {
+	return &withProgress{fn: fn}
+}
+
+type withStrictSymlinks struct{}
+
+func (o *withStrictSymlinks) Apply(opts *SendOpts) {
+	opts.strictSymlinks = true
+}
+
+// WithStrictSymlinks fails the send if it encounters a symlink whose target is absolute, or resolves outside
+// of the destination root. By default, such symlinks are sent, and receivers skip them with a warning.
+func WithStrictSymlinks() SendOpt {
+	return &withStrictSymlinks{}
+}
+
 type SendTransport interface {
 	Send(*executorv1.FileTransfer) error
 }
 
-type SendResult struct{}
+type SendResult struct {
+	// SkippedFiles is the number of files that were not sent because the receiver's manifest showed them unchanged.
+	SkippedFiles int
+	SkippedBytes uint64
+	// DeletedFiles is the number of extraneous files and directories that were deleted from the receiver.
+	DeletedFiles int
+	// BlobFiles is the number of files that were sent by reference to blobs the receiver already held.
+	BlobFiles int
+	BlobBytes uint64
+}
+
+type trackKind int
+
+const (
+	trackSent trackKind = iota
+	trackSentDir
+	trackExcluded
+)
+
+// sendEntry is a file or directory to send, found by walking src.
+type sendEntry struct {
+	isDir bool
+	src   string
+	dest  string
+}
 
 type Sender struct {
 	syslog     *zap.SugaredLogger
@@ -92,6 +247,30 @@ type Sender struct {
 	transport  SendTransport
 	runtimeID  string
 	transferID string
+	manifest   map[string]*executorv1.ManifestEntry
+	// entries are the files and directories to send, found by walking walkedSrc (see walk).
+	entries   []sendEntry
+	walked    bool
+	walkedSrc string
+	// mu guards the state that is updated as files are sent concurrently (see SendStreams).
+	mu     sync.Mutex
+	result *SendResult
+	// sent and sentDirs are the dest paths that were sent (or skipped as unchanged), and excluded are
+	// the dest paths that were excluded. Only tracked when deleting extraneous files.
+	sent     map[string]struct{}
+	sentDirs map[string]struct{}
+	excluded []string
+	// digests caches the digests of files by src path, so each file is read to compute its digest at most once.
+	// remoteDigestPaths are the manifest paths whose digests to request, remoteDigests are the receiver's
+	// digests by dest path, and blobs are the digests of the blobs the receiver holds (see plan).
+	digests           map[string]*fileDigest
+	remoteDigestPaths []string
+	remoteDigests     map[string][]byte
+	blobs             map[string]struct{}
+	// ignore matches paths against the Sender's ignore files, and walkRoot is the src path currently being walked,
+	// which is exempt from them.
+	ignore   *ignoreMatcher
+	walkRoot string
 }
 
 func NewSender(syslog *zap.SugaredLogger, fs fs.FS, transport SendTransport, runtimeID string, transferID string, opts ...SendOpt) *Sender {
@@ -99,43 +278,148 @@ func NewSender(syslog *zap.SugaredLogger, fs fs.FS, transport SendTransport, run
 	for _, opt := range opts {
 		opt.Apply(o)
 	}
+	manifest := make(map[string]*executorv1.ManifestEntry, len(o.manifest))
+	for _, entry := range o.manifest {
+		manifest[filepath.Clean(entry.Path)] = entry
+	}
 	return &Sender{
-		syslog:     syslog.Named("file_sender"),
-		fs:         fs,
-		transport:  transport,
-		runtimeID:  runtimeID,
-		transferID: transferID,
-		opts:       o,
+		syslog:        syslog.Named("file_sender"),
+		fs:            fs,
+		transport:     transport,
+		runtimeID:     runtimeID,
+		transferID:    transferID,
+		opts:          o,
+		result:        &SendResult{},
+		manifest:      manifest,
+		sent:          make(map[string]struct{}),
+		sentDirs:      make(map[string]struct{}),
+		digests:       make(map[string]*fileDigest),
+		remoteDigests: make(map[string][]byte),
+		blobs:         make(map[string]struct{}),
+		ignore:        newIgnoreMatcher(fs, o.ignoreFiles),
 	}
 }
 
+// Send sends src over the Sender's transport.
 func (s *Sender) Send(src string) (*SendResult, error) {
+	return s.SendStreams(src, s.transport)
+}
+
+// SendStreams sends src over transports concurrently. src is walked once, and each file is sent in full over
+// whichever transport is free next. Directories and deletions are sent over the first transport.
+// The Sender's own transport is not used, so it may be nil.
+func (s *Sender) SendStreams(src string, transports ...SendTransport) (*SendResult, error) {
+	if len(transports) == 0 {
+		return nil, fmt.Errorf("error no transports to send over")
+	}
+	err := s.walk(src)
+	if err != nil {
+		return nil, err
+	}
+	if s.opts.haveBlobs != nil || (s.opts.manifestDigests != nil && len(s.manifest) > 0) {
+		err = s.plan()
+		if err != nil {
+			return nil, err
+		}
+	}
+	for _, entry := range s.entries {
+		if entry.isDir {
+			err = s.sendDirectory(transports[0], entry.src, entry.dest)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	err = s.sendFiles(transports)
+	if err != nil {
+		return nil, err
+	}
+	if s.opts.deleteExtraneous {
+		err = s.deleteExtraneous(transports[0])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return s.result, nil
+}
+
+// Count returns the number and combined size of the files that sending src would consider, including any
+// that would be skipped as unchanged, without sending anything. Used to choose the number of streams to
+// split a transfer across (see DefaultStreams), and to report its progress (see WithProgress).
+// Symlinks are counted as files without any data. The files found are sent by a subsequent Send of src,
+// without walking it again.
+func (s *Sender) Count(src string) (int, uint64, error) {
+	err := s.walk(src)
+	if err != nil {
+		return 0, 0, err
+	}
+	var files int
+	var size uint64
+	for _, entry := range s.entries {
+		if entry.isDir {
+			continue
+		}
+		info, err := lstat(s.fs, entry.src)
+		if err != nil {
+			return 0, 0, fmt.Errorf("error stating file %s: %w", entry.src, err)
+		}
+		files++
+		if info.Mode().IsRegular() {
+			size += uint64(info.Size())
+		}
+	}
+	return files, size, nil
+}
+
+// walk finds the files and directories to send by walking src, unless it has already been walked.
+func (s *Sender) walk(src string) error {
+	if s.walked {
+		if s.walkedSrc != src {
+			return fmt.Errorf("error sender already walked %s", s.walkedSrc)
+		}
+		return nil
+	}
+	s.entries = nil
+	err := s.walkSrc(src)
+	if err != nil {
+		return err
+	}
+	s.walked, s.walkedSrc = true, src
+	return nil
+}
+
+func (s *Sender) walkSrc(src string) error {
 	dest := s.opts.dest
 	if filepath.IsAbs(src) {
-		return nil, fmt.Errorf("error src must be relative")
+		return fmt.Errorf("error src must be relative")
 	}
 	if filepath.IsAbs(dest) {
-		return nil, fmt.Errorf("error dest must be relative")
+		return fmt.Errorf("error dest must be relative")
 	}
 	for _, exclude := range s.opts.excludes {
 		if !doublestar.ValidatePattern(exclude) {
-			return nil, fmt.Errorf("error invalid exclude pattern: %s", exclude)
+			return fmt.Errorf("error invalid exclude pattern: %s", exclude)
+		}
+	}
+	for _, include := range s.opts.includes {
+		if !doublestar.ValidatePattern(include) {
+			return fmt.Errorf("error invalid include pattern: %s", include)
 		}
 	}
 
 	var isFile, isDir, isDirContents bool
 	isGlob, err := isGlob(src)
 	if err != nil {
-		return nil, fmt.Errorf("error detecting glob in %q: %w", src, err)
+		return fmt.Errorf("error detecting glob in %q: %w", src, err)
 	}
 
 	if !isGlob {
 		info, err := fs.Stat(s.fs, strings.TrimSuffix(src, "/"))
 		if err != nil {
 			if os.IsNotExist(err) {
-				return nil, fmt.Errorf("error src %s does not exist", src)
+				return fmt.Errorf("error src %s does not exist", src)
 			} else {
-				return nil, fmt.Errorf("error stating src: %w", err)
+				return fmt.Errorf("error stating src: %w", err)
 			}
 		}
 		if strings.HasSuffix(src, "/") || src == "." {
@@ -158,13 +442,15 @@ func (s *Sender) Send(src string) (*SendResult, error) {
 		} else {
 			finalDest = dest
 		}
-		return &SendResult{}, s.filteredSend(false, src, finalDest)
+		s.walkRoot = src
+		return s.filteredSend(false, src, finalDest)
 	}
 
 	// If src is a single directory then
 	//	Copy recursively to dest, where dir(src) is substituted for dest in the final dest paths
 	if isDir {
-		return &SendResult{}, fs.WalkDir(s.fs, src, func(path string, d fs.DirEntry, err error) error {
+		s.walkRoot = src
+		return fs.WalkDir(s.fs, src, func(path string, d fs.DirEntry, err error) error {
 			if err != nil {
 				return err
 			}
@@ -183,7 +469,9 @@ func (s *Sender) Send(src string) (*SendResult, error) {
 	// If src is directory contents e.g. dir/ then
 	//  Recurse through everything in the directory and copy to dest/ (dest must be a folder).
 	if isDirContents {
-		return &SendResult{}, fs.WalkDir(s.fs, strings.TrimSuffix(src, "/"), func(path string, d fs.DirEntry, err error) error {
+		s.track(trackSentDir, filepath.Clean(dest))
+		s.walkRoot = strings.TrimSuffix(src, "/")
+		return fs.WalkDir(s.fs, strings.TrimSuffix(src, "/"), func(path string, d fs.DirEntry, err error) error {
 			if err != nil {
 				return err
 			}
@@ -210,13 +498,14 @@ func (s *Sender) Send(src string) (*SendResult, error) {
 	if isGlob {
 		matches, err := doublestar.Glob(s.fs, src, doublestar.WithFailOnIOErrors())
 		if err != nil {
-			return nil, fmt.Errorf("error expanding glob: %w", err)
+			return fmt.Errorf("error expanding glob: %w", err)
 		}
 		if len(matches) == 0 {
-			return nil, fmt.Errorf("error no matches")
+			return fmt.Errorf("error no matches")
 		}
 		for _, match := range matches {
 			matchDir := filepath.Dir(match)
+			s.walkRoot = strings.TrimSuffix(match, "/")
 			err = fs.WalkDir(s.fs, strings.TrimSuffix(match, "/"), func(path string, d fs.DirEntry, err error) error {
 				if err != nil {
 					return err
@@ -237,18 +526,26 @@ func (s *Sender) Send(src string) (*SendResult, error) {
 				return s.filteredSend(d.IsDir(), path, finalDest)
 			})
 			if err != nil {
-				return nil, err
+				return err
 			}
 		}
-		return nil, nil
+		return nil
 	}
 
-	return nil, fmt.Errorf("error unable to parse src %q", src)
+	return fmt.Errorf("error unable to parse src %q", src)
 }
 
 func (s *Sender) filteredSend(isDir bool, src string, dest string) error {
 	isExcluded, excludedBy := s.isExcluded(src)
+	if !isExcluded && src != s.walkRoot {
+		var err error
+		isExcluded, excludedBy, err = s.ignore.Match(src, isDir)
+		if err != nil {
+			return err
+		}
+	}
 	if isExcluded {
+		s.track(trackExcluded, dest)
 		if s.opts.skipCallback != nil {
 			s.opts.skipCallback(src, isDir, excludedBy)
 		}
@@ -259,38 +556,75 @@ func (s *Sender) filteredSend(isDir bool, src string, dest string) error {
 		s.syslog.Infow("Skipped file", "path", src, "excluded_by", excludedBy)
 		return nil
 	}
-	if isDir {
-		return s.sendDirectory(src, dest)
-	} else {
-		return s.sendFile(src, dest)
+	if isIncluded, mayContain := s.isIncluded(src); !isIncluded {
+		s.track(trackExcluded, dest)
+		if isDir && mayContain {
+			// Walk the directory without sending it, as its contents may be included.
+			return nil
+		}
+		if isDir {
+			return fs.SkipDir
+		}
+		return nil
 	}
+	s.entries = append(s.entries, sendEntry{isDir: isDir, src: src, dest: dest})
+	return nil
 }
 
 func (s *Sender) isExcluded(path string) (bool, string) {
 	for _, exclude := range s.opts.excludes {
-		isGlob, _ := isGlob(exclude)
-		if isGlob {
-			match, _ := doublestar.Match(exclude, path)
-			if match {
-				return true, exclude
-			}
-		} else if path == exclude {
+		if matchesPattern(exclude, path) {
 			return true, exclude
-		} else {
-			up := "../"
-			rel, err := filepath.Rel(exclude, path)
-			if err != nil {
-				return false, ""
+		}
+	}
+	return false, ""
+}
+
+// isIncluded returns true if path is included by the Sender's includes (see WithIncludes), or there are none.
+// If path is not included, mayContain is true if paths within it may be.
+func (s *Sender) isIncluded(path string) (isIncluded bool, mayContain bool) {
+	if len(s.opts.includes) == 0 {
+		return true, false
+	}
+	for _, include := range s.opts.includes {
+		for dir := path; ; dir = filepath.Dir(dir) {
+			if matchesPattern(include, dir) {
+				return true, false
 			}
-			if !strings.HasPrefix(rel, up) && rel != ".." {
-				return true, exclude
+			if dir == "." || dir == filepath.Dir(dir) {
+				break
 			}
 		}
+		base := include
+		if isGlob, _ := isGlob(include); isGlob {
+			base, _ = doublestar.SplitPattern(include)
+		}
+		if isWithin(base, []string{path}) || isWithin(path, []string{base}) {
+			mayContain = true
+		}
 	}
-	return false, ""
+	return false, mayContain
+}
+
+// matchesPattern returns true if path matches the exclude or include pattern, or is within a directory that
+// matches a pattern that is not a glob.
+func matchesPattern(pattern string, path string) bool {
+	isGlob, _ := isGlob(pattern)
+	if isGlob {
+		match, _ := doublestar.Match(pattern, path)
+		return match
+	} else if path == pattern {
+		return true
+	}
+	up := "../"
+	rel, err := filepath.Rel(pattern, path)
+	if err != nil {
+		return false
+	}
+	return !strings.HasPrefix(rel, up) && rel != ".."
 }
 
-func (s *Sender) sendDirectory(src string, dest string) error {
+func (s *Sender) sendDirectory(transport SendTransport, src string, dest string) error {
 	fh, err := s.fs.Open(src)
 	if err != nil {
 		return fmt.Errorf("error opening file %s: %w", src, err)
@@ -307,16 +641,18 @@ func (s *Sender) sendDirectory(src string, dest string) error {
 		FileId:     fileID,
 		Header: &executorv1.FileTransferHeader{
 			IsDir:    true,
+			Type:     executorv1.FileType_FILE_TYPE_DIR,
 			SrcPath:  src,
 			DestPath: dest,
 			Mode:     uint32(info.Mode()),
 			Size:     0,
 		},
 	}
-	err = s.transport.Send(req)
+	err = transport.Send(req)
 	if err != nil {
 		return fmt.Errorf("error sending directory: %w", err)
 	}
+	s.track(trackSentDir, dest)
 	s.syslog.Infow("Sent directory", "src", src, "dest", dest, "mode", info.Mode())
 	if s.opts.sendCallback != nil {
 		s.opts.sendCallback(req.Header)
@@ -324,7 +660,59 @@ func (s *Sender) sendDirectory(src string, dest string) error {
 	return nil
 }
 
-func (s *Sender) sendFile(src string, dest string) error {
+// sendFiles sends the files that were found by walking src over transports concurrently, each file in full
+// over whichever transport is free next. Returns the first error, once the files being sent have been sent.
+func (s *Sender) sendFiles(transports []SendTransport) error {
+	var (
+		wg    sync.WaitGroup
+		files = make(chan sendEntry)
+		errs  = make(chan error, len(transports))
+	)
+	for _, transport := range transports {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for entry := range files {
+				if err := s.sendFile(transport, entry.src, entry.dest); err != nil {
+					errs <- err
+					return
+				}
+			}
+		}()
+	}
+	var err error
+	for _, entry := range s.entries {
+		if entry.isDir {
+			continue
+		}
+		select {
+		case files <- entry:
+			continue
+		case err = <-errs:
+		}
+		break
+	}
+	close(files)
+	wg.Wait()
+	if err != nil {
+		return err
+	}
+	select {
+	case err = <-errs:
+		return err
+	default:
+		return nil
+	}
+}
+
+func (s *Sender) sendFile(transport SendTransport, src string, dest string) error {
+	linfo, err := lstat(s.fs, src)
+	if err != nil {
+		return fmt.Errorf("error stating file %s: %w", src, err)
+	}
+	if linfo.Mode()&fs.ModeSymlink != 0 {
+		return s.sendSymlink(transport, src, dest, linfo)
+	}
 	fh, err := s.fs.Open(src)
 	if err != nil {
 		return fmt.Errorf("error opening file %s: %w", src, err)
@@ -337,23 +725,62 @@ func (s *Sender) sendFile(src string, dest string) error {
 	fileID := uuid.New().String()
 	header := &executorv1.FileTransferHeader{
 		IsDir:    false,
+		Type:     executorv1.FileType_FILE_TYPE_REGULAR,
 		SrcPath:  src,
 		DestPath: dest,
 		Mode:     uint32(info.Mode()),
 		Size:     uint64(info.Size()),
+		Mtime:    timestamppb.New(info.ModTime()),
+	}
+	unchanged, err := s.isUnchanged(src, dest, info)
+	if err != nil {
+		return err
+	}
+	if unchanged {
+		s.track(trackSent, dest)
+		s.mu.Lock()
+		s.result.SkippedFiles++
+		s.result.SkippedBytes += uint64(info.Size())
+		s.mu.Unlock()
+		s.syslog.Infow("Skipped unchanged file", "src", src, "dest", dest, "size", info.Size())
+		s.reportProgress(1, uint64(info.Size()))
+		return nil
+	}
+	digest, digested := s.cachedDigest(src, info)
+	if digested {
+		if _, ok := s.blobs[string(digest)]; ok {
+			return s.sendBlob(transport, fileID, header, digest)
+		}
+	}
+	if isCompressible(src) {
+		header.Compression = s.opts.compression
+	}
+	// Files are hashed as they are sent, even if their digests were computed while planning, so the trailer
+	// always reports the digest of the data that was sent. Files that changed since planning fail the transfer.
+	hash := sha256.New()
+	verify := func() ([]byte, error) {
+		actual := hash.Sum(nil)
+		if digested && !bytes.Equal(actual, digest) {
+			return nil, fmt.Errorf("error file %s changed while it was sent: %w", src, &ChecksumMismatchError{Path: src, Expected: digest, Actual: actual})
+		}
+		return actual, nil
 	}
 	if info.Size() == 0 {
+		digest, err := verify()
+		if err != nil {
+			return err
+		}
 		req := &executorv1.FileTransfer{RuntimeId: s.runtimeID, TransferId: s.transferID, FileId: fileID}
 		req.Header = header
-		req.Trailer = &executorv1.FileTransferTrailer{}
-		err = s.transport.Send(req)
+		req.Trailer = &executorv1.FileTransferTrailer{Sha256: digest}
+		err = transport.Send(req)
 		if err != nil {
 			return fmt.Errorf("error sending file: %w", err)
 		}
 	} else {
 		parts := 0
 		for offset := int64(0); offset < info.Size(); {
-			buf := make([]byte, chunkSize)
+			buf := make([]byte, min(chunkSize, info.Size()-offset))
 			n, err := fh.Read(buf)
 			if err != nil {
 				return fmt.Errorf("error reading file: %w", err)
@@ -363,24 +790,313 @@ func (s *Sender) sendFile(src string, dest string) error {
 				req.Header = header
 			}
 			if n > 0 {
-				req.Body = &executorv1.FileTransferBody{Offset: uint64(offset), Data: buf[:n]}
+				hash.Write(buf[:n])
+				data, err := compress(header.Compression, buf[:n])
+				if err != nil {
+					return fmt.Errorf("error compressing file: %w", err)
+				}
+				req.Body = &executorv1.FileTransferBody{Offset: uint64(offset), Data: data}
 			}
 			partOffset := offset
 			offset += int64(n)
 			if offset == info.Size() {
-				req.Trailer = &executorv1.FileTransferTrailer{Md5: nil} // TODO
+				digest, err := verify()
+				if err != nil {
+					return err
+				}
+				req.Trailer = &executorv1.FileTransferTrailer{Sha256: digest}
 			}
-			err = s.transport.Send(req)
+			err = transport.Send(req)
 			if err != nil {
 				return fmt.Errorf("error sending file: %w", err)
 			}
 			parts++
-			s.syslog.Debugw("Sent file part", "src", src, "part_offset", partOffset, "part_size", n)
+			s.syslog.Debugw("Sent file part", "src", src, "part_offset", partOffset, "part_size", n, "compressed_size", len(req.GetBody().GetData()))
+			s.reportProgress(0, uint64(n))
+		}
+	}
+	s.reportProgress(1, 0)
+	s.track(trackSent, dest)
+	s.syslog.Infow("Sent file", "src", src, "dest", dest, "mode", info.Mode(), "size", info.Size(), "compression", header.Compression)
+	if s.opts.sendCallback != nil {
+		s.opts.sendCallback(header)
+	}
+	return nil
+}
+
+// sendSymlink sends a symbolic link as a header and trailer, without a body.
+// The link is recreated by the receiver, rather than the file it points to being sent.
+func (s *Sender) sendSymlink(transport SendTransport, src string, dest string, info fs.FileInfo) error {
+	target, err := s.fs.(ReadLinkFS).ReadLink(src)
+	if err != nil {
+		return fmt.Errorf("error reading symlink %s: %w", src, err)
+	}
+	if s.opts.strictSymlinks && SymlinkEscapes(dest, target) {
+		return fmt.Errorf("error symlink %s -> %s escapes the destination root", src, target)
+	}
+	req := &executorv1.FileTransfer{
+		RuntimeId:  s.runtimeID,
+		TransferId: s.transferID,
+		FileId:     uuid.New().String(),
+		Header: &executorv1.FileTransferHeader{
+			Type:       executorv1.FileType_FILE_TYPE_SYMLINK,
+			SrcPath:    src,
+			DestPath:   dest,
+			Mode:       uint32(info.Mode()),
+			Mtime:      timestamppb.New(info.ModTime()),
+			LinkTarget: target,
+		},
+		Trailer: &executorv1.FileTransferTrailer{},
+	}
+	err = transport.Send(req)
+	if err != nil {
+		return fmt.Errorf("error sending symlink: %w", err)
+	}
+	s.reportProgress(1, 0)
+	s.track(trackSent, dest)
+	s.syslog.Infow("Sent symlink", "src", src, "dest", dest, "target", target)
+	if s.opts.sendCallback != nil {
+		s.opts.sendCallback(req.Header)
+	}
+	return nil
+}
+
+// isUnchanged returns true if the receiver's manifest shows it already holds the file at dest with the
+// same content and mode. Files with the same size, mode and modification time are assumed to be unchanged,
+// otherwise their digests are compared, if the receiver's digest was requested (see plan).
+func (s *Sender) isUnchanged(src string, dest string, info fs.FileInfo) (bool, error) {
+	entry, sameMtime := s.matchManifest(dest, info)
+	if entry == nil {
+		return false, nil
+	}
+	if sameMtime {
+		return true, nil
+	}
+	remoteDigest, ok := s.remoteDigests[filepath.Clean(dest)]
+	if !ok {
+		return false, nil
+	}
+	digest, err := s.digest(src, info)
+	if err != nil {
+		return false, err
+	}
+	return bytes.Equal(digest, remoteDigest), nil
+}
+
+// matchManifest returns the receiver's manifest entry for the file at dest if it has the same size and mode as
+// the file to send, along with whether it also has the same modification time. Returns nil if it does not match.
+func (s *Sender) matchManifest(dest string, info fs.FileInfo) (*executorv1.ManifestEntry, bool) {
+	entry, ok := s.manifest[filepath.Clean(dest)]
+	if !ok || entry.IsDir || entry.Size != uint64(info.Size()) {
+		return nil, false
+	}
+	if entry.Mode != nil && fs.FileMode(*entry.Mode).Perm() != info.Mode().Perm() {
+		return nil, false
+	}
+	return entry, entry.Mtime != nil && entry.Mtime.AsTime().Equal(info.ModTime())
+}
+
+// plan computes the digests of the files to send where they are needed, then requests the receiver's digests
+// of files that match its manifest in all but modification time, and negotiates blobs.
+func (s *Sender) plan() error {
+	for _, entry := range s.entries {
+		if entry.isDir {
+			continue
+		}
+		info, err := lstat(s.fs, entry.src)
+		if err != nil {
+			return fmt.Errorf("error stating file %s: %w", entry.src, err)
+		}
+		if !info.Mode().IsRegular() {
+			continue
+		}
+		err = s.planFile(entry.src, entry.dest, info)
+		if err != nil {
+			return err
+		}
+	}
+	if err := s.requestRemoteDigests(); err != nil {
+		return err
+	}
+	if s.opts.haveBlobs != nil {
+		return s.negotiateBlobs()
+	}
+	return nil
+}
+
+// planFile computes the digest of the file at src while planning, if it is needed to compare the file with the
+// receiver's copy, or to send it by reference to a blob.
+func (s *Sender) planFile(src string, dest string, info fs.FileInfo) error {
+	entry, sameMtime := s.matchManifest(dest, info)
+	if entry != nil && sameMtime {
+		return nil
+	}
+	if entry != nil && s.opts.manifestDigests != nil {
+		s.remoteDigestPaths = append(s.remoteDigestPaths, entry.Path)
+	} else if s.opts.haveBlobs == nil || info.Size() < MinBlobSize {
+		return nil
+	}
+	_, err := s.digest(src, info)
+	return err
+}
+
+// requestRemoteDigests requests the receiver's digests of the files recorded while planning.
+func (s *Sender) requestRemoteDigests() error {
+	paths := s.remoteDigestPaths
+	s.remoteDigestPaths = nil
+	for start := 0; start < len(paths); start += MaxManifestDigestsPerRequest {
+		digests, err := s.opts.manifestDigests(paths[start:min(start+MaxManifestDigestsPerRequest, len(paths))])
+		if err != nil {
+			return fmt.Errorf("error requesting manifest digests: %w", err)
+		}
+		for _, digest := range digests {
+			s.remoteDigests[filepath.Clean(digest.Path)] = digest.Sha256
+		}
+	}
+	s.syslog.Infow("Requested manifest digests", "paths", len(paths), "digests", len(s.remoteDigests))
+	return nil
+}
+
+// fileDigest is the digest of a file's data, and the size and modification time the file had when it was computed.
+type fileDigest struct {
+	sha256 []byte
+	size   int64
+	mtime  time.Time
+}
+
+// digest returns the SHA-256 digest of a file's data, which is cached for the lifetime of the Sender,
+// so each file is read to compute its digest at most once.
+func (s *Sender) digest(src string, info fs.FileInfo) ([]byte, error) {
+	if digest, ok := s.cachedDigest(src, info); ok {
+		return digest, nil
+	}
+	digest, err := hashFile(s.fs, src)
+	if err != nil {
+		return nil, err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.digests[src] = &fileDigest{sha256: digest, size: info.Size(), mtime: info.ModTime()}
+	return digest, nil
+}
+
+// cachedDigest returns the digest of a file's data, if it has been computed and the file's size and
+// modification time have not changed since.
+func (s *Sender) cachedDigest(src string, info fs.FileInfo) ([]byte, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	digest, ok := s.digests[src]
+	if !ok || digest.size != info.Size() || !digest.mtime.Equal(info.ModTime()) {
+		return nil, false
+	}
+	return digest.sha256, true
+}
+
+// negotiateBlobs determines which of the digests computed while planning the receiver already holds as blobs.
+func (s *Sender) negotiateBlobs() error {
+	var digests [][]byte
+	seen := make(map[string]struct{}, len(s.digests))
+	for _, digest := range s.digests {
+		if _, ok := seen[string(digest.sha256)]; !ok {
+			seen[string(digest.sha256)] = struct{}{}
+			digests = append(digests, digest.sha256)
 		}
 	}
-	s.syslog.Infow("Sent file", "src", src, "dest", dest, "mode", info.Mode(), "size", info.Size())
+	for start := 0; start < len(digests); start += maxBlobsPerRequest {
+		have, err := s.opts.haveBlobs(digests[start:min(start+maxBlobsPerRequest, len(digests))])
+		if err != nil {
+			return fmt.Errorf("error negotiating blobs: %w", err)
+		}
+		for _, digest := range have {
+			s.blobs[string(digest)] = struct{}{}
+		}
+	}
+	s.syslog.Infow("Negotiated blobs", "digests", len(digests), "blobs", len(s.blobs))
+	return nil
+}
+
+// sendBlob sends a file by reference to a blob the receiver already holds, rather than sending its data.
+func (s *Sender) sendBlob(transport SendTransport, fileID string, header *executorv1.FileTransferHeader, digest []byte) error {
+	header.BlobSha256 = digest
+	req := &executorv1.FileTransfer{
+		RuntimeId:  s.runtimeID,
+		TransferId: s.transferID,
+		FileId:     fileID,
+		Header:     header,
+		Trailer:    &executorv1.FileTransferTrailer{Sha256: digest},
+	}
+	err := transport.Send(req)
+	if err != nil {
+		return fmt.Errorf("error sending file: %w", err)
+	}
+	s.track(trackSent, header.DestPath)
+	s.mu.Lock()
+	s.result.BlobFiles++
+	s.result.BlobBytes += header.Size
+	s.mu.Unlock()
+	s.reportProgress(1, header.Size)
+	s.syslog.Infow("Sent file by reference to blob", "src", header.SrcPath, "dest", header.DestPath, "size", header.Size)
 	if s.opts.sendCallback != nil {
 		s.opts.sendCallback(header)
 	}
 	return nil
 }
+
+// reportProgress reports that files and bytes have been sent, if progress is being reported.
+func (s *Sender) reportProgress(files int, bytes uint64) {
+	if s.opts.progress != nil {
+		s.opts.progress(files, bytes)
+	}
+}
+
+// track records a dest path for the purposes of deleting extraneous files.
+func (s *Sender) track(kind trackKind, dest string) {
+	if !s.opts.deleteExtraneous {
+		return
+	}
+	dest = filepath.Clean(dest)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	switch kind {
+	case trackSent:
+		s.sent[dest] = struct{}{}
+	case trackSentDir:
+		s.sentDirs[dest] = struct{}{}
+	case trackExcluded:
+		s.excluded = append(s.excluded, dest)
+	}
+}
+
+// deleteExtraneous deletes the entries in the receiver's manifest that were not sent, from the directories
+// that were sent. Entries below an extraneous directory are deleted along with it.
+func (s *Sender) deleteExtraneous(transport SendTransport) error {
+	for _, entry := range s.opts.manifest {
+		path := filepath.Clean(entry.Path)
+		if _, ok := s.sent[path]; ok {
+			continue
+		}
+		if _, ok := s.sentDirs[path]; ok {
+			continue
+		}
+		if _, ok := s.sentDirs[filepath.Dir(path)]; !ok || path == "." || isWithin(path, s.excluded) {
+			continue
+		}
+		req := &executorv1.FileTransfer{
+			RuntimeId:  s.runtimeID,
+			TransferId: s.transferID,
+			FileId:     uuid.New().String(),
+			Header:     &executorv1.FileTransferHeader{IsDir: entry.IsDir, DestPath: path, Delete: true},
+			Trailer:    &executorv1.FileTransferTrailer{},
+		}
+		err := transport.Send(req)
+		if err != nil {
+			return fmt.Errorf("error sending deletion: %w", err)
+		}
+		s.result.DeletedFiles++
+		s.syslog.Infow("Deleted extraneous path", "path", path, "is_dir", entry.IsDir)
+		if s.opts.sendCallback != nil {
+			s.opts.sendCallback(req.Header)
+		}
+	}
+	return nil
+}
@@ -1,13 +1,23 @@
 package file
 
 import (
+	"bytes"
+	"crypto/sha256"
+	"fmt"
 	"io/fs"
+	"os"
+	"path/filepath"
+	"runtime"
 	"sort"
+	"sync"
 	"testing"
 	"testing/fstest"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/knita-io/knita/api/executor/v1"
 )
@@ -506,3 +516,335 @@ func TestSend(t *testing.T) {
 		}
 	}
 }
+
+func TestSendWithManifest(t *testing.T) {
+	mtime := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	testFS := fstest.MapFS{
+		"app/unchanged.txt":  &fstest.MapFile{Data: []byte("same"), Mode: 0644, ModTime: mtime},
+		"app/changed.txt":    &fstest.MapFile{Data: []byte("new"), Mode: 0644, ModTime: mtime},
+		"app/added.txt":      &fstest.MapFile{Data: []byte("added"), Mode: 0644, ModTime: mtime},
+		"app/cache/keep.txt": &fstest.MapFile{Data: []byte("local"), Mode: 0644, ModTime: mtime},
+	}
+	dir := t.TempDir()
+	for path, data := range map[string]string{
+		"app/unchanged.txt":    "same",
+		"app/changed.txt":      "old",
+		"app/extraneous.txt":   "extraneous",
+		"app/extraneous/x.txt": "extraneous",
+		"app/cache/keep.txt":   "remote",
+		"other/untouched.txt":  "untouched",
+	} {
+		require.NoError(t, os.MkdirAll(filepath.Join(dir, filepath.Dir(path)), 0755))
+		require.NoError(t, os.WriteFile(filepath.Join(dir, path), []byte(data), 0644))
+	}
+
+	send := func() *SendResult {
+		root := ManifestRoot("app", "")
+		require.Equal(t, "app", root)
+		manifest := buildTestManifest(t, os.DirFS(dir), root)
+		transport := &testSendTransport{}
+		sender := NewSender(zap.NewNop().Sugar(), testFS, transport, "runtime", "transfer",
+			WithManifest(manifest, true), WithManifestDigests(func(paths []string) ([]*v1.FileDigest, error) {
+				return ManifestDigests(os.DirFS(dir), paths)
+			}), WithExcludes([]string{"app/cache"}))
+		res, err := sender.Send("app")
+		require.NoError(t, err)
+		receivers := make(map[string]*Receiver)
+		for _, send := range transport.sends {
+			receiver, ok := receivers[send.FileId]
+			if !ok {
+				receiver = NewReceiver(zap.NewNop().Sugar(), WriteDirFS(dir), WithRecvDeletes())
+				receivers[send.FileId] = receiver
+			}
+			require.NoError(t, receiver.Next(send))
+		}
+		return res
+	}
+
+	res := send()
+	require.Equal(t, &SendResult{SkippedFiles: 1, SkippedBytes: 4, DeletedFiles: 2}, res)
+	for path, data := range map[string]string{
+		"app/unchanged.txt":   "same",
+		"app/changed.txt":     "new",
+		"app/added.txt":       "added",
+		"app/cache/keep.txt":  "remote",
+		"other/untouched.txt": "untouched",
+	} {
+		actual, err := os.ReadFile(filepath.Join(dir, path))
+		require.NoError(t, err)
+		require.Equal(t, data, string(actual))
+	}
+	for _, path := range []string{"app/extraneous.txt", "app/extraneous"} {
+		_, err := os.Stat(filepath.Join(dir, path))
+		require.True(t, os.IsNotExist(err), "expected %s to be deleted", path)
+	}
+	info, err := os.Stat(filepath.Join(dir, "app/added.txt"))
+	require.NoError(t, err)
+	require.True(t, mtime.Equal(info.ModTime()))
+
+	// Everything is now up-to-date.
+	res = send()
+	require.Equal(t, &SendResult{SkippedFiles: 3, SkippedBytes: 12}, res)
+}
+
+func TestSendWithManifestDigests(t *testing.T) {
+	mtime := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	testFS := fstest.MapFS{
+		"app/same.txt":     &fstest.MapFile{Data: []byte("same"), Mode: 0644, ModTime: mtime},
+		"app/touched.txt":  &fstest.MapFile{Data: []byte("same"), Mode: 0644, ModTime: mtime},
+		"app/edited.txt":   &fstest.MapFile{Data: []byte("new!"), Mode: 0644, ModTime: mtime},
+		"app/resized.txt":  &fstest.MapFile{Data: []byte("longer"), Mode: 0644, ModTime: mtime},
+		"app/chmodded.txt": &fstest.MapFile{Data: []byte("same"), Mode: 0755, ModTime: mtime},
+		"app/no-mode.txt":  &fstest.MapFile{Data: []byte("same"), Mode: 0755, ModTime: mtime},
+	}
+	later := timestamppb.New(mtime.Add(time.Hour))
+	manifest := []*v1.ManifestEntry{
+		{Path: "app/same.txt", Size: 4, Mode: proto.Uint32(0644), Mtime: timestamppb.New(mtime)},
+		{Path: "app/touched.txt", Size: 4, Mode: proto.Uint32(0644), Mtime: later},
+		{Path: "app/edited.txt", Size: 4, Mode: proto.Uint32(0644), Mtime: later},
+		{Path: "app/resized.txt", Size: 4, Mode: proto.Uint32(0644), Mtime: later},
+		{Path: "app/chmodded.txt", Size: 4, Mode: proto.Uint32(0644), Mtime: timestamppb.New(mtime)},
+		// Receivers that don't support modes omit them.
+		{Path: "app/no-mode.txt", Size: 4, Mtime: timestamppb.New(mtime)},
+	}
+	remoteFS := fstest.MapFS{
+		"app/touched.txt": &fstest.MapFile{Data: []byte("same")},
+		"app/edited.txt":  &fstest.MapFile{Data: []byte("old!")},
+	}
+	var requested []string
+	transport := &testSendTransport{}
+	sender := NewSender(zap.NewNop().Sugar(), testFS, transport, "runtime", "transfer",
+		WithManifest(manifest, false), WithManifestDigests(func(paths []string) ([]*v1.FileDigest, error) {
+			requested = append(requested, paths...)
+			return ManifestDigests(remoteFS, paths)
+		}))
+	res, err := sender.Send("app")
+	require.NoError(t, err)
+	// Digests are only requested for files that differ in modification time alone.
+	sort.Strings(requested)
+	require.Equal(t, []string{"app/edited.txt", "app/touched.txt"}, requested)
+	require.Equal(t, 3, res.SkippedFiles)
+	var sent []string
+	for _, send := range transport.sends {
+		if send.Header != nil && !send.Header.IsDir {
+			sent = append(sent, send.Header.DestPath)
+		}
+	}
+	sort.Strings(sent)
+	require.Equal(t, []string{"app/chmodded.txt", "app/edited.txt", "app/resized.txt"}, sent)
+}
+
+func TestBuildManifest(t *testing.T) {
+	testFS := fstest.MapFS{}
+	for i := 0; i < maxManifestEntriesPerBatch*2+1; i++ {
+		testFS[fmt.Sprintf("root/f%d", i)] = &fstest.MapFile{Data: []byte("data"), Mode: 0640}
+	}
+	var batches int
+	var entries []*v1.ManifestEntry
+	err := BuildManifest(testFS, "root", func(batch []*v1.ManifestEntry) error {
+		require.LessOrEqual(t, len(batch), maxManifestEntriesPerBatch)
+		batches++
+		entries = append(entries, batch...)
+		return nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, 3, batches)
+	// The root directory and every file.
+	require.Len(t, entries, maxManifestEntriesPerBatch*2+2)
+	require.Equal(t, "root/f0", entries[1].Path)
+	require.Equal(t, uint64(4), entries[1].Size)
+	if runtime.GOOS != "windows" {
+		require.Equal(t, uint32(0640), entries[1].GetMode())
+	}
+
+	require.NoError(t, BuildManifest(testFS, "missing", func(batch []*v1.ManifestEntry) error {
+		t.Fatal("unexpected entries for missing root")
+		return nil
+	}))
+}
+
+// buildTestManifest returns the manifest of the files and directories in fsys at or below root.
+func buildTestManifest(t *testing.T, fsys fs.FS, root string) []*v1.ManifestEntry {
+	var manifest []*v1.ManifestEntry
+	err := BuildManifest(fsys, root, func(entries []*v1.ManifestEntry) error {
+		manifest = append(manifest, entries...)
+		return nil
+	})
+	require.NoError(t, err)
+	return manifest
+}
+
+func TestSendStreams(t *testing.T) {
+	mtime := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	testFS := fstest.MapFS{}
+	for d := 0; d < 5; d++ {
+		for f := 0; f < 10; f++ {
+			testFS[fmt.Sprintf("tree/d%d/f%d.txt", d, f)] = &fstest.MapFile{Data: []byte("data"), ModTime: mtime}
+		}
+	}
+	manifest := []*v1.ManifestEntry{
+		{Path: "tree/d0/f0.txt", Size: 4, Mtime: timestamppb.New(mtime)},
+		{Path: "tree/extraneous.txt", Size: 4},
+	}
+	var walks int
+	sender := NewSender(zap.NewNop().Sugar(), testFS, nil, "runtime", "transfer", WithManifest(manifest, true),
+		WithSkipCallback(func(path string, isDir bool, excludedBy string) {
+			walks++
+		}), WithExcludes([]string{"tree/d4/f9.txt"}))
+
+	files, size, err := sender.Count("tree")
+	require.NoError(t, err)
+	require.Equal(t, 49, files)
+	require.Equal(t, uint64(196), size)
+
+	const streams = 3
+	transports := make([]SendTransport, streams)
+	for i := range transports {
+		transports[i] = &testSendTransport{}
+	}
+	res, err := sender.SendStreams("tree", transports...)
+	require.NoError(t, err)
+	require.Equal(t, 1, res.SkippedFiles)
+	// The files that were counted are sent without walking the tree again.
+	require.Equal(t, 1, walks)
+	sent := make(map[string]int)
+	for i, transport := range transports {
+		for _, send := range transport.(*testSendTransport).sends {
+			header := send.Header
+			if header == nil {
+				continue
+			}
+			if header.IsDir || header.Delete {
+				// Directories and deletions are only sent over the first stream. Files sent over other
+				// streams are not considered extraneous.
+				require.Equal(t, 0, i, "unexpected %s over stream %d", header.DestPath, i)
+			}
+			if header.Delete {
+				require.Equal(t, "tree/extraneous.txt", header.DestPath)
+			}
+			sent[header.DestPath]++
+		}
+	}
+	// Every file is sent exactly once, across all streams.
+	require.Len(t, sent, 6+48+1)
+	for path, n := range sent {
+		require.Equal(t, 1, n, "%s sent %d times", path, n)
+	}
+}
+
+func TestSendProgress(t *testing.T) {
+	mtime := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	testFS := fstest.MapFS{
+		"app/empty.txt":     &fstest.MapFile{ModTime: mtime},
+		"app/small.txt":     &fstest.MapFile{Data: []byte("small"), ModTime: mtime},
+		"app/large.bin":     &fstest.MapFile{Data: make([]byte, chunkSize*2+1), ModTime: mtime},
+		"app/unchanged.txt": &fstest.MapFile{Data: []byte("same"), ModTime: mtime},
+		"app/dir/nested":    &fstest.MapFile{Data: []byte("nested"), ModTime: mtime},
+	}
+	manifest := []*v1.ManifestEntry{{Path: "app/unchanged.txt", Size: 4, Mtime: timestamppb.New(mtime)}}
+	files, size, err := NewSender(zap.NewNop().Sugar(), testFS, nil, "runtime", "transfer").Count("app")
+	require.NoError(t, err)
+	require.Equal(t, 5, files)
+	require.Equal(t, uint64(chunkSize*2+1+5+4+6), size)
+
+	var sentFiles, receivedFiles int
+	var sentBytes, receivedBytes uint64
+	transport := &testSendTransport{}
+	sender := NewSender(zap.NewNop().Sugar(), testFS, transport, "runtime", "transfer",
+		WithManifest(manifest, false), WithProgress(func(files int, bytes uint64) {
+			sentFiles += files
+			sentBytes += bytes
+		}))
+	_, err = sender.Send("app")
+	require.NoError(t, err)
+	require.Equal(t, files, sentFiles)
+	require.Equal(t, size, sentBytes)
+
+	dir := t.TempDir()
+	receivers := make(map[string]*Receiver)
+	for _, send := range transport.sends {
+		receiver, ok := receivers[send.FileId]
+		if !ok {
+			receiver = NewReceiver(zap.NewNop().Sugar(), WriteDirFS(dir), WithRecvProgress(func(files int, bytes uint64) {
+				receivedFiles += files
+				receivedBytes += bytes
+			}))
+			receivers[send.FileId] = receiver
+		}
+		require.NoError(t, receiver.Next(send))
+	}
+	// The unchanged file was not sent, so the receiver only reports the files it received.
+	require.Equal(t, files-1, receivedFiles)
+	require.Equal(t, size-4, receivedBytes)
+}
+
+// openCountingFS counts the number of times each file is opened to read its data. Stats are not counted.
+type openCountingFS struct {
+	fs.FS
+	mu    sync.Mutex
+	opens map[string]int
+}
+
+func (f *openCountingFS) Open(name string) (fs.File, error) {
+	f.mu.Lock()
+	f.opens[name]++
+	f.mu.Unlock()
+	return f.FS.Open(name)
+}
+
+func (f *openCountingFS) Stat(name string) (fs.FileInfo, error) {
+	return fs.Stat(f.FS, name)
+}
+
+func TestSendDigestsFilesOnce(t *testing.T) {
+	mtime := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	large := bytes.Repeat([]byte("knita "), MinBlobSize)
+	testFS := &openCountingFS{FS: fstest.MapFS{
+		"app/large.bin":   &fstest.MapFile{Data: large, Mode: 0644, ModTime: mtime},
+		"app/changed.bin": &fstest.MapFile{Data: large, Mode: 0644, ModTime: mtime},
+	}, opens: make(map[string]int)}
+	manifest := []*v1.ManifestEntry{{Path: "app/changed.bin", Size: uint64(len(large)), Mode: proto.Uint32(0644)}}
+	var negotiated int
+	sender := NewSender(zap.NewNop().Sugar(), testFS, nil, "runtime", "transfer",
+		WithManifest(manifest, false),
+		WithManifestDigests(func(paths []string) ([]*v1.FileDigest, error) {
+			return []*v1.FileDigest{{Path: "app/changed.bin", Sha256: []byte("stale")}}, nil
+		}),
+		WithBlobs(func(digests [][]byte) ([][]byte, error) {
+			negotiated += len(digests)
+			return nil, nil
+		}))
+	_, _, err := sender.Count("app")
+	require.NoError(t, err)
+	transports := []SendTransport{&testSendTransport{}, &testSendTransport{}}
+	_, err = sender.SendStreams("app", transports...)
+	require.NoError(t, err)
+	require.Equal(t, 1, negotiated)
+
+	// Each file is read once to compute its digest, and once to send it, across the count, plan and send.
+	for _, name := range []string{"app/large.bin", "app/changed.bin"} {
+		require.Equal(t, 2, testFS.opens[name], name)
+	}
+	expected := sha256.Sum256(large)
+	var trailers int
+	for _, transport := range transports {
+		for _, send := range transport.(*testSendTransport).sends {
+			if send.Trailer != nil && !send.Header.GetIsDir() {
+				require.Equal(t, expected[:], send.Trailer.Sha256)
+				trailers++
+			}
+		}
+	}
+	require.Equal(t, 2, trailers)
+
+	// Files that change after they were digested, without their size or modification time changing, fail the transfer.
+	changing := fstest.MapFS{"app/large.bin": &fstest.MapFile{Data: bytes.Clone(large), Mode: 0644, ModTime: mtime}}
+	sender = NewSender(zap.NewNop().Sugar(), changing, &testSendTransport{}, "runtime", "transfer",
+		WithBlobs(func(digests [][]byte) ([][]byte, error) {
+			copy(changing["app/large.bin"].Data, "changed")
+			return nil, nil
+		}))
+	_, err = sender.Send("app")
+	var mismatch *ChecksumMismatchError
+	require.ErrorAs(t, err, &mismatch)
+}
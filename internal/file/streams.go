@@ -0,0 +1,66 @@
+package file
+
+import (
+	"strconv"
+
+	"google.golang.org/grpc/metadata"
+)
+
+// MaxStreams is the maximum number of concurrent streams a transfer may be split across.
+const MaxStreams = 8
+
+// StreamsHeader is the gRPC response header in which an exporter reports the number of streams it chose
+// to split an export across, and the gRPC request header in which an importer reports the number of streams
+// it split an import across.
+const StreamsHeader = "knita-transfer-streams"
+
+// TransferIDHeader is the gRPC request header in which an importer reports the ID of the import that a stream
+// belongs to, so the streams of an import can be tracked before (or without) any file being sent over them.
+const TransferIDHeader = "knita-transfer-id"
+
+const (
+	// filesPerStream and bytesPerStream are the amounts of work that justify an additional stream.
+	// Small files benefit the most, as the receiver writes each file in turn.
+	filesPerStream = 256
+	bytesPerStream = 64 * 1024 * 1024
+)
+
+// DefaultStreams returns the number of concurrent streams to split a transfer of the given number
+// and combined size of files across, between 1 and maxStreams.
+func DefaultStreams(files int, bytes uint64, maxStreams int) int {
+	n := max(files/filesPerStream, int(min(bytes/bytesPerStream, MaxStreams)))
+	return max(1, min(n, maxStreams, MaxStreams))
+}
+
+// StreamsFromHeader returns the number of streams an exporter or importer reported in its header,
+// or 1 if it did not report one.
+func StreamsFromHeader(header metadata.MD) int {
+	values := header.Get(StreamsHeader)
+	if len(values) == 0 {
+		return 1
+	}
+	n, err := strconv.Atoi(values[0])
+	if err != nil || n < 1 {
+		return 1
+	}
+	return min(n, MaxStreams)
+}
+
+// StreamsHeaderMD returns a response header reporting that an export was split across n streams.
+func StreamsHeaderMD(n int) metadata.MD {
+	return metadata.Pairs(StreamsHeader, strconv.Itoa(n))
+}
+
+// ImportStreamsMD returns a request header reporting that the import with the given ID was split across n streams.
+func ImportStreamsMD(importID string, n int) metadata.MD {
+	return metadata.Pairs(TransferIDHeader, importID, StreamsHeader, strconv.Itoa(n))
+}
+
+// TransferIDFromHeader returns the transfer ID an importer reported in its request header, or "" if it did not report one.
+func TransferIDFromHeader(header metadata.MD) string {
+	values := header.Get(TransferIDHeader)
+	if len(values) == 0 {
+		return ""
+	}
+	return values[0]
+}
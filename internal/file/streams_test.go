@@ -0,0 +1,112 @@
+package file
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/knita-io/knita/api/executor/v1"
+)
+
+func TestDefaultStreams(t *testing.T) {
+	var table = []struct {
+		files      int
+		bytes      uint64
+		maxStreams int
+		streams    int
+	}{
+		{files: 0, bytes: 0, maxStreams: MaxStreams, streams: 1},
+		{files: 10, bytes: 1024, maxStreams: MaxStreams, streams: 1},
+		{files: 1000, bytes: 1024 * 1024, maxStreams: MaxStreams, streams: 3},
+		{files: 100000, bytes: 1024 * 1024, maxStreams: MaxStreams, streams: MaxStreams},
+		{files: 2, bytes: 1024 * 1024 * 1024, maxStreams: MaxStreams, streams: MaxStreams},
+		{files: 100000, bytes: 0, maxStreams: 4, streams: 4},
+		{files: 100000, bytes: 0, maxStreams: 0, streams: 1},
+		{files: 100000, bytes: 0, maxStreams: 100, streams: MaxStreams},
+	}
+	for _, test := range table {
+		require.Equal(t, test.streams, DefaultStreams(test.files, test.bytes, test.maxStreams),
+			"files: %d, bytes: %d, max: %d", test.files, test.bytes, test.maxStreams)
+	}
+}
+
+// latencyTransport delivers transfers to a receiving goroutine after a delay, as a gRPC stream to a distant
+// receiver would, so each stream is limited by its latency rather than by the speed of the local disk.
+type latencyTransport struct {
+	latency time.Duration
+	c       chan *v1.FileTransfer
+}
+
+func (t *latencyTransport) Send(transfer *v1.FileTransfer) error {
+	time.Sleep(t.latency)
+	t.c <- transfer
+	return nil
+}
+
+// BenchmarkTransfer transfers a tree of small files between directories over an increasing number of streams,
+// each with a latency of 100µs per message. The tree is walked once, and its files are fanned out to the streams.
+// Once the streams hide the latency, speedups depend on the number of cores available to read and write the files.
+func BenchmarkTransfer(b *testing.B) {
+	src := b.TempDir()
+	for d := 0; d < 20; d++ {
+		require.NoError(b, os.MkdirAll(filepath.Join(src, "tree", fmt.Sprint(d)), 0755))
+		for f := 0; f < 200; f++ {
+			data := []byte(fmt.Sprintf("file %d of directory %d", f, d))
+			require.NoError(b, os.WriteFile(filepath.Join(src, "tree", fmt.Sprint(d), fmt.Sprint(f)), data, 0644))
+		}
+	}
+	for _, streams := range []int{1, 2, 4, 8} {
+		b.Run(fmt.Sprintf("streams=%d", streams), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				b.StopTimer()
+				dest := b.TempDir()
+				b.StartTimer()
+				var wg sync.WaitGroup
+				errs := make(chan error, streams)
+				transports := make([]SendTransport, streams)
+				for p := range transports {
+					transport := &latencyTransport{latency: 100 * time.Microsecond, c: make(chan *v1.FileTransfer, 64)}
+					transports[p] = transport
+					wg.Add(1)
+					go func() {
+						defer wg.Done()
+						receivers := make(map[string]*Receiver)
+						for transfer := range transport.c {
+							receiver, ok := receivers[transfer.FileId]
+							if !ok {
+								receiver = NewReceiver(zap.NewNop().Sugar(), WriteDirFS(dest))
+								receivers[transfer.FileId] = receiver
+							}
+							if err := receiver.Next(transfer); err != nil {
+								select {
+								case errs <- err:
+								default:
+								}
+							}
+							if receiver.State() == ReceiveStateDone {
+								delete(receivers, transfer.FileId)
+							}
+						}
+					}()
+				}
+				sender := NewSender(zap.NewNop().Sugar(), WriteDirFS(src).ReadFS(), nil, "runtime", "transfer")
+				_, err := sender.SendStreams("tree", transports...)
+				for _, transport := range transports {
+					close(transport.(*latencyTransport).c)
+				}
+				wg.Wait()
+				require.NoError(b, err)
+				close(errs)
+				for err := range errs {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
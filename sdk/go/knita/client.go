@@ -126,6 +126,7 @@ func (c *Client) RuntimeWithContext(ctx context.Context, opts ...runtime.Opt) (*
 		runtimeID:           res.RuntimeId,
 		remoteWorkDirectory: res.WorkDirectory,
 		remoteSysInfo:       res.SysInfo,
+		runtimeType:         req.Opts.Type,
 	}, nil
 }
 
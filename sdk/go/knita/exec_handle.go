@@ -0,0 +1,232 @@
+package knita
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	directorv1 "github.com/knita-io/knita/api/director/v1"
+	builtinv1 "github.com/knita-io/knita/api/events/builtin/v1"
+	executorv1 "github.com/knita-io/knita/api/executor/v1"
+	"github.com/knita-io/knita/sdk/go/knita/runtime"
+	"github.com/knita-io/knita/sdk/go/knita/runtime/exec"
+)
+
+const (
+	// defaultTerminationGracePeriod mirrors the executor's default termination grace period.
+	defaultTerminationGracePeriod = time.Second * 10
+	// maxOutputTailSize is the amount of recent output retained for error messages.
+	maxOutputTailSize = 8 * 1024
+)
+
+// ExecHandle is a handle to a command executing inside a remote runtime.
+type ExecHandle struct {
+	runtime     *Runtime
+	opts        *exec.Opts
+	execID      string
+	startC      chan struct{}
+	doneC       chan struct{}
+	res         *executorv1.ExecResponse
+	err         error
+	mu          sync.Mutex
+	subscribers map[int]*outputSubscriber
+	nextSubID   int
+	// tail holds the most recent output of the command.
+	tail []byte
+}
+
+type outputSubscriber struct {
+	stdout io.Writer
+	stderr io.Writer
+}
+
+func newExecHandle(runtime *Runtime, opts *exec.Opts) *ExecHandle {
+	return &ExecHandle{
+		runtime:     runtime,
+		opts:        opts,
+		startC:      make(chan struct{}),
+		doneC:       make(chan struct{}),
+		subscribers: map[int]*outputSubscriber{},
+	}
+}
+
+// ID returns the ID of the exec.
+func (h *ExecHandle) ID() string {
+	return h.execID
+}
+
+// Wait blocks until the command exits.
+// Check the returned ExecResponse to see the command's exit code (a non-zero code is not an error) and resource usage.
+// Resource usage in Docker runtimes is container wide, so includes any other processes running in the container.
+func (h *ExecHandle) Wait() (*executorv1.ExecResponse, error) {
+	<-h.doneC
+	return h.res, h.err
+}
+
+// Done returns a channel that is closed once the command has exited.
+func (h *ExecHandle) Done() <-chan struct{} {
+	return h.doneC
+}
+
+// Subscribe writes the command's subsequent output to stdout and stderr (either may be nil), in addition
+// to any writers configured when the command was started. Call the returned func to unsubscribe.
+func (h *ExecHandle) Subscribe(stdout io.Writer, stderr io.Writer) func() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	id := h.nextSubID
+	h.nextSubID++
+	h.subscribers[id] = &outputSubscriber{stdout: stdout, stderr: stderr}
+	return func() {
+		h.mu.Lock()
+		defer h.mu.Unlock()
+		delete(h.subscribers, id)
+	}
+}
+
+// Stop terminates the command and any processes it spawned, and waits for it to exit.
+// The command is sent its termination signal (SIGTERM by default, see exec.WithTermination),
+// and is killed if it has not exited within the termination grace period.
+func (h *ExecHandle) Stop() error {
+	return h.StopWithContext(context.Background())
+}
+
+// StopWithContext is like Stop, but it allows a context to be set.
+func (h *ExecHandle) StopWithContext(ctx context.Context) error {
+	termSignal := "SIGTERM"
+	if h.opts.TerminationSignal != "" {
+		termSignal = h.opts.TerminationSignal
+	}
+	grace := defaultTerminationGracePeriod
+	if h.opts.TerminationGracePeriod != nil {
+		grace = h.opts.TerminationGracePeriod.AsDuration()
+	}
+	for _, signal := range []string{termSignal, "SIGKILL"} {
+		if err := h.signal(ctx, signal); err != nil {
+			select {
+			case <-h.doneC:
+				// The command exited before it could be signalled.
+				return nil
+			default:
+				return err
+			}
+		}
+		select {
+		case <-h.doneC:
+			return nil
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(grace):
+		}
+	}
+	return fmt.Errorf("error command did not exit after being killed")
+}
+
+// Signal sends sig to the command and any processes it spawned. For example, send syscall.SIGQUIT
+// to a hung Go test binary to make it dump its goroutines.
+func (h *ExecHandle) Signal(sig os.Signal) error {
+	return h.SignalWithContext(context.Background(), sig)
+}
+
+// SignalWithContext is like Signal, but it allows a context to be set.
+func (h *ExecHandle) SignalWithContext(ctx context.Context, sig os.Signal) error {
+	name, err := runtime.SignalName(sig)
+	if err != nil {
+		return err
+	}
+	return h.signal(ctx, name)
+}
+
+func (h *ExecHandle) signal(ctx context.Context, signal string) error {
+	req := &directorv1.SignalRequest{RuntimeId: h.runtime.runtimeID, ExecId: h.execID, Signal: signal}
+	_, err := h.runtime.client.Signal(ctx, req)
+	if err != nil {
+		return fmt.Errorf("error signalling exec: %w", err)
+	}
+	return nil
+}
+
+// writeOutput writes output to the configured writer and all subscribers, and records it in the output tail.
+func (h *ExecHandle) writeOutput(data []byte, stderr bool) {
+	w := h.opts.Stdout
+	if stderr {
+		w = h.opts.Stderr
+	}
+	if w != nil {
+		w.Write(data)
+	}
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.tail = append(h.tail, data...)
+	if len(h.tail) > maxOutputTailSize {
+		h.tail = h.tail[len(h.tail)-maxOutputTailSize:]
+	}
+	for _, sub := range h.subscribers {
+		w := sub.stdout
+		if stderr {
+			w = sub.stderr
+		}
+		if w != nil {
+			w.Write(data)
+		}
+	}
+}
+
+// receive processes the exec's events until the stream closes, returning the exec's result.
+// startC is closed once the exec has started.
+func (h *ExecHandle) receive(ctx context.Context, stream directorv1.Director_ExecClient) (*executorv1.ExecResponse, error) {
+	var execEnd *executorv1.ExecResponse
+	for {
+		msg, err := stream.Recv()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				if execEnd != nil {
+					return execEnd, nil
+				}
+				return nil, fmt.Errorf("error stream closed before exec end event was observed")
+			}
+			if ctx.Err() != nil {
+				return nil, fmt.Errorf("error exec cancelled: %w", ctx.Err())
+			}
+			return nil, err
+		}
+		if msg.Payload == nil {
+			continue
+		}
+		payload, err := anypb.UnmarshalNew(msg.Payload, proto.UnmarshalOptions{})
+		if err != nil {
+			if errors.Is(err, protoregistry.NotFound) {
+				continue
+			} else {
+				return nil, err
+			}
+		}
+		switch p := payload.(type) {
+		case *builtinv1.ExecStartEvent:
+			if h.execID == "" {
+				h.execID = p.ExecId
+				close(h.startC)
+			}
+		case *builtinv1.StdoutEvent:
+			h.writeOutput(p.Data, false)
+		case *builtinv1.StderrEvent:
+			h.writeOutput(p.Data, true)
+		case *builtinv1.ExecEndEvent:
+			switch res := p.Status.(type) {
+			case *builtinv1.ExecEndEvent_Result:
+				execEnd = &executorv1.ExecResponse{ExitCode: res.Result.ExitCode, TimedOut: res.Result.TimedOut, Usage: res.Result.Usage}
+			case *builtinv1.ExecEndEvent_Error:
+				return nil, fmt.Errorf("error in exec: %v", res.Error.Message)
+			case *builtinv1.ExecEndEvent_Cancelled:
+				return nil, fmt.Errorf("error exec cancelled")
+			}
+		}
+	}
+}
@@ -0,0 +1,230 @@
+package knita
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	executorv1 "github.com/knita-io/knita/api/executor/v1"
+	"github.com/knita-io/knita/sdk/go/knita/runtime/exec"
+)
+
+const (
+	// waitPollInterval is how often WaitForExec re-runs its healthcheck.
+	waitPollInterval = time.Second
+	// maxTailLines is the number of output lines included in wait errors.
+	maxTailLines = 20
+	// maxMatchLineSize is the amount of an incomplete line lineMatcher buffers. Older bytes are discarded.
+	maxMatchLineSize = maxOutputTailSize
+)
+
+// WaitForOutput blocks until a line of the command's output (stdout or stderr) matches re, e.g. to wait
+// until a server logs "listening on :8080". The most recent output produced before WaitForOutput was called
+// (up to 8 KiB) is also matched, so call it soon after starting the command if it may produce a lot of output.
+// Returns an error containing the tail of the command's output if the command exits or timeout passes first.
+func (h *ExecHandle) WaitForOutput(re *regexp.Regexp, timeout time.Duration) error {
+	matchC := make(chan struct{})
+	var once sync.Once
+	onMatch := func() {
+		once.Do(func() { close(matchC) })
+	}
+	stdout := &lineMatcher{re: re, onMatch: onMatch}
+	stderr := &lineMatcher{re: re, onMatch: onMatch}
+	h.mu.Lock()
+	tail := &lineMatcher{re: re, onMatch: onMatch}
+	tail.Write(h.tail)
+	tail.Flush()
+	id := h.nextSubID
+	h.nextSubID++
+	h.subscribers[id] = &outputSubscriber{stdout: stdout, stderr: stderr}
+	h.mu.Unlock()
+	defer func() {
+		h.mu.Lock()
+		delete(h.subscribers, id)
+		h.mu.Unlock()
+	}()
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+	select {
+	case <-matchC:
+		return nil
+	case <-h.doneC:
+		select {
+		case <-matchC:
+			return nil
+		default:
+		}
+		return fmt.Errorf("error command exited before output matched %q%s", re, h.formatTail())
+	case <-timer.C:
+		return fmt.Errorf("error output did not match %q within %s%s", re, timeout, h.formatTail())
+	}
+}
+
+// WaitForPort blocks until a process inside the runtime is listening on the TCP port, e.g. to wait until
+// a server started by the command is ready to accept connections. The port is checked from inside the
+// runtime, using /proc on Linux, lsof on macOS and PowerShell on Windows. Docker runtimes are assumed to
+// run Linux containers, unless the executor is running on Windows.
+// Returns an error containing the tail of the command's output if the command exits or timeout passes first.
+func (h *ExecHandle) WaitForPort(port int, timeout time.Duration) error {
+	name, args := h.runtime.portProbeCommand(port)
+	probe := []exec.Opt{
+		exec.WithCommand(name, args...),
+		exec.WithDisplayName(fmt.Sprintf("wait for port %d", port)),
+		exec.WithTimeout(timeout),
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	probeH, err := h.runtime.StartWithContext(ctx, probe...)
+	if err != nil {
+		return fmt.Errorf("error starting port probe: %w", err)
+	}
+	select {
+	case <-probeH.Done():
+		res, err := probeH.Wait()
+		if err != nil {
+			return fmt.Errorf("error probing port %d: %w", port, err)
+		}
+		if res.TimedOut {
+			return fmt.Errorf("error port %d was not listening within %s%s", port, timeout, h.formatTail())
+		}
+		if res.ExitCode != 0 {
+			return fmt.Errorf("error probing port %d: probe exited with code %d%s", port, res.ExitCode, probeH.formatTail())
+		}
+		return nil
+	case <-h.doneC:
+		return fmt.Errorf("error command exited before port %d was listening%s", port, h.formatTail())
+	}
+}
+
+// WaitForExec blocks until the healthcheck command specified by opts exits with a zero exit code,
+// e.g. to wait until `pg_isready` succeeds. The healthcheck is retried every second.
+// Returns an error containing the tail of the command's output and the healthcheck's most recent
+// output if the command exits or timeout passes first.
+func (h *ExecHandle) WaitForExec(timeout time.Duration, opts ...exec.Opt) error {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	var lastCheck *ExecHandle
+	for {
+		checkH, err := h.runtime.StartWithContext(ctx, opts...)
+		if err != nil {
+			if ctx.Err() != nil {
+				break
+			}
+			return fmt.Errorf("error starting healthcheck: %w", err)
+		}
+		lastCheck = checkH
+		var res *executorv1.ExecResponse
+		select {
+		case <-checkH.Done():
+			res, err = checkH.Wait()
+		case <-h.doneC:
+		}
+		select {
+		case <-h.doneC:
+			return fmt.Errorf("error command exited before healthcheck passed%s%s", h.formatTail(), lastCheck.formatTailTitled("Healthcheck output"))
+		default:
+		}
+		if err == nil && res != nil && res.ExitCode == 0 && !res.TimedOut {
+			return nil
+		}
+		if ctx.Err() != nil {
+			break
+		}
+		select {
+		case <-time.After(waitPollInterval):
+		case <-ctx.Done():
+		case <-h.doneC:
+		}
+	}
+	return fmt.Errorf("error healthcheck did not pass within %s%s%s", timeout, h.formatTail(), lastCheck.formatTailTitled("Healthcheck output"))
+}
+
+// formatTail formats the most recent lines of the command's output for inclusion in an error.
+func (h *ExecHandle) formatTail() string {
+	return h.formatTailTitled("Output tail")
+}
+
+// formatTailTitled is like formatTail, but with a custom title. Returns an empty string if h is nil.
+func (h *ExecHandle) formatTailTitled(title string) string {
+	if h == nil {
+		return ""
+	}
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return formatTail(title, h.tail)
+}
+
+// portProbeCommand returns a command that exits once a process is listening on port.
+func (c *Runtime) portProbeCommand(port int) (string, []string) {
+	os := c.remoteSysInfo.GetOs()
+	if c.runtimeType == executorv1.RuntimeType_RUNTIME_DOCKER && os != "windows" {
+		os = "linux"
+	}
+	switch os {
+	case "windows":
+		return "powershell", []string{"-NoProfile", "-Command", fmt.Sprintf(
+			"while (-not (Get-NetTCPConnection -State Listen -LocalPort %d -ErrorAction SilentlyContinue)) { Start-Sleep -Seconds 1 }", port)}
+	case "darwin":
+		return "/bin/sh", []string{"-c", fmt.Sprintf(
+			"while ! lsof -nP -iTCP:%d -sTCP:LISTEN >/dev/null 2>&1; do sleep 1; done", port)}
+	default:
+		// Listening sockets have state 0A, and their local address port is hex encoded.
+		return "/bin/sh", []string{"-c", fmt.Sprintf(
+			"while ! grep -qsE ':%04X [0-9A-F]+:[0-9A-F]+ 0A' /proc/net/tcp /proc/net/tcp6; do sleep 1; done", port)}
+	}
+}
+
+// lineMatcher is a writer that calls onMatch when a line written to it matches re.
+// Only the last maxMatchLineSize bytes of very long lines are matched.
+type lineMatcher struct {
+	re      *regexp.Regexp
+	onMatch func()
+	buf     []byte
+}
+
+func (m *lineMatcher) Write(p []byte) (int, error) {
+	m.buf = append(m.buf, p...)
+	for {
+		i := bytes.IndexByte(m.buf, '\n')
+		if i < 0 {
+			break
+		}
+		m.match(m.buf[:i])
+		m.buf = m.buf[i+1:]
+	}
+	if len(m.buf) > maxMatchLineSize {
+		m.buf = append([]byte(nil), m.buf[len(m.buf)-maxMatchLineSize:]...)
+	}
+	// Match incomplete lines too, as prompts and progress output may not be newline terminated.
+	m.match(m.buf)
+	return len(p), nil
+}
+
+// Flush matches any buffered incomplete line.
+func (m *lineMatcher) Flush() {
+	m.match(m.buf)
+}
+
+func (m *lineMatcher) match(line []byte) {
+	if m.re.Match(bytes.TrimSuffix(line, []byte("\r"))) {
+		m.onMatch()
+	}
+}
+
+// formatTail formats the last maxTailLines lines of output under a title.
+// Returns an empty string if there is no output.
+func formatTail(title string, output []byte) string {
+	text := strings.TrimRight(string(output), "\r\n")
+	if text == "" {
+		return ""
+	}
+	lines := strings.Split(text, "\n")
+	if len(lines) > maxTailLines {
+		lines = lines[len(lines)-maxTailLines:]
+	}
+	return fmt.Sprintf("\n%s:\n%s", title, strings.Join(lines, "\n"))
+}
@@ -0,0 +1,217 @@
+package knita
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	directorv1 "github.com/knita-io/knita/api/director/v1"
+	builtinv1 "github.com/knita-io/knita/api/events/builtin/v1"
+	eventsv1 "github.com/knita-io/knita/api/events/v1"
+	executorv1 "github.com/knita-io/knita/api/executor/v1"
+	"github.com/knita-io/knita/sdk/go/knita/runtime/exec"
+)
+
+func TestWaitForOutput(t *testing.T) {
+	re := regexp.MustCompile(`^listening on :\d+$`)
+
+	t.Run("matches earlier output", func(t *testing.T) {
+		h := newTestExecHandle(nil)
+		h.writeOutput([]byte("starting\nlistening on :8080\n"), false)
+		if err := h.WaitForOutput(re, time.Second); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	t.Run("matches later output split across writes", func(t *testing.T) {
+		h := newTestExecHandle(nil)
+		go func() {
+			time.Sleep(50 * time.Millisecond)
+			h.writeOutput([]byte("starting\nlisten"), true)
+			h.writeOutput([]byte("ing on :80\r\n"), true)
+		}()
+		if err := h.WaitForOutput(re, 5*time.Second); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	t.Run("times out", func(t *testing.T) {
+		h := newTestExecHandle(nil)
+		h.writeOutput([]byte("starting\nlistening on :8080 (not really)\n"), false)
+		err := h.WaitForOutput(re, 50*time.Millisecond)
+		if err == nil || !strings.Contains(err.Error(), "did not match") || !strings.Contains(err.Error(), "starting") {
+			t.Fatalf("expected timeout error containing the output tail, got: %v", err)
+		}
+	})
+
+	t.Run("command exits", func(t *testing.T) {
+		h := newTestExecHandle(nil)
+		h.writeOutput([]byte("fatal: address in use\n"), true)
+		close(h.doneC)
+		err := h.WaitForOutput(re, 5*time.Second)
+		if err == nil || !strings.Contains(err.Error(), "exited") || !strings.Contains(err.Error(), "address in use") {
+			t.Fatalf("expected exit error containing the output tail, got: %v", err)
+		}
+	})
+}
+
+func TestLineMatcherBoundsIncompleteLines(t *testing.T) {
+	var matched bool
+	m := &lineMatcher{re: regexp.MustCompile(`ready$`), onMatch: func() { matched = true }}
+	chunk := []byte(strings.Repeat("x", 1024))
+	for i := 0; i < 1024; i++ {
+		m.Write(chunk)
+	}
+	if len(m.buf) > maxMatchLineSize {
+		t.Fatalf("expected at most %d bytes buffered, got %d", maxMatchLineSize, len(m.buf))
+	}
+	m.Write([]byte("ready"))
+	if !matched {
+		t.Fatal("expected the end of the long line to match")
+	}
+}
+
+func TestWaitForExec(t *testing.T) {
+	t.Run("passes once healthcheck succeeds", func(t *testing.T) {
+		var attempts int
+		h := newTestExecHandle(func(opts *executorv1.ExecOpts) (string, *builtinv1.ExecResult) {
+			attempts++
+			if attempts == 1 {
+				return "no response", &builtinv1.ExecResult{ExitCode: 2}
+			}
+			return "accepting connections", &builtinv1.ExecResult{}
+		})
+		if err := h.WaitForExec(5*time.Second, exec.WithCommand("pg_isready")); err != nil {
+			t.Fatal(err)
+		}
+		if attempts != 2 {
+			t.Fatalf("expected 2 healthcheck attempts, got %d", attempts)
+		}
+	})
+
+	t.Run("times out", func(t *testing.T) {
+		h := newTestExecHandle(func(opts *executorv1.ExecOpts) (string, *builtinv1.ExecResult) {
+			return "no response", &builtinv1.ExecResult{ExitCode: 2}
+		})
+		err := h.WaitForExec(100*time.Millisecond, exec.WithCommand("pg_isready"))
+		if err == nil || !strings.Contains(err.Error(), "did not pass") || !strings.Contains(err.Error(), "no response") {
+			t.Fatalf("expected timeout error containing the healthcheck output, got: %v", err)
+		}
+	})
+
+	t.Run("command exits", func(t *testing.T) {
+		h := newTestExecHandle(func(opts *executorv1.ExecOpts) (string, *builtinv1.ExecResult) {
+			return "", &builtinv1.ExecResult{ExitCode: 2}
+		})
+		close(h.doneC)
+		err := h.WaitForExec(5*time.Second, exec.WithCommand("pg_isready"))
+		if err == nil || !strings.Contains(err.Error(), "exited") {
+			t.Fatalf("expected exit error, got: %v", err)
+		}
+	})
+}
+
+func TestWaitForPort(t *testing.T) {
+	var table = []struct {
+		res *builtinv1.ExecResult
+		err string
+	}{
+		{res: &builtinv1.ExecResult{}},
+		{res: &builtinv1.ExecResult{ExitCode: -1, TimedOut: true}, err: "was not listening within"},
+		{res: &builtinv1.ExecResult{ExitCode: 127}, err: "probe exited with code 127"},
+	}
+	for _, test := range table {
+		var command []string
+		h := newTestExecHandle(func(opts *executorv1.ExecOpts) (string, *builtinv1.ExecResult) {
+			command = append([]string{opts.Name}, opts.Args...)
+			return "", test.res
+		})
+		err := h.WaitForPort(8080, time.Second)
+		if test.err == "" && err != nil {
+			t.Fatal(err)
+		}
+		if test.err != "" && (err == nil || !strings.Contains(err.Error(), test.err)) {
+			t.Fatalf("expected error containing %q, got: %v", test.err, err)
+		}
+		// Docker runtimes are assumed to run Linux containers, where port 8080 is 1F90 in /proc/net/tcp.
+		if len(command) != 3 || command[0] != "/bin/sh" || !strings.Contains(command[2], ":1F90 ") {
+			t.Fatalf("unexpected probe command: %v", command)
+		}
+	}
+}
+
+// newTestExecHandle returns a handle to a running command in a Docker runtime. Subsequent execs in the
+// runtime write the output returned by exec and end with its result.
+func newTestExecHandle(execFunc func(opts *executorv1.ExecOpts) (string, *builtinv1.ExecResult)) *ExecHandle {
+	rt := &Runtime{
+		runtimeID:     "runtime",
+		runtimeType:   executorv1.RuntimeType_RUNTIME_DOCKER,
+		remoteSysInfo: &executorv1.SystemInfo{Os: "darwin"},
+		client:        &testDirectorClient{exec: execFunc},
+	}
+	return newExecHandle(rt, &exec.Opts{ExecOpts: &executorv1.ExecOpts{}})
+}
+
+// testDirectorClient is a director client that fakes execs.
+type testDirectorClient struct {
+	directorv1.DirectorClient
+	mu    sync.Mutex
+	execs int
+	exec  func(opts *executorv1.ExecOpts) (string, *builtinv1.ExecResult)
+}
+
+func (c *testDirectorClient) Exec(ctx context.Context, _ ...grpc.CallOption) (directorv1.Director_ExecClient, error) {
+	return &testExecStream{ctx: ctx, client: c}, nil
+}
+
+type testExecStream struct {
+	grpc.ClientStream
+	ctx    context.Context
+	client *testDirectorClient
+	events []proto.Message
+}
+
+func (s *testExecStream) Send(req *directorv1.ExecRequest) error {
+	if req.Opts == nil {
+		return nil
+	}
+	s.client.mu.Lock()
+	s.client.execs++
+	execID := fmt.Sprintf("exec-%d", s.client.execs)
+	output, res := s.client.exec(req.Opts)
+	s.client.mu.Unlock()
+	s.events = []proto.Message{
+		&builtinv1.ExecStartEvent{ExecId: execID},
+		&builtinv1.StdoutEvent{Data: []byte(output)},
+		&builtinv1.ExecEndEvent{ExecId: execID, Status: &builtinv1.ExecEndEvent_Result{Result: res}},
+	}
+	return nil
+}
+
+func (s *testExecStream) CloseSend() error {
+	return nil
+}
+
+func (s *testExecStream) Recv() (*eventsv1.Event, error) {
+	if s.ctx.Err() != nil {
+		return nil, s.ctx.Err()
+	}
+	if len(s.events) == 0 {
+		return nil, io.EOF
+	}
+	payload, err := anypb.New(s.events[0])
+	if err != nil {
+		return nil, err
+	}
+	s.events = s.events[1:]
+	return &eventsv1.Event{Payload: payload}, nil
+}
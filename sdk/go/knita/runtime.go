@@ -7,12 +7,10 @@ import (
 	"io"
 	"path/filepath"
 
-	"google.golang.org/protobuf/proto"
-	"google.golang.org/protobuf/reflect/protoregistry"
-	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	directorv1 "github.com/knita-io/knita/api/director/v1"
-	builtinv1 "github.com/knita-io/knita/api/events/builtin/v1"
 	executorv1 "github.com/knita-io/knita/api/executor/v1"
 	"github.com/knita-io/knita/sdk/go/knita/runtime/exec"
 	"github.com/knita-io/knita/sdk/go/knita/runtime/export"
@@ -26,6 +24,7 @@ type Runtime struct {
 	runtimeID           string
 	remoteWorkDirectory string
 	remoteSysInfo       *executorv1.SystemInfo
+	runtimeType         executorv1.RuntimeType
 	client              directorv1.DirectorClient
 }
 
@@ -49,9 +48,15 @@ func (c *Runtime) WorkDirectory(path string) string {
 	return filepath.Join(c.remoteWorkDirectory, path)
 }
 
+// ErrChecksumMismatch is returned by imports and exports when a transferred file fails verification,
+// indicating it was corrupted in transit.
+var ErrChecksumMismatch = errors.New("error checksum mismatch")
+
 // Import files from the local work directory into the runtime's remote work directory.
 // src must be a relative path, and may be a glob (doublestar syntax supported).
 // By default, all files identified by src will be copied to their original location on the remote. Use the import_.WithDest  option to override this.
+// Files the runtime already holds with identical content are skipped, and every imported file is verified against
+// its SHA-256 digest. Use ImportWithResponse to find out which files were imported.
 func (c *Runtime) Import(src string, opts ...import_.Opt) error {
 	return c.ImportWithContext(context.Background(), src, opts...)
 }
@@ -66,18 +71,29 @@ func (c *Runtime) MustImport(src string, opts ...import_.Opt) {
 
 // ImportWithContext is like Import, but it allows a context to be set.
 func (c *Runtime) ImportWithContext(ctx context.Context, src string, opts ...import_.Opt) error {
+	_, err := c.ImportWithResponse(ctx, src, opts...)
+	return err
+}
+
+// ImportWithResponse is like ImportWithContext, but it returns the ImportResponse, which lists the verified
+// digests of the imported files, and reports how many files were skipped as the runtime already held them.
+func (c *Runtime) ImportWithResponse(ctx context.Context, src string, opts ...import_.Opt) (*directorv1.ImportResponse, error) {
 	o := &directorv1.ImportOpts{}
 	for _, opt := range opts {
 		opt(o)
 	}
 	o.SrcPath = src
-	_, err := c.client.Import(ctx, &directorv1.ImportRequest{RuntimeId: c.runtimeID, Opts: o})
-	return err
+	res, err := c.client.Import(ctx, &directorv1.ImportRequest{RuntimeId: c.runtimeID, Opts: o})
+	if err != nil {
+		return nil, transferError(err)
+	}
+	return res, nil
 }
 
 // Export files from the runtime's remote work directory into the local work directory.
 // src must be a relative path, and may be a glob (doublestar syntax supported).
 // By default, all files identified by src will be copied to their original location locally. Use the export.WithDest option to override this.
+// Every exported file is verified against its SHA-256 digest. Use ExportWithResponse to find out which files were exported.
 func (c *Runtime) Export(src string, opts ...export.Opt) error {
 	return c.ExportWithContext(context.Background(), src, opts...)
 }
@@ -92,17 +108,36 @@ func (c *Runtime) MustExport(src string, opts ...export.Opt) {
 
 // ExportWithContext is like Export, but it allows a context to be set.
 func (c *Runtime) ExportWithContext(ctx context.Context, src string, opts ...export.Opt) error {
+	_, err := c.ExportWithResponse(ctx, src, opts...)
+	return err
+}
+
+// ExportWithResponse is like ExportWithContext, but it returns the ExportResponse, which lists the verified
+// digests of the exported files.
+func (c *Runtime) ExportWithResponse(ctx context.Context, src string, opts ...export.Opt) (*directorv1.ExportResponse, error) {
 	o := &directorv1.ExportOpts{}
 	for _, opt := range opts {
 		opt(o)
 	}
 	o.SrcPath = src
-	_, err := c.client.Export(ctx, &directorv1.ExportRequest{RuntimeId: c.runtimeID, Opts: o})
+	res, err := c.client.Export(ctx, &directorv1.ExportRequest{RuntimeId: c.runtimeID, Opts: o})
+	if err != nil {
+		return nil, transferError(err)
+	}
+	return res, nil
+}
+
+// transferError wraps a failed import or export's error in ErrChecksumMismatch if a file failed verification.
+func transferError(err error) error {
+	if status.Code(err) == codes.DataLoss {
+		return fmt.Errorf("%w: %s", ErrChecksumMismatch, status.Convert(err).Message())
+	}
 	return err
 }
 
 // Exec executes a command inside the remote runtime.
-// Check the returned ExecResponse to see the command's exit code (a non-zero code is not an error).
+// Check the returned ExecResponse to see the command's exit code (a non-zero code is not an error) and resource usage.
+// Resource usage in Docker runtimes is container wide, so includes any other processes running in the container.
 func (c *Runtime) Exec(opts ...exec.Opt) (*executorv1.ExecResponse, error) {
 	return c.ExecWithContext(context.Background(), opts...)
 }
@@ -113,6 +148,9 @@ func (c *Runtime) MustExec(opts ...exec.Opt) *executorv1.ExecResponse {
 	if err != nil {
 		c.fatalFunc(fmt.Errorf("error execing: %w", err))
 	}
+	if res.TimedOut {
+		c.fatalFunc(fmt.Errorf("error command timed out"))
+	}
 	if res.ExitCode != 0 {
 		c.fatalFunc(fmt.Errorf("error non-zero exit code"))
 	}
@@ -120,58 +158,111 @@ func (c *Runtime) MustExec(opts ...exec.Opt) *executorv1.ExecResponse {
 }
 
 // ExecWithContext is like Exec, but it allows a context to be set.
+// Cancelling the context terminates the remote command and any processes it spawned.
 func (c *Runtime) ExecWithContext(ctx context.Context, opts ...exec.Opt) (*executorv1.ExecResponse, error) {
+	h, err := c.StartWithContext(ctx, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return h.Wait()
+}
+
+// Start starts a command inside the remote runtime without waiting for it to exit, e.g. to start a server
+// that subsequent execs in the same runtime will test against. Use the returned ExecHandle to wait for,
+// signal, stop, or subscribe to the output of the command. Commands still running when the runtime is
+// closed are killed.
+func (c *Runtime) Start(opts ...exec.Opt) (*ExecHandle, error) {
+	return c.StartWithContext(context.Background(), opts...)
+}
+
+// StartWithContext is like Start, but it allows a context to be set.
+// Cancelling the context terminates the remote command and any processes it spawned.
+func (c *Runtime) StartWithContext(ctx context.Context, opts ...exec.Opt) (*ExecHandle, error) {
 	o := &exec.Opts{ExecOpts: &executorv1.ExecOpts{}}
 	for _, opt := range opts {
 		opt(o)
 	}
+	o.ExecOpts.Stdin = o.Stdin != nil
 	ctx, cancel := context.WithCancel(ctx)
-	defer cancel()
-	stream, err := c.client.Exec(ctx, &directorv1.ExecRequest{RuntimeId: c.runtimeID, Opts: o.ExecOpts})
+	stream, err := c.client.Exec(ctx)
 	if err != nil {
+		cancel()
 		return nil, fmt.Errorf("error in exec: %w", err)
 	}
-	var execEnd *executorv1.ExecResponse
+	err = stream.Send(&directorv1.ExecRequest{RuntimeId: c.runtimeID, Opts: o.ExecOpts})
+	if err != nil {
+		cancel()
+		return nil, fmt.Errorf("error sending exec request: %w", err)
+	}
+	if o.Stdin != nil {
+		go c.sendStdin(stream, o.Stdin)
+	} else if err := stream.CloseSend(); err != nil {
+		cancel()
+		return nil, fmt.Errorf("error closing exec stream: %w", err)
+	}
+	h := newExecHandle(c, o)
+	go func() {
+		defer close(h.doneC)
+		defer cancel()
+		h.res, h.err = h.receive(ctx, stream)
+	}()
+	select {
+	case <-h.startC:
+		return h, nil
+	case <-h.doneC:
+		if h.err == nil {
+			return nil, fmt.Errorf("error exec ended before it was started")
+		}
+		return nil, h.err
+	}
+}
+
+// sendStdin streams stdin to the exec in chunks, closing the send side of the stream once stdin reaches EOF.
+func (c *Runtime) sendStdin(stream directorv1.Director_ExecClient, stdin io.Reader) {
+	buf := make([]byte, 32*1024)
 	for {
-		msg, err := stream.Recv()
-		if err != nil {
-			if errors.Is(err, io.EOF) {
-				if execEnd != nil {
-					return execEnd, nil
-				}
-				return nil, fmt.Errorf("error stream closed before exec end event was observed")
+		n, err := stdin.Read(buf)
+		if n > 0 {
+			if err := stream.Send(&directorv1.ExecRequest{Stdin: buf[:n]}); err != nil {
+				return
 			}
-			return nil, err
-		}
-		if msg.Payload == nil {
-			continue
 		}
-		payload, err := anypb.UnmarshalNew(msg.Payload, proto.UnmarshalOptions{})
 		if err != nil {
-			if errors.Is(err, protoregistry.NotFound) {
-				continue
-			} else {
-				return nil, err
-			}
-		}
-		switch p := payload.(type) {
-		case *builtinv1.StdoutEvent:
-			if o.Stdout != nil {
-				o.Stdout.Write(p.Data)
-			}
-		case *builtinv1.StderrEvent:
-			if o.Stderr != nil {
-				o.Stderr.Write(p.Data)
-			}
-		case *builtinv1.ExecEndEvent:
-			switch res := p.Status.(type) {
-			case *builtinv1.ExecEndEvent_Result:
-				execEnd = &executorv1.ExecResponse{ExitCode: res.Result.ExitCode}
-			case *builtinv1.ExecEndEvent_Error:
-				return nil, fmt.Errorf("error in exec: %v", res.Error.Message)
+			if !errors.Is(err, io.EOF) {
+				c.syslog.Printf("Closing exec stdin early due to read error: %v", err)
 			}
+			break
 		}
 	}
+	stream.CloseSend()
+}
+
+// Pause pauses the build at a breakpoint so the runtime can be inspected, e.g. with knita attach.
+// The runtime is kept alive while paused. Blocks until the build is resumed with knita resume,
+// or the build's pause timeout expires. Returns an error if the build is aborted with knita abort.
+func (c *Runtime) Pause(reason string) error {
+	return c.PauseWithContext(context.Background(), reason)
+}
+
+// MustPause is like Pause, but it calls the configured FatalFunc if an error occurs or the build is aborted.
+func (c *Runtime) MustPause(reason string) {
+	err := c.Pause(reason)
+	if err != nil {
+		c.fatalFunc(fmt.Errorf("error pausing build: %w", err))
+	}
+}
+
+// PauseWithContext is like Pause, but it allows a context to be set.
+// Cancelling the context ends the pause with an error.
+func (c *Runtime) PauseWithContext(ctx context.Context, reason string) error {
+	res, err := c.client.Pause(ctx, &directorv1.PauseRequest{RuntimeId: c.runtimeID, Reason: reason})
+	if err != nil {
+		return err
+	}
+	if res.Aborted {
+		return fmt.Errorf("error build aborted")
+	}
+	return nil
 }
 
 // Close the runtime. After a call to close the runtime can no longer be used.
@@ -2,6 +2,10 @@ package exec
 
 import (
 	"io"
+	"os"
+	"time"
+
+	"google.golang.org/protobuf/types/known/durationpb"
 
 	executorv1 "github.com/knita-io/knita/api/executor/v1"
 	"github.com/knita-io/knita/sdk/go/knita/runtime"
@@ -29,6 +33,49 @@ func WithCommand(name string, args ...string) Opt {
 	}
 }
 
+// WithScript runs script with the runtime's shell instead of a command name and args,
+// e.g. "go test ./... | tee test.log". See runtime.WithShell.
+func WithScript(script string) Opt {
+	return func(o *Opts) {
+		if o.ExecOpts == nil {
+			o.ExecOpts = &executorv1.ExecOpts{}
+		}
+		o.Script = script
+	}
+}
+
+// WithWorkDir runs the command in dir, which is relative to the runtime's work directory.
+func WithWorkDir(dir string) Opt {
+	return func(o *Opts) {
+		if o.ExecOpts == nil {
+			o.ExecOpts = &executorv1.ExecOpts{}
+		}
+		o.WorkDir = dir
+	}
+}
+
+// WithUser runs the command as user, in the form "user", "user:group", "uid" or "uid:gid".
+func WithUser(user string) Opt {
+	return func(o *Opts) {
+		if o.ExecOpts == nil {
+			o.ExecOpts = &executorv1.ExecOpts{}
+		}
+		o.User = user
+	}
+}
+
+// WithTty runs the command in a pseudo-terminal of the given size, so that tools which detect a terminal
+// produce interactive output e.g. colors and progress bars. Zero rows or cols use the defaults of 24 and 80.
+// The terminal's output, including anything the command writes to stderr, is delivered as stdout.
+func WithTty(rows uint32, cols uint32) Opt {
+	return func(o *Opts) {
+		if o.ExecOpts == nil {
+			o.ExecOpts = &executorv1.ExecOpts{}
+		}
+		o.Tty = &executorv1.TtyOpts{Rows: rows, Cols: cols}
+	}
+}
+
 // WithStdout directs stdout to the provided writer.
 func WithStdout(w io.Writer) Opt {
 	return func(o *Opts) {
@@ -43,6 +90,41 @@ func WithStderr(w io.Writer) Opt {
 	}
 }
 
+// WithStdin streams r to the command's stdin until r returns EOF.
+func WithStdin(r io.Reader) Opt {
+	return func(o *Opts) {
+		o.Stdin = r
+	}
+}
+
+// WithTimeout terminates the command if it has not exited within timeout.
+// Check the returned ExecResponse's TimedOut field to see if the timeout was exceeded.
+func WithTimeout(timeout time.Duration) Opt {
+	return func(o *Opts) {
+		if o.ExecOpts == nil {
+			o.ExecOpts = &executorv1.ExecOpts{}
+		}
+		o.Timeout = durationpb.New(timeout)
+	}
+}
+
+// WithTermination sets the signal sent to the command's processes when it is cancelled or times out,
+// and how long they are given to exit before being killed. Defaults to SIGTERM and 10s.
+// Panics if sig is not supported.
+func WithTermination(sig os.Signal, gracePeriod time.Duration) Opt {
+	name, err := runtime.SignalName(sig)
+	if err != nil {
+		panic("WithTermination: " + err.Error())
+	}
+	return func(o *Opts) {
+		if o.ExecOpts == nil {
+			o.ExecOpts = &executorv1.ExecOpts{}
+		}
+		o.TerminationSignal = name
+		o.TerminationGracePeriod = durationpb.New(gracePeriod)
+	}
+}
+
 // WithEnv adds environment variables (e.g. "KEY=VALUE").
 func WithEnv(env ...string) Opt {
 	return func(o *Opts) {
@@ -22,6 +22,31 @@ func WithExcludes(excludes ...string) Opt {
 	}
 }
 
+// WithStreams sets the number of concurrent streams to split the export across, up to the executor's maximum.
+// Defaults to a number based on the number and size of the files to export. Use 1 to export sequentially.
+func WithStreams(streams int) Opt {
+	return func(o *directorv1.ExportOpts) {
+		o.Streams = uint32(streams)
+	}
+}
+
+// WithArchive packs the exported files into an archive at the destination path (which must be set with WithDest),
+// rather than writing them to the local work directory. The archive's format is determined by its extension:
+// .tar, .tar.gz, .tgz or .zip.
+func WithArchive() Opt {
+	return func(o *directorv1.ExportOpts) {
+		o.Archive = true
+	}
+}
+
+// WithStrictSymlinks fails the export if it encounters a symlink whose target is absolute, or resolves outside of
+// the destination path. By default, such symlinks are skipped with a warning.
+func WithStrictSymlinks() Opt {
+	return func(o *directorv1.ExportOpts) {
+		o.StrictSymlinks = true
+	}
+}
+
 // WithDisplayName sets the display name for the export.
 func WithDisplayName(displayName string) Opt {
 	return func(o *directorv1.ExportOpts) {
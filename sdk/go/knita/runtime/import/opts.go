@@ -22,6 +22,54 @@ func WithExcludes(excludes ...string) Opt {
 	}
 }
 
+// WithDeleteExtraneous deletes files and directories from the runtime that do not exist locally,
+// within the directories being imported, rsync-style. Excluded paths are never deleted.
+func WithDeleteExtraneous() Opt {
+	return func(o *directorv1.ImportOpts) {
+		o.DeleteExtraneous = true
+	}
+}
+
+// WithStreams sets the number of concurrent streams to split the import across, up to the executor's maximum.
+// Defaults to a number based on the number and size of the files to import. Use 1 to import sequentially.
+func WithStreams(streams int) Opt {
+	return func(o *directorv1.ImportOpts) {
+		o.Streams = uint32(streams)
+	}
+}
+
+// WithIncludes limits the import to files and directories that match, or are within a directory that matches,
+// one of includes. Excludes take precedence over includes.
+func WithIncludes(includes ...string) Opt {
+	return func(o *directorv1.ImportOpts) {
+		o.Includes = append(o.Includes, includes...)
+	}
+}
+
+// WithGitignore skips files and directories that are ignored by .gitignore files in the directories being imported.
+// Paths ignored by .knitaignore files, which use the same syntax, are always skipped.
+func WithGitignore() Opt {
+	return func(o *directorv1.ImportOpts) {
+		o.Gitignore = true
+	}
+}
+
+// WithStrictSymlinks fails the import if it encounters a symlink whose target is absolute, or resolves outside of
+// the destination path. By default, such symlinks are skipped with a warning.
+func WithStrictSymlinks() Opt {
+	return func(o *directorv1.ImportOpts) {
+		o.StrictSymlinks = true
+	}
+}
+
+// WithArchive imports the contents of the local archive at the import's src path, which is extracted into the
+// destination path in the runtime. The archive's format is determined by its extension: .tar, .tar.gz, .tgz or .zip.
+func WithArchive() Opt {
+	return func(o *directorv1.ImportOpts) {
+		o.Archive = true
+	}
+}
+
 // WithDisplayName sets the display name for the import.
 func WithDisplayName(displayName string) Opt {
 	return func(o *directorv1.ImportOpts) {
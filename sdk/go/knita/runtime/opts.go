@@ -1,6 +1,10 @@
 package runtime
 
 import (
+	"time"
+
+	"google.golang.org/protobuf/types/known/durationpb"
+
 	directorv1 "github.com/knita-io/knita/api/director/v1"
 	executorv1 "github.com/knita-io/knita/api/executor/v1"
 )
@@ -94,6 +98,147 @@ func WithImage(imageURI string) Opt {
 	}
 }
 
+// WithDockerfile builds the Docker image from a Dockerfile rather than pulling it.
+// contextPath is the path of the build context relative to the work directory, and dockerfile
+// is the path of the Dockerfile relative to the build context (defaults to "Dockerfile").
+// The build context is imported into the executor, and images are cached by a fingerprint of
+// the build context, so unchanged contexts are not rebuilt.
+func WithDockerfile(contextPath string, dockerfile string) Opt {
+	return func(o *directorv1.OpenRequest) {
+		if o.Opts.Opts == nil {
+			o.Opts.Opts = &executorv1.RuntimeOpts_Docker{Docker: &executorv1.DockerOpts{}}
+		}
+		d := o.Opts.GetDocker()
+		if d.Build == nil {
+			d.Build = &executorv1.DockerBuildOpts{}
+		}
+		d.Build.ContextPath = contextPath
+		d.Build.Dockerfile = dockerfile
+	}
+}
+
+// WithBuildArg sets a Docker build arg. Must be combined with WithDockerfile.
+func WithBuildArg(key string, value string) Opt {
+	return func(o *directorv1.OpenRequest) {
+		if o.Opts.Opts == nil {
+			o.Opts.Opts = &executorv1.RuntimeOpts_Docker{Docker: &executorv1.DockerOpts{}}
+		}
+		d := o.Opts.GetDocker()
+		if d.Build == nil {
+			d.Build = &executorv1.DockerBuildOpts{}
+		}
+		if d.Build.BuildArgs == nil {
+			d.Build.BuildArgs = make(map[string]string)
+		}
+		d.Build.BuildArgs[key] = value
+	}
+}
+
+// WithBuildTarget sets the Dockerfile stage to build. Must be combined with WithDockerfile.
+func WithBuildTarget(target string) Opt {
+	return func(o *directorv1.OpenRequest) {
+		if o.Opts.Opts == nil {
+			o.Opts.Opts = &executorv1.RuntimeOpts_Docker{Docker: &executorv1.DockerOpts{}}
+		}
+		d := o.Opts.GetDocker()
+		if d.Build == nil {
+			d.Build = &executorv1.DockerBuildOpts{}
+		}
+		d.Build.Target = target
+	}
+}
+
+// WithUser runs the Docker container as user, in the form "user", "user:group", "uid" or "uid:gid".
+// Additional groups may be specified via groups.
+func WithUser(user string, groups ...string) Opt {
+	return func(o *directorv1.OpenRequest) {
+		d := dockerOpts(o)
+		d.User = user
+		d.GroupAdd = append(d.GroupAdd, groups...)
+	}
+}
+
+// WithCapAdd adds Linux capabilities to the Docker container e.g. "NET_ADMIN".
+func WithCapAdd(capabilities ...string) Opt {
+	return func(o *directorv1.OpenRequest) {
+		d := dockerOpts(o)
+		d.CapAdd = append(d.CapAdd, capabilities...)
+	}
+}
+
+// WithCapDrop drops Linux capabilities from the Docker container. "ALL" drops all capabilities.
+func WithCapDrop(capabilities ...string) Opt {
+	return func(o *directorv1.OpenRequest) {
+		d := dockerOpts(o)
+		d.CapDrop = append(d.CapDrop, capabilities...)
+	}
+}
+
+// WithPrivileged runs the Docker container in privileged mode.
+func WithPrivileged() Opt {
+	return func(o *directorv1.OpenRequest) {
+		dockerOpts(o).Privileged = true
+	}
+}
+
+// WithReadOnlyRootfs mounts the Docker container's root filesystem as read only.
+// The work directory remains writable.
+func WithReadOnlyRootfs() Opt {
+	return func(o *directorv1.OpenRequest) {
+		dockerOpts(o).ReadOnlyRootfs = true
+	}
+}
+
+// WithTmpfs mounts a tmpfs at path inside the Docker container, with optional mount options e.g. "rw,size=64m".
+func WithTmpfs(path string, options string) Opt {
+	return func(o *directorv1.OpenRequest) {
+		d := dockerOpts(o)
+		if d.Tmpfs == nil {
+			d.Tmpfs = make(map[string]string)
+		}
+		d.Tmpfs[path] = options
+	}
+}
+
+// WithShmSize sets the size of the Docker container's /dev/shm in bytes.
+func WithShmSize(bytes int64) Opt {
+	return func(o *directorv1.OpenRequest) {
+		dockerOpts(o).ShmSize = bytes
+	}
+}
+
+type NetworkMode string
+
+const (
+	NetworkModeBridge NetworkMode = "bridge"
+	NetworkModeHost   NetworkMode = "host"
+	// NetworkModeNone disables networking, which is useful for hermetic builds.
+	NetworkModeNone NetworkMode = "none"
+)
+
+// WithNetworkMode sets the network mode of the Docker container.
+func WithNetworkMode(mode NetworkMode) Opt {
+	return func(o *directorv1.OpenRequest) {
+		dockerOpts(o).NetworkMode = string(mode)
+	}
+}
+
+// WithDockerSocket mounts the executor host's Docker socket into the Docker container,
+// allowing it to run Docker commands. The executor may forbid this by policy.
+func WithDockerSocket() Opt {
+	return func(o *directorv1.OpenRequest) {
+		dockerOpts(o).MountDockerSocket = true
+	}
+}
+
+// dockerOpts returns the Docker opts of o, initializing them if necessary.
+func dockerOpts(o *directorv1.OpenRequest) *executorv1.DockerOpts {
+	if o.Opts.Opts == nil {
+		o.Opts.Opts = &executorv1.RuntimeOpts_Docker{Docker: &executorv1.DockerOpts{}}
+	}
+	return o.Opts.GetDocker()
+}
+
 type DockerPullStrategy string
 
 const (
@@ -168,6 +313,90 @@ func WithAWSECRAuth(region, accessKeyID, secretKey string) Opt {
 	}
 }
 
+// ServiceOpt configures a Docker service container.
+type ServiceOpt func(opts *executorv1.DockerServiceOpts)
+
+// WithService starts a sidecar container alongside a Docker runtime.
+// The service is reachable from the runtime by name, e.g. WithService("postgres", "postgres:16")
+// makes the service available at postgres:5432.
+func WithService(name string, imageURI string, opts ...ServiceOpt) Opt {
+	return func(o *directorv1.OpenRequest) {
+		if o.Opts.Opts == nil {
+			o.Opts.Opts = &executorv1.RuntimeOpts_Docker{Docker: &executorv1.DockerOpts{}}
+		}
+		d := o.Opts.GetDocker()
+		service := &executorv1.DockerServiceOpts{
+			Name:  name,
+			Image: &executorv1.DockerPullOpts{ImageUri: imageURI},
+		}
+		for _, opt := range opts {
+			opt(service)
+		}
+		d.Services = append(d.Services, service)
+	}
+}
+
+// WithServiceEnv adds environment variables (e.g. "KEY=VALUE") to the service container.
+func WithServiceEnv(env ...string) ServiceOpt {
+	return func(o *executorv1.DockerServiceOpts) {
+		o.Env = append(o.Env, env...)
+	}
+}
+
+// WithServiceAliases adds additional names the service is resolvable on.
+func WithServiceAliases(aliases ...string) ServiceOpt {
+	return func(o *executorv1.DockerServiceOpts) {
+		o.Aliases = append(o.Aliases, aliases...)
+	}
+}
+
+// WithServiceCommand overrides the service image's default command.
+func WithServiceCommand(command ...string) ServiceOpt {
+	return func(o *executorv1.DockerServiceOpts) {
+		o.Command = command
+	}
+}
+
+// WithServiceReadiness specifies a command that is executed inside the service container
+// every interval until it exits zero. The runtime will fail to open if the service is not
+// ready within timeout. Zero values select the executor's defaults.
+func WithServiceReadiness(interval time.Duration, timeout time.Duration, command ...string) ServiceOpt {
+	return func(o *executorv1.DockerServiceOpts) {
+		o.Readiness = &executorv1.DockerServiceReadiness{Command: command}
+		if interval > 0 {
+			o.Readiness.Interval = durationpb.New(interval)
+		}
+		if timeout > 0 {
+			o.Readiness.Timeout = durationpb.New(timeout)
+		}
+	}
+}
+
+// WithCache mounts the named persistent cache at path inside the runtime. Caches persist on the
+// executor between builds, e.g. for dependency downloads. Relative paths are relative to the runtime's
+// work directory. maxSize limits the size of the cache in bytes; zero uses the executor's default.
+func WithCache(name string, path string, maxSize int64) Opt {
+	return func(o *directorv1.OpenRequest) {
+		o.Opts.Caches = append(o.Opts.Caches, &executorv1.CacheMount{Name: name, Path: path, MaxSize: maxSize})
+	}
+}
+
+// WithEnv adds environment variables (e.g. "KEY=VALUE") set for every exec in the runtime.
+// Environment variables set on an exec take precedence.
+func WithEnv(env ...string) Opt {
+	return func(o *directorv1.OpenRequest) {
+		o.Opts.Env = append(o.Opts.Env, env...)
+	}
+}
+
+// WithShell sets the command line that exec scripts are run with, e.g. "bash", "-eo", "pipefail", "-c".
+// The script is appended as the final argument. Defaults to "/bin/sh", "-c", or "cmd", "/C" on Windows.
+func WithShell(shell ...string) Opt {
+	return func(o *directorv1.OpenRequest) {
+		o.Opts.Shell = shell
+	}
+}
+
 // WithDisplayName sets the display name for the runtime.
 func WithDisplayName(displayName string) Opt {
 	return func(o *directorv1.OpenRequest) {
@@ -0,0 +1,35 @@
+package runtime
+
+import (
+	"fmt"
+	"os"
+	"syscall"
+)
+
+// signalNames maps the signals available on every platform to their names.
+// Additional platform specific signals are registered in signal_unix.go.
+var signalNames = map[os.Signal]string{
+	syscall.SIGABRT: "SIGABRT",
+	syscall.SIGALRM: "SIGALRM",
+	syscall.SIGBUS:  "SIGBUS",
+	syscall.SIGFPE:  "SIGFPE",
+	syscall.SIGHUP:  "SIGHUP",
+	syscall.SIGILL:  "SIGILL",
+	syscall.SIGINT:  "SIGINT",
+	syscall.SIGKILL: "SIGKILL",
+	syscall.SIGPIPE: "SIGPIPE",
+	syscall.SIGQUIT: "SIGQUIT",
+	syscall.SIGSEGV: "SIGSEGV",
+	syscall.SIGTERM: "SIGTERM",
+	syscall.SIGTRAP: "SIGTRAP",
+}
+
+// SignalName returns the name of sig (e.g. "SIGQUIT") as understood by executors.
+// Signals refer to the executor's platform, not the platform the SDK is running on.
+func SignalName(sig os.Signal) (string, error) {
+	name, ok := signalNames[sig]
+	if !ok {
+		return "", fmt.Errorf("error unsupported signal: %v", sig)
+	}
+	return name, nil
+}
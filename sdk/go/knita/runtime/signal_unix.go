@@ -0,0 +1,15 @@
+//go:build !windows
+
+package runtime
+
+import "syscall"
+
+func init() {
+	signalNames[syscall.SIGCHLD] = "SIGCHLD"
+	signalNames[syscall.SIGCONT] = "SIGCONT"
+	signalNames[syscall.SIGSTOP] = "SIGSTOP"
+	signalNames[syscall.SIGTSTP] = "SIGTSTP"
+	signalNames[syscall.SIGUSR1] = "SIGUSR1"
+	signalNames[syscall.SIGUSR2] = "SIGUSR2"
+	signalNames[syscall.SIGWINCH] = "SIGWINCH"
+}
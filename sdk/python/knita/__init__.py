@@ -1,4 +1,6 @@
 from .client import Client
-from .runtime import RuntimeType, DockerPullStrategy, DockerBasicAuth, DockerAWSECRAuth
+from .runtime import RuntimeType, DockerPullStrategy, DockerBasicAuth, DockerAWSECRAuth, BuildAbortedException, \
+    ChecksumMismatchException
 
-__all__ = ['Client', 'RuntimeType', 'DockerPullStrategy', 'DockerBasicAuth', 'DockerAWSECRAuth']
+__all__ = ['Client', 'RuntimeType', 'DockerPullStrategy', 'DockerBasicAuth', 'DockerAWSECRAuth', 'BuildAbortedException',
+           'ChecksumMismatchException']
@@ -14,9 +14,10 @@ _sym_db = _symbol_database.Default()
 
 from . import executor_pb2 as executor_dot_v1_dot_executor__pb2
 from . import broker_pb2 as broker_dot_v1_dot_broker__pb2
+from google.protobuf import duration_pb2 as google_dot_protobuf_dot_duration__pb2
 
 
-DESCRIPTOR = _descriptor_pool.Default().AddSerializedFile(b'\n\x1f\x65vents/builtin/v1/builtin.proto\x12\x17\x62uiltin.events.knita.io\x1a\x1a\x65xecutor/v1/executor.proto\x1a\x16\x62roker/v1/broker.proto\"\x18\n\x05\x45rror\x12\x0f\n\x07message\x18\x01 \x01(\t\"P\n\x0c\x44irectorInfo\x12\x0f\n\x07version\x18\x01 \x01(\t\x12/\n\x08sys_info\x18\x02 \x01(\x0b\x32\x1d.executor.knita.io.SystemInfo\"a\n\x0f\x42uildStartEvent\x12\x10\n\x08\x62uild_id\x18\x01 \x01(\t\x12<\n\rdirector_info\x18\x02 \x01(\x0b\x32%.builtin.events.knita.io.DirectorInfo\"\r\n\x0b\x42uildResult\"\x94\x01\n\rBuildEndEvent\x12\x10\n\x08\x62uild_id\x18\x01 \x01(\t\x12/\n\x05\x65rror\x18\x02 \x01(\x0b\x32\x1e.builtin.events.knita.io.ErrorH\x00\x12\x36\n\x06result\x18\x03 \x01(\x0b\x32$.builtin.events.knita.io.BuildResultH\x00\x42\x08\n\x06status\"l\n\x17RuntimeTenderStartEvent\x12\x10\n\x08\x62uild_id\x18\x01 \x01(\t\x12\x11\n\ttender_id\x18\x02 \x01(\t\x12,\n\x04opts\x18\x03 \x01(\x0b\x32\x1e.executor.knita.io.RuntimeOpts\"J\n\x13RuntimeTenderResult\x12\x33\n\tcontracts\x18\x01 \x03(\x0b\x32 .broker.knita.io.RuntimeContract\"\xa5\x01\n\x15RuntimeTenderEndEvent\x12\x11\n\ttender_id\x18\x01 \x01(\t\x12/\n\x05\x65rror\x18\x02 \x01(\x0b\x32\x1e.builtin.events.knita.io.ErrorH\x00\x12>\n\x06result\x18\x03 \x01(\x0b\x32,.builtin.events.knita.io.RuntimeTenderResultH\x00\x42\x08\n\x06status\"Y\n\x1bRuntimeSettlementStartEvent\x12\x11\n\ttender_id\x18\x01 \x01(\t\x12\x13\n\x0b\x63ontract_id\x18\x02 \x01(\t\x12\x12\n\nruntime_id\x18\x03 \x01(\t\"\x19\n\x17RuntimeSettlementResult\"\xd6\x01\n\x19RuntimeSettlementEndEvent\x12\x11\n\ttender_id\x18\x01 \x01(\t\x12\x13\n\x0b\x63ontract_id\x18\x02 \x01(\t\x12\x12\n\nruntime_id\x18\x03 \x01(\t\x12/\n\x05\x65rror\x18\x04 \x01(\x0b\x32\x1e.builtin.events.knita.io.ErrorH\x00\x12\x42\n\x06result\x18\x05 \x01(\x0b\x32\x30.builtin.events.knita.io.RuntimeSettlementResultH\x00\x42\x08\n\x06status\"Y\n\x15RuntimeOpenStartEvent\x12\x12\n\nruntime_id\x18\x01 \x01(\t\x12,\n\x04opts\x18\x02 \x01(\x0b\x32\x1e.executor.knita.io.RuntimeOpts\"\x13\n\x11RuntimeOpenResult\"\xa2\x01\n\x13RuntimeOpenEndEvent\x12\x12\n\nruntime_id\x18\x01 \x01(\t\x12/\n\x05\x65rror\x18\x02 \x01(\x0b\x32\x1e.builtin.events.knita.io.ErrorH\x00\x12<\n\x06result\x18\x03 \x01(\x0b\x32*.builtin.events.knita.io.RuntimeOpenResultH\x00\x42\x08\n\x06status\",\n\x16RuntimeCloseStartEvent\x12\x12\n\nruntime_id\x18\x01 \x01(\t\"\x14\n\x12RuntimeCloseResult\"\xa4\x01\n\x14RuntimeCloseEndEvent\x12\x12\n\nruntime_id\x18\x01 \x01(\t\x12/\n\x05\x65rror\x18\x02 \x01(\x0b\x32\x1e.builtin.events.knita.io.ErrorH\x00\x12=\n\x06result\x18\x03 \x01(\x0b\x32+.builtin.events.knita.io.RuntimeCloseResultH\x00\x42\x08\n\x06status\"T\n\x0bStdoutEvent\x12\x0c\n\x04\x64\x61ta\x18\x01 \x01(\x0c\x12\x37\n\x06source\x18\x02 \x01(\x0b\x32\'.builtin.events.knita.io.LogEventSource\"T\n\x0bStderrEvent\x12\x0c\n\x04\x64\x61ta\x18\x01 \x01(\x0c\x12\x37\n\x06source\x18\x02 \x01(\x0b\x32\'.builtin.events.knita.io.LogEventSource\"\xd0\x01\n\x0eLogEventSource\x12<\n\x07runtime\x18\x02 \x01(\x0b\x32).builtin.events.knita.io.LogSourceRuntimeH\x00\x12\x36\n\x04\x65xec\x18\x03 \x01(\x0b\x32&.builtin.events.knita.io.LogSourceExecH\x00\x12>\n\x08\x64irector\x18\x04 \x01(\x0b\x32*.builtin.events.knita.io.LogSourceDirectorH\x00\x42\x08\n\x06source\"&\n\x10LogSourceRuntime\x12\x12\n\nruntime_id\x18\x01 \x01(\t\"D\n\rLogSourceExec\x12\x12\n\nruntime_id\x18\x01 \x01(\t\x12\x0f\n\x07\x65xec_id\x18\x02 \x01(\t\x12\x0e\n\x06system\x18\x03 \x01(\x08\"\x13\n\x11LogSourceDirector\"`\n\x0e\x45xecStartEvent\x12\x12\n\nruntime_id\x18\x01 \x01(\t\x12\x0f\n\x07\x65xec_id\x18\x02 \x01(\t\x12)\n\x04opts\x18\x03 \x01(\x0b\x32\x1b.executor.knita.io.ExecOpts\"\x1f\n\nExecResult\x12\x11\n\texit_code\x18\x04 \x01(\x05\"\xa5\x01\n\x0c\x45xecEndEvent\x12\x12\n\nruntime_id\x18\x01 \x01(\t\x12\x0f\n\x07\x65xec_id\x18\x02 \x01(\t\x12/\n\x05\x65rror\x18\x03 \x01(\x0b\x32\x1e.builtin.events.knita.io.ErrorH\x00\x12\x35\n\x06result\x18\x04 \x01(\x0b\x32#.builtin.events.knita.io.ExecResultH\x00\x42\x08\n\x06status\"9\n\x10ImportStartEvent\x12\x12\n\nruntime_id\x18\x01 \x01(\t\x12\x11\n\timport_id\x18\x02 \x01(\t\"\x0e\n\x0cImportResult\"\xab\x01\n\x0eImportEndEvent\x12\x12\n\nruntime_id\x18\x01 \x01(\t\x12\x11\n\timport_id\x18\x02 \x01(\t\x12/\n\x05\x65rror\x18\x03 \x01(\x0b\x32\x1e.builtin.events.knita.io.ErrorH\x00\x12\x37\n\x06result\x18\x04 \x01(\x0b\x32%.builtin.events.knita.io.ImportResultH\x00\x42\x08\n\x06status\"9\n\x10\x45xportStartEvent\x12\x12\n\nruntime_id\x18\x01 \x01(\t\x12\x11\n\texport_id\x18\x02 \x01(\t\"\x0e\n\x0c\x45xportResult\"\xab\x01\n\x0e\x45xportEndEvent\x12\x12\n\nruntime_id\x18\x01 \x01(\t\x12\x11\n\texport_id\x18\x02 \x01(\t\x12/\n\x05\x65rror\x18\x03 \x01(\x0b\x32\x1e.builtin.events.knita.io.ErrorH\x00\x12\x37\n\x06result\x18\x04 \x01(\x0b\x32%.builtin.events.knita.io.ExportResultH\x00\x42\x08\n\x06status\"+\n\x15SyncPointReachedEvent\x12\x12\n\nbarrier_id\x18\x01 \x01(\tB1Z/github.com/knita-io/knita/api/events/builtin/v1b\x06proto3')
+DESCRIPTOR = _descriptor_pool.Default().AddSerializedFile(b'\n\x1f\x65vents/builtin/v1/builtin.proto\x12\x17\x62uiltin.events.knita.io\x1a\x1a\x65xecutor/v1/executor.proto\x1a\x16\x62roker/v1/broker.proto\x1a\x1egoogle/protobuf/duration.proto\"\x18\n\x05\x45rror\x12\x0f\n\x07message\x18\x01 \x01(\t\"P\n\x0c\x44irectorInfo\x12\x0f\n\x07version\x18\x01 \x01(\t\x12/\n\x08sys_info\x18\x02 \x01(\x0b\x32\x1d.executor.knita.io.SystemInfo\"a\n\x0f\x42uildStartEvent\x12\x10\n\x08\x62uild_id\x18\x01 \x01(\t\x12<\n\rdirector_info\x18\x02 \x01(\x0b\x32%.builtin.events.knita.io.DirectorInfo\"\r\n\x0b\x42uildResult\"\x94\x01\n\rBuildEndEvent\x12\x10\n\x08\x62uild_id\x18\x01 \x01(\t\x12/\n\x05\x65rror\x18\x02 \x01(\x0b\x32\x1e.builtin.events.knita.io.ErrorH\x00\x12\x36\n\x06result\x18\x03 \x01(\x0b\x32$.builtin.events.knita.io.BuildResultH\x00\x42\x08\n\x06status\"l\n\x17RuntimeTenderStartEvent\x12\x10\n\x08\x62uild_id\x18\x01 \x01(\t\x12\x11\n\ttender_id\x18\x02 \x01(\t\x12,\n\x04opts\x18\x03 \x01(\x0b\x32\x1e.executor.knita.io.RuntimeOpts\"J\n\x13RuntimeTenderResult\x12\x33\n\tcontracts\x18\x01 \x03(\x0b\x32 .broker.knita.io.RuntimeContract\"\xa5\x01\n\x15RuntimeTenderEndEvent\x12\x11\n\ttender_id\x18\x01 \x01(\t\x12/\n\x05\x65rror\x18\x02 \x01(\x0b\x32\x1e.builtin.events.knita.io.ErrorH\x00\x12>\n\x06result\x18\x03 \x01(\x0b\x32,.builtin.events.knita.io.RuntimeTenderResultH\x00\x42\x08\n\x06status\"Y\n\x1bRuntimeSettlementStartEvent\x12\x11\n\ttender_id\x18\x01 \x01(\t\x12\x13\n\x0b\x63ontract_id\x18\x02 \x01(\t\x12\x12\n\nruntime_id\x18\x03 \x01(\t\"\x19\n\x17RuntimeSettlementResult\"\xd6\x01\n\x19RuntimeSettlementEndEvent\x12\x11\n\ttender_id\x18\x01 \x01(\t\x12\x13\n\x0b\x63ontract_id\x18\x02 \x01(\t\x12\x12\n\nruntime_id\x18\x03 \x01(\t\x12/\n\x05\x65rror\x18\x04 \x01(\x0b\x32\x1e.builtin.events.knita.io.ErrorH\x00\x12\x42\n\x06result\x18\x05 \x01(\x0b\x32\x30.builtin.events.knita.io.RuntimeSettlementResultH\x00\x42\x08\n\x06status\"Y\n\x15RuntimeOpenStartEvent\x12\x12\n\nruntime_id\x18\x01 \x01(\t\x12,\n\x04opts\x18\x02 \x01(\x0b\x32\x1e.executor.knita.io.RuntimeOpts\")\n\x11RuntimeOpenResult\x12\x14\n\x0cimage_digest\x18\x01 \x01(\t\"\xa2\x01\n\x13RuntimeOpenEndEvent\x12\x12\n\nruntime_id\x18\x01 \x01(\t\x12/\n\x05\x65rror\x18\x02 \x01(\x0b\x32\x1e.builtin.events.knita.io.ErrorH\x00\x12<\n\x06result\x18\x03 \x01(\x0b\x32*.builtin.events.knita.io.RuntimeOpenResultH\x00\x42\x08\n\x06status\"\x80\x01\n\x16ImagePullProgressEvent\x12\x12\n\nruntime_id\x18\x01 \x01(\t\x12\x11\n\timage_uri\x18\x02 \x01(\t\x12?\n\x06layers\x18\x03 \x03(\x0b\x32/.builtin.events.knita.io.ImagePullLayerProgress\"Z\n\x16ImagePullLayerProgress\x12\x10\n\x08layer_id\x18\x01 \x01(\t\x12\x0e\n\x06status\x18\x02 \x01(\t\x12\x0f\n\x07\x63urrent\x18\x03 \x01(\x03\x12\r\n\x05total\x18\x04 \x01(\x03\",\n\x16RuntimeCloseStartEvent\x12\x12\n\nruntime_id\x18\x01 \x01(\t\"\x14\n\x12RuntimeCloseResult\"\xa4\x01\n\x14RuntimeCloseEndEvent\x12\x12\n\nruntime_id\x18\x01 \x01(\t\x12/\n\x05\x65rror\x18\x02 \x01(\x0b\x32\x1e.builtin.events.knita.io.ErrorH\x00\x12=\n\x06result\x18\x03 \x01(\x0b\x32+.builtin.events.knita.io.RuntimeCloseResultH\x00\x42\x08\n\x06status\"T\n\x0bStdoutEvent\x12\x0c\n\x04\x64\x61ta\x18\x01 \x01(\x0c\x12\x37\n\x06source\x18\x02 \x01(\x0b\x32\'.builtin.events.knita.io.LogEventSource\"T\n\x0bStderrEvent\x12\x0c\n\x04\x64\x61ta\x18\x01 \x01(\x0c\x12\x37\n\x06source\x18\x02 \x01(\x0b\x32\'.builtin.events.knita.io.LogEventSource\"\xd0\x01\n\x0eLogEventSource\x12<\n\x07runtime\x18\x02 \x01(\x0b\x32).builtin.events.knita.io.LogSourceRuntimeH\x00\x12\x36\n\x04\x65xec\x18\x03 \x01(\x0b\x32&.builtin.events.knita.io.LogSourceExecH\x00\x12>\n\x08\x64irector\x18\x04 \x01(\x0b\x32*.builtin.events.knita.io.LogSourceDirectorH\x00\x42\x08\n\x06source\"&\n\x10LogSourceRuntime\x12\x12\n\nruntime_id\x18\x01 \x01(\t\"D\n\rLogSourceExec\x12\x12\n\nruntime_id\x18\x01 \x01(\t\x12\x0f\n\x07\x65xec_id\x18\x02 \x01(\t\x12\x0e\n\x06system\x18\x03 \x01(\x08\"\x13\n\x11LogSourceDirector\"`\n\x0e\x45xecStartEvent\x12\x12\n\nruntime_id\x18\x01 \x01(\t\x12\x0f\n\x07\x65xec_id\x18\x02 \x01(\t\x12)\n\x04opts\x18\x03 \x01(\x0b\x32\x1b.executor.knita.io.ExecOpts\"c\n\nExecResult\x12\x11\n\texit_code\x18\x04 \x01(\x05\x12\x11\n\ttimed_out\x18\x05 \x01(\x08\x12/\n\x05usage\x18\x06 \x01(\x0b\x32 .executor.knita.io.ResourceUsage\"\x0f\n\rExecCancelled\"\xe2\x01\n\x0c\x45xecEndEvent\x12\x12\n\nruntime_id\x18\x01 \x01(\t\x12\x0f\n\x07\x65xec_id\x18\x02 \x01(\t\x12/\n\x05\x65rror\x18\x03 \x01(\x0b\x32\x1e.builtin.events.knita.io.ErrorH\x00\x12\x35\n\x06result\x18\x04 \x01(\x0b\x32#.builtin.events.knita.io.ExecResultH\x00\x12;\n\tcancelled\x18\x05 \x01(\x0b\x32&.builtin.events.knita.io.ExecCancelledH\x00\x42\x08\n\x06status\"9\n\x10ImportStartEvent\x12\x12\n\nruntime_id\x18\x01 \x01(\t\x12\x11\n\timport_id\x18\x02 \x01(\t\"y\n\x13ImportProgressEvent\x12\x12\n\nruntime_id\x18\x01 \x01(\t\x12\x11\n\timport_id\x18\x02 \x01(\t\x12;\n\x08progress\x18\x03 \x01(\x0b\x32).builtin.events.knita.io.TransferProgress\"\x90\x01\n\x10TransferProgress\x12\x12\n\nfiles_done\x18\x01 \x01(\r\x12\x12\n\nbytes_done\x18\x02 \x01(\x04\x12\x13\n\x0b\x66iles_total\x18\x03 \x01(\r\x12\x13\n\x0b\x62ytes_total\x18\x04 \x01(\x04\x12*\n\x07\x65lapsed\x18\x05 \x01(\x0b\x32\x19.google.protobuf.Duration\"\x7f\n\x0cImportResult\x12\x15\n\rskipped_files\x18\x01 \x01(\r\x12\x15\n\rskipped_bytes\x18\x02 \x01(\x04\x12\x15\n\rdeleted_files\x18\x03 \x01(\r\x12\x14\n\x0c\x63\x61\x63hed_files\x18\x04 \x01(\r\x12\x14\n\x0c\x63\x61\x63hed_bytes\x18\x05 \x01(\x04\"\xab\x01\n\x0eImportEndEvent\x12\x12\n\nruntime_id\x18\x01 \x01(\t\x12\x11\n\timport_id\x18\x02 \x01(\t\x12/\n\x05\x65rror\x18\x03 \x01(\x0b\x32\x1e.builtin.events.knita.io.ErrorH\x00\x12\x37\n\x06result\x18\x04 \x01(\x0b\x32%.builtin.events.knita.io.ImportResultH\x00\x42\x08\n\x06status\"9\n\x10\x45xportStartEvent\x12\x12\n\nruntime_id\x18\x01 \x01(\t\x12\x11\n\texport_id\x18\x02 \x01(\t\"y\n\x13\x45xportProgressEvent\x12\x12\n\nruntime_id\x18\x01 \x01(\t\x12\x11\n\texport_id\x18\x02 \x01(\t\x12;\n\x08progress\x18\x03 \x01(\x0b\x32).builtin.events.knita.io.TransferProgress\"\x0e\n\x0c\x45xportResult\"\xab\x01\n\x0e\x45xportEndEvent\x12\x12\n\nruntime_id\x18\x01 \x01(\t\x12\x11\n\texport_id\x18\x02 \x01(\t\x12/\n\x05\x65rror\x18\x03 \x01(\x0b\x32\x1e.builtin.events.knita.io.ErrorH\x00\x12\x37\n\x06result\x18\x04 \x01(\x0b\x32%.builtin.events.knita.io.ExportResultH\x00\x42\x08\n\x06status\"+\n\x15SyncPointReachedEvent\x12\x12\n\nbarrier_id\x18\x01 \x01(\t\"\x9c\x01\n\x10\x42uildPausedEvent\x12\x10\n\x08\x62uild_id\x18\x01 \x01(\t\x12\x10\n\x08pause_id\x18\x02 \x01(\t\x12\x12\n\nruntime_id\x18\x03 \x01(\t\x12\x0e\n\x06reason\x18\x04 \x01(\t\x12\x14\n\x0cinstructions\x18\x05 \x01(\t\x12*\n\x07timeout\x18\x06 \x01(\x0b\x32\x19.google.protobuf.Duration\"[\n\x11\x42uildResumedEvent\x12\x10\n\x08\x62uild_id\x18\x01 \x01(\t\x12\x10\n\x08pause_id\x18\x02 \x01(\t\x12\x0f\n\x07\x61\x62orted\x18\x03 \x01(\x08\x12\x11\n\ttimed_out\x18\x04 \x01(\x08\x42\x31Z/github.com/knita-io/knita/api/events/builtin/v1b\x06proto3')
 
 _globals = globals()
 _builder.BuildMessageAndEnumDescriptors(DESCRIPTOR, _globals)
@@ -24,70 +25,86 @@ _builder.BuildTopDescriptorsAndMessages(DESCRIPTOR, 'events.builtin.v1.builtin_p
 if not _descriptor._USE_C_DESCRIPTORS:
   _globals['DESCRIPTOR']._loaded_options = None
   _globals['DESCRIPTOR']._serialized_options = b'Z/github.com/knita-io/knita/api/events/builtin/v1'
-  _globals['_ERROR']._serialized_start=112
-  _globals['_ERROR']._serialized_end=136
-  _globals['_DIRECTORINFO']._serialized_start=138
-  _globals['_DIRECTORINFO']._serialized_end=218
-  _globals['_BUILDSTARTEVENT']._serialized_start=220
-  _globals['_BUILDSTARTEVENT']._serialized_end=317
-  _globals['_BUILDRESULT']._serialized_start=319
-  _globals['_BUILDRESULT']._serialized_end=332
-  _globals['_BUILDENDEVENT']._serialized_start=335
-  _globals['_BUILDENDEVENT']._serialized_end=483
-  _globals['_RUNTIMETENDERSTARTEVENT']._serialized_start=485
-  _globals['_RUNTIMETENDERSTARTEVENT']._serialized_end=593
-  _globals['_RUNTIMETENDERRESULT']._serialized_start=595
-  _globals['_RUNTIMETENDERRESULT']._serialized_end=669
-  _globals['_RUNTIMETENDERENDEVENT']._serialized_start=672
-  _globals['_RUNTIMETENDERENDEVENT']._serialized_end=837
-  _globals['_RUNTIMESETTLEMENTSTARTEVENT']._serialized_start=839
-  _globals['_RUNTIMESETTLEMENTSTARTEVENT']._serialized_end=928
-  _globals['_RUNTIMESETTLEMENTRESULT']._serialized_start=930
-  _globals['_RUNTIMESETTLEMENTRESULT']._serialized_end=955
-  _globals['_RUNTIMESETTLEMENTENDEVENT']._serialized_start=958
-  _globals['_RUNTIMESETTLEMENTENDEVENT']._serialized_end=1172
-  _globals['_RUNTIMEOPENSTARTEVENT']._serialized_start=1174
-  _globals['_RUNTIMEOPENSTARTEVENT']._serialized_end=1263
-  _globals['_RUNTIMEOPENRESULT']._serialized_start=1265
-  _globals['_RUNTIMEOPENRESULT']._serialized_end=1284
-  _globals['_RUNTIMEOPENENDEVENT']._serialized_start=1287
-  _globals['_RUNTIMEOPENENDEVENT']._serialized_end=1449
-  _globals['_RUNTIMECLOSESTARTEVENT']._serialized_start=1451
-  _globals['_RUNTIMECLOSESTARTEVENT']._serialized_end=1495
-  _globals['_RUNTIMECLOSERESULT']._serialized_start=1497
-  _globals['_RUNTIMECLOSERESULT']._serialized_end=1517
-  _globals['_RUNTIMECLOSEENDEVENT']._serialized_start=1520
-  _globals['_RUNTIMECLOSEENDEVENT']._serialized_end=1684
-  _globals['_STDOUTEVENT']._serialized_start=1686
-  _globals['_STDOUTEVENT']._serialized_end=1770
-  _globals['_STDERREVENT']._serialized_start=1772
-  _globals['_STDERREVENT']._serialized_end=1856
-  _globals['_LOGEVENTSOURCE']._serialized_start=1859
-  _globals['_LOGEVENTSOURCE']._serialized_end=2067
-  _globals['_LOGSOURCERUNTIME']._serialized_start=2069
-  _globals['_LOGSOURCERUNTIME']._serialized_end=2107
-  _globals['_LOGSOURCEEXEC']._serialized_start=2109
-  _globals['_LOGSOURCEEXEC']._serialized_end=2177
-  _globals['_LOGSOURCEDIRECTOR']._serialized_start=2179
-  _globals['_LOGSOURCEDIRECTOR']._serialized_end=2198
-  _globals['_EXECSTARTEVENT']._serialized_start=2200
-  _globals['_EXECSTARTEVENT']._serialized_end=2296
-  _globals['_EXECRESULT']._serialized_start=2298
-  _globals['_EXECRESULT']._serialized_end=2329
-  _globals['_EXECENDEVENT']._serialized_start=2332
-  _globals['_EXECENDEVENT']._serialized_end=2497
-  _globals['_IMPORTSTARTEVENT']._serialized_start=2499
-  _globals['_IMPORTSTARTEVENT']._serialized_end=2556
-  _globals['_IMPORTRESULT']._serialized_start=2558
-  _globals['_IMPORTRESULT']._serialized_end=2572
-  _globals['_IMPORTENDEVENT']._serialized_start=2575
-  _globals['_IMPORTENDEVENT']._serialized_end=2746
-  _globals['_EXPORTSTARTEVENT']._serialized_start=2748
-  _globals['_EXPORTSTARTEVENT']._serialized_end=2805
-  _globals['_EXPORTRESULT']._serialized_start=2807
-  _globals['_EXPORTRESULT']._serialized_end=2821
-  _globals['_EXPORTENDEVENT']._serialized_start=2824
-  _globals['_EXPORTENDEVENT']._serialized_end=2995
-  _globals['_SYNCPOINTREACHEDEVENT']._serialized_start=2997
-  _globals['_SYNCPOINTREACHEDEVENT']._serialized_end=3040
+  _globals['_ERROR']._serialized_start=144
+  _globals['_ERROR']._serialized_end=168
+  _globals['_DIRECTORINFO']._serialized_start=170
+  _globals['_DIRECTORINFO']._serialized_end=250
+  _globals['_BUILDSTARTEVENT']._serialized_start=252
+  _globals['_BUILDSTARTEVENT']._serialized_end=349
+  _globals['_BUILDRESULT']._serialized_start=351
+  _globals['_BUILDRESULT']._serialized_end=364
+  _globals['_BUILDENDEVENT']._serialized_start=367
+  _globals['_BUILDENDEVENT']._serialized_end=515
+  _globals['_RUNTIMETENDERSTARTEVENT']._serialized_start=517
+  _globals['_RUNTIMETENDERSTARTEVENT']._serialized_end=625
+  _globals['_RUNTIMETENDERRESULT']._serialized_start=627
+  _globals['_RUNTIMETENDERRESULT']._serialized_end=701
+  _globals['_RUNTIMETENDERENDEVENT']._serialized_start=704
+  _globals['_RUNTIMETENDERENDEVENT']._serialized_end=869
+  _globals['_RUNTIMESETTLEMENTSTARTEVENT']._serialized_start=871
+  _globals['_RUNTIMESETTLEMENTSTARTEVENT']._serialized_end=960
+  _globals['_RUNTIMESETTLEMENTRESULT']._serialized_start=962
+  _globals['_RUNTIMESETTLEMENTRESULT']._serialized_end=987
+  _globals['_RUNTIMESETTLEMENTENDEVENT']._serialized_start=990
+  _globals['_RUNTIMESETTLEMENTENDEVENT']._serialized_end=1204
+  _globals['_RUNTIMEOPENSTARTEVENT']._serialized_start=1206
+  _globals['_RUNTIMEOPENSTARTEVENT']._serialized_end=1295
+  _globals['_RUNTIMEOPENRESULT']._serialized_start=1297
+  _globals['_RUNTIMEOPENRESULT']._serialized_end=1338
+  _globals['_RUNTIMEOPENENDEVENT']._serialized_start=1341
+  _globals['_RUNTIMEOPENENDEVENT']._serialized_end=1503
+  _globals['_IMAGEPULLPROGRESSEVENT']._serialized_start=1506
+  _globals['_IMAGEPULLPROGRESSEVENT']._serialized_end=1634
+  _globals['_IMAGEPULLLAYERPROGRESS']._serialized_start=1636
+  _globals['_IMAGEPULLLAYERPROGRESS']._serialized_end=1726
+  _globals['_RUNTIMECLOSESTARTEVENT']._serialized_start=1728
+  _globals['_RUNTIMECLOSESTARTEVENT']._serialized_end=1772
+  _globals['_RUNTIMECLOSERESULT']._serialized_start=1774
+  _globals['_RUNTIMECLOSERESULT']._serialized_end=1794
+  _globals['_RUNTIMECLOSEENDEVENT']._serialized_start=1797
+  _globals['_RUNTIMECLOSEENDEVENT']._serialized_end=1961
+  _globals['_STDOUTEVENT']._serialized_start=1963
+  _globals['_STDOUTEVENT']._serialized_end=2047
+  _globals['_STDERREVENT']._serialized_start=2049
+  _globals['_STDERREVENT']._serialized_end=2133
+  _globals['_LOGEVENTSOURCE']._serialized_start=2136
+  _globals['_LOGEVENTSOURCE']._serialized_end=2344
+  _globals['_LOGSOURCERUNTIME']._serialized_start=2346
+  _globals['_LOGSOURCERUNTIME']._serialized_end=2384
+  _globals['_LOGSOURCEEXEC']._serialized_start=2386
+  _globals['_LOGSOURCEEXEC']._serialized_end=2454
+  _globals['_LOGSOURCEDIRECTOR']._serialized_start=2456
+  _globals['_LOGSOURCEDIRECTOR']._serialized_end=2475
+  _globals['_EXECSTARTEVENT']._serialized_start=2477
+  _globals['_EXECSTARTEVENT']._serialized_end=2573
+  _globals['_EXECRESULT']._serialized_start=2575
+  _globals['_EXECRESULT']._serialized_end=2674
+  _globals['_EXECCANCELLED']._serialized_start=2676
+  _globals['_EXECCANCELLED']._serialized_end=2691
+  _globals['_EXECENDEVENT']._serialized_start=2694
+  _globals['_EXECENDEVENT']._serialized_end=2920
+  _globals['_IMPORTSTARTEVENT']._serialized_start=2922
+  _globals['_IMPORTSTARTEVENT']._serialized_end=2979
+  _globals['_IMPORTPROGRESSEVENT']._serialized_start=2981
+  _globals['_IMPORTPROGRESSEVENT']._serialized_end=3102
+  _globals['_TRANSFERPROGRESS']._serialized_start=3105
+  _globals['_TRANSFERPROGRESS']._serialized_end=3249
+  _globals['_IMPORTRESULT']._serialized_start=3251
+  _globals['_IMPORTRESULT']._serialized_end=3378
+  _globals['_IMPORTENDEVENT']._serialized_start=3381
+  _globals['_IMPORTENDEVENT']._serialized_end=3552
+  _globals['_EXPORTSTARTEVENT']._serialized_start=3554
+  _globals['_EXPORTSTARTEVENT']._serialized_end=3611
+  _globals['_EXPORTPROGRESSEVENT']._serialized_start=3613
+  _globals['_EXPORTPROGRESSEVENT']._serialized_end=3734
+  _globals['_EXPORTRESULT']._serialized_start=3736
+  _globals['_EXPORTRESULT']._serialized_end=3750
+  _globals['_EXPORTENDEVENT']._serialized_start=3753
+  _globals['_EXPORTENDEVENT']._serialized_end=3924
+  _globals['_SYNCPOINTREACHEDEVENT']._serialized_start=3926
+  _globals['_SYNCPOINTREACHEDEVENT']._serialized_end=3969
+  _globals['_BUILDPAUSEDEVENT']._serialized_start=3972
+  _globals['_BUILDPAUSEDEVENT']._serialized_end=4128
+  _globals['_BUILDRESUMEDEVENT']._serialized_start=4130
+  _globals['_BUILDRESUMEDEVENT']._serialized_end=4221
 # @@protoc_insertion_point(module_scope)
@@ -1,5 +1,6 @@
 from . import executor_pb2 as _executor_pb2
 from . import broker_pb2 as _broker_pb2
+from google.protobuf import duration_pb2 as _duration_pb2
 from google.protobuf.internal import containers as _containers
 from google.protobuf import descriptor as _descriptor
 from google.protobuf import message as _message
@@ -106,8 +107,10 @@ class RuntimeOpenStartEvent(_message.Message):
     def __init__(self, runtime_id: _Optional[str] = ..., opts: _Optional[_Union[_executor_pb2.RuntimeOpts, _Mapping]] = ...) -> None: ...
 
 class RuntimeOpenResult(_message.Message):
-    __slots__ = ()
-    def __init__(self) -> None: ...
+    __slots__ = ("image_digest",)
+    IMAGE_DIGEST_FIELD_NUMBER: _ClassVar[int]
+    image_digest: str
+    def __init__(self, image_digest: _Optional[str] = ...) -> None: ...
 
 class RuntimeOpenEndEvent(_message.Message):
     __slots__ = ("runtime_id", "error", "result")
@@ -119,6 +122,28 @@ class RuntimeOpenEndEvent(_message.Message):
     result: RuntimeOpenResult
     def __init__(self, runtime_id: _Optional[str] = ..., error: _Optional[_Union[Error, _Mapping]] = ..., result: _Optional[_Union[RuntimeOpenResult, _Mapping]] = ...) -> None: ...
 
+class ImagePullProgressEvent(_message.Message):
+    __slots__ = ("runtime_id", "image_uri", "layers")
+    RUNTIME_ID_FIELD_NUMBER: _ClassVar[int]
+    IMAGE_URI_FIELD_NUMBER: _ClassVar[int]
+    LAYERS_FIELD_NUMBER: _ClassVar[int]
+    runtime_id: str
+    image_uri: str
+    layers: _containers.RepeatedCompositeFieldContainer[ImagePullLayerProgress]
+    def __init__(self, runtime_id: _Optional[str] = ..., image_uri: _Optional[str] = ..., layers: _Optional[_Iterable[_Union[ImagePullLayerProgress, _Mapping]]] = ...) -> None: ...
+
+class ImagePullLayerProgress(_message.Message):
+    __slots__ = ("layer_id", "status", "current", "total")
+    LAYER_ID_FIELD_NUMBER: _ClassVar[int]
+    STATUS_FIELD_NUMBER: _ClassVar[int]
+    CURRENT_FIELD_NUMBER: _ClassVar[int]
+    TOTAL_FIELD_NUMBER: _ClassVar[int]
+    layer_id: str
+    status: str
+    current: int
+    total: int
+    def __init__(self, layer_id: _Optional[str] = ..., status: _Optional[str] = ..., current: _Optional[int] = ..., total: _Optional[int] = ...) -> None: ...
+
 class RuntimeCloseStartEvent(_message.Message):
     __slots__ = ("runtime_id",)
     RUNTIME_ID_FIELD_NUMBER: _ClassVar[int]
@@ -196,22 +221,32 @@ class ExecStartEvent(_message.Message):
     def __init__(self, runtime_id: _Optional[str] = ..., exec_id: _Optional[str] = ..., opts: _Optional[_Union[_executor_pb2.ExecOpts, _Mapping]] = ...) -> None: ...
 
 class ExecResult(_message.Message):
-    __slots__ = ("exit_code",)
+    __slots__ = ("exit_code", "timed_out", "usage")
     EXIT_CODE_FIELD_NUMBER: _ClassVar[int]
+    TIMED_OUT_FIELD_NUMBER: _ClassVar[int]
+    USAGE_FIELD_NUMBER: _ClassVar[int]
     exit_code: int
-    def __init__(self, exit_code: _Optional[int] = ...) -> None: ...
+    timed_out: bool
+    usage: _executor_pb2.ResourceUsage
+    def __init__(self, exit_code: _Optional[int] = ..., timed_out: bool = ..., usage: _Optional[_Union[_executor_pb2.ResourceUsage, _Mapping]] = ...) -> None: ...
+
+class ExecCancelled(_message.Message):
+    __slots__ = ()
+    def __init__(self) -> None: ...
 
 class ExecEndEvent(_message.Message):
-    __slots__ = ("runtime_id", "exec_id", "error", "result")
+    __slots__ = ("runtime_id", "exec_id", "error", "result", "cancelled")
     RUNTIME_ID_FIELD_NUMBER: _ClassVar[int]
     EXEC_ID_FIELD_NUMBER: _ClassVar[int]
     ERROR_FIELD_NUMBER: _ClassVar[int]
     RESULT_FIELD_NUMBER: _ClassVar[int]
+    CANCELLED_FIELD_NUMBER: _ClassVar[int]
     runtime_id: str
     exec_id: str
     error: Error
     result: ExecResult
-    def __init__(self, runtime_id: _Optional[str] = ..., exec_id: _Optional[str] = ..., error: _Optional[_Union[Error, _Mapping]] = ..., result: _Optional[_Union[ExecResult, _Mapping]] = ...) -> None: ...
+    cancelled: ExecCancelled
+    def __init__(self, runtime_id: _Optional[str] = ..., exec_id: _Optional[str] = ..., error: _Optional[_Union[Error, _Mapping]] = ..., result: _Optional[_Union[ExecResult, _Mapping]] = ..., cancelled: _Optional[_Union[ExecCancelled, _Mapping]] = ...) -> None: ...
 
 class ImportStartEvent(_message.Message):
     __slots__ = ("runtime_id", "import_id")
@@ -221,9 +256,43 @@ class ImportStartEvent(_message.Message):
     import_id: str
     def __init__(self, runtime_id: _Optional[str] = ..., import_id: _Optional[str] = ...) -> None: ...
 
+class ImportProgressEvent(_message.Message):
+    __slots__ = ("runtime_id", "import_id", "progress")
+    RUNTIME_ID_FIELD_NUMBER: _ClassVar[int]
+    IMPORT_ID_FIELD_NUMBER: _ClassVar[int]
+    PROGRESS_FIELD_NUMBER: _ClassVar[int]
+    runtime_id: str
+    import_id: str
+    progress: TransferProgress
+    def __init__(self, runtime_id: _Optional[str] = ..., import_id: _Optional[str] = ..., progress: _Optional[_Union[TransferProgress, _Mapping]] = ...) -> None: ...
+
+class TransferProgress(_message.Message):
+    __slots__ = ("files_done", "bytes_done", "files_total", "bytes_total", "elapsed")
+    FILES_DONE_FIELD_NUMBER: _ClassVar[int]
+    BYTES_DONE_FIELD_NUMBER: _ClassVar[int]
+    FILES_TOTAL_FIELD_NUMBER: _ClassVar[int]
+    BYTES_TOTAL_FIELD_NUMBER: _ClassVar[int]
+    ELAPSED_FIELD_NUMBER: _ClassVar[int]
+    files_done: int
+    bytes_done: int
+    files_total: int
+    bytes_total: int
+    elapsed: _duration_pb2.Duration
+    def __init__(self, files_done: _Optional[int] = ..., bytes_done: _Optional[int] = ..., files_total: _Optional[int] = ..., bytes_total: _Optional[int] = ..., elapsed: _Optional[_Union[_duration_pb2.Duration, _Mapping]] = ...) -> None: ...
+
 class ImportResult(_message.Message):
-    __slots__ = ()
-    def __init__(self) -> None: ...
+    __slots__ = ("skipped_files", "skipped_bytes", "deleted_files", "cached_files", "cached_bytes")
+    SKIPPED_FILES_FIELD_NUMBER: _ClassVar[int]
+    SKIPPED_BYTES_FIELD_NUMBER: _ClassVar[int]
+    DELETED_FILES_FIELD_NUMBER: _ClassVar[int]
+    CACHED_FILES_FIELD_NUMBER: _ClassVar[int]
+    CACHED_BYTES_FIELD_NUMBER: _ClassVar[int]
+    skipped_files: int
+    skipped_bytes: int
+    deleted_files: int
+    cached_files: int
+    cached_bytes: int
+    def __init__(self, skipped_files: _Optional[int] = ..., skipped_bytes: _Optional[int] = ..., deleted_files: _Optional[int] = ..., cached_files: _Optional[int] = ..., cached_bytes: _Optional[int] = ...) -> None: ...
 
 class ImportEndEvent(_message.Message):
     __slots__ = ("runtime_id", "import_id", "error", "result")
@@ -245,6 +314,16 @@ class ExportStartEvent(_message.Message):
     export_id: str
     def __init__(self, runtime_id: _Optional[str] = ..., export_id: _Optional[str] = ...) -> None: ...
 
+class ExportProgressEvent(_message.Message):
+    __slots__ = ("runtime_id", "export_id", "progress")
+    RUNTIME_ID_FIELD_NUMBER: _ClassVar[int]
+    EXPORT_ID_FIELD_NUMBER: _ClassVar[int]
+    PROGRESS_FIELD_NUMBER: _ClassVar[int]
+    runtime_id: str
+    export_id: str
+    progress: TransferProgress
+    def __init__(self, runtime_id: _Optional[str] = ..., export_id: _Optional[str] = ..., progress: _Optional[_Union[TransferProgress, _Mapping]] = ...) -> None: ...
+
 class ExportResult(_message.Message):
     __slots__ = ()
     def __init__(self) -> None: ...
@@ -266,3 +345,31 @@ class SyncPointReachedEvent(_message.Message):
     BARRIER_ID_FIELD_NUMBER: _ClassVar[int]
     barrier_id: str
     def __init__(self, barrier_id: _Optional[str] = ...) -> None: ...
+
+class BuildPausedEvent(_message.Message):
+    __slots__ = ("build_id", "pause_id", "runtime_id", "reason", "instructions", "timeout")
+    BUILD_ID_FIELD_NUMBER: _ClassVar[int]
+    PAUSE_ID_FIELD_NUMBER: _ClassVar[int]
+    RUNTIME_ID_FIELD_NUMBER: _ClassVar[int]
+    REASON_FIELD_NUMBER: _ClassVar[int]
+    INSTRUCTIONS_FIELD_NUMBER: _ClassVar[int]
+    TIMEOUT_FIELD_NUMBER: _ClassVar[int]
+    build_id: str
+    pause_id: str
+    runtime_id: str
+    reason: str
+    instructions: str
+    timeout: _duration_pb2.Duration
+    def __init__(self, build_id: _Optional[str] = ..., pause_id: _Optional[str] = ..., runtime_id: _Optional[str] = ..., reason: _Optional[str] = ..., instructions: _Optional[str] = ..., timeout: _Optional[_Union[_duration_pb2.Duration, _Mapping]] = ...) -> None: ...
+
+class BuildResumedEvent(_message.Message):
+    __slots__ = ("build_id", "pause_id", "aborted", "timed_out")
+    BUILD_ID_FIELD_NUMBER: _ClassVar[int]
+    PAUSE_ID_FIELD_NUMBER: _ClassVar[int]
+    ABORTED_FIELD_NUMBER: _ClassVar[int]
+    TIMED_OUT_FIELD_NUMBER: _ClassVar[int]
+    build_id: str
+    pause_id: str
+    aborted: bool
+    timed_out: bool
+    def __init__(self, build_id: _Optional[str] = ..., pause_id: _Optional[str] = ..., aborted: bool = ..., timed_out: bool = ...) -> None: ...
@@ -41,16 +41,22 @@ class Client:
                 labels: Optional[dict] = None,
                 annotations: Optional[dict] = None,
                 runs_on: Optional[dict] = None,
-                runs_on_expressions: Optional[List[runtime.Requirement]] = None):
+                runs_on_expressions: Optional[List[runtime.Requirement]] = None,
+                env: Optional[List[str]] = None,
+                shell: Optional[List[str]] = None):
         """Opens a new remote runtime configured based on options.
 
         labels / annotations attach metadata to the runtime (carried through to its events).
         runs_on is a dict of matchLabels constraining which executor can host the runtime.
         runs_on_expressions is a list of label-selector Requirement entries (more expressive
-        matching). Both runs_on and runs_on_expressions can be combined."""
+        matching). Both runs_on and runs_on_expressions can be combined.
+        env is a list of "KEY=VALUE" environment variables set for every exec in the runtime.
+        shell is the command line exec scripts are run with e.g. ["bash", "-eo", "pipefail", "-c"]."""
 
         opts = executor_pb2.RuntimeOpts(
             display_name=display_name,
+            env=env,
+            shell=shell,
             meta=runtime._opts_meta(labels, annotations),
             label_selector=runtime._label_selector(runs_on, runs_on_expressions))
         if type == runtime.RuntimeType.host:
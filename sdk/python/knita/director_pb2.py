@@ -14,9 +14,10 @@ _sym_db = _symbol_database.Default()
 
 from . import executor_pb2 as executor_dot_v1_dot_executor__pb2
 from . import event_pb2 as events_dot_v1_dot_event__pb2
+from google.protobuf import duration_pb2 as google_dot_protobuf_dot_duration__pb2
 
 
-DESCRIPTOR = _descriptor_pool.Default().AddSerializedFile(b'\n\x1a\x64irector/v1/director.proto\x12\x11\x64irector.knita.io\x1a\x1a\x65xecutor/v1/executor.proto\x1a\x15\x65vents/v1/event.proto\"M\n\x0bOpenRequest\x12\x10\n\x08\x62uild_id\x18\x01 \x01(\t\x12,\n\x04opts\x18\x02 \x01(\x0b\x32\x1e.executor.knita.io.RuntimeOpts\"k\n\x0cOpenResponse\x12\x12\n\nruntime_id\x18\x01 \x01(\t\x12\x16\n\x0ework_directory\x18\x02 \x01(\t\x12/\n\x08sys_info\x18\x03 \x01(\x0b\x32\x1d.executor.knita.io.SystemInfo\"P\n\rImportRequest\x12\x12\n\nruntime_id\x18\x01 \x01(\t\x12+\n\x04opts\x18\x02 \x01(\x0b\x32\x1d.director.knita.io.ImportOpts\"\x84\x01\n\nImportOpts\x12\x10\n\x08src_path\x18\x01 \x01(\t\x12\x11\n\tdest_path\x18\x02 \x01(\t\x12\x10\n\x08\x65xcludes\x18\x03 \x03(\t\x12)\n\x04meta\x18\x04 \x01(\x0b\x32\x1b.executor.knita.io.OptsMeta\x12\x14\n\x0c\x64isplay_name\x18\x06 \x01(\t\"\x10\n\x0eImportResponse\"P\n\rExportRequest\x12\x12\n\nruntime_id\x18\x01 \x01(\t\x12+\n\x04opts\x18\x02 \x01(\x0b\x32\x1d.director.knita.io.ExportOpts\"\x84\x01\n\nExportOpts\x12\x10\n\x08src_path\x18\x01 \x01(\t\x12\x11\n\tdest_path\x18\x02 \x01(\t\x12\x10\n\x08\x65xcludes\x18\x03 \x03(\t\x12)\n\x04meta\x18\x04 \x01(\x0b\x32\x1b.executor.knita.io.OptsMeta\x12\x14\n\x0c\x64isplay_name\x18\x06 \x01(\t\"\x10\n\x0e\x45xportResponse\"L\n\x0b\x45xecRequest\x12\x12\n\nruntime_id\x18\x01 \x01(\t\x12)\n\x04opts\x18\x02 \x01(\x0b\x32\x1b.executor.knita.io.ExecOpts\"\"\n\x0c\x43loseRequest\x12\x12\n\nruntime_id\x18\x01 \x01(\t\"\x0f\n\rCloseResponse2\xff\x02\n\x08\x44irector\x12G\n\x04Open\x12\x1e.director.knita.io.OpenRequest\x1a\x1f.director.knita.io.OpenResponse\x12@\n\x04\x45xec\x12\x1e.director.knita.io.ExecRequest\x1a\x16.events.knita.io.Event0\x01\x12M\n\x06Import\x12 .director.knita.io.ImportRequest\x1a!.director.knita.io.ImportResponse\x12M\n\x06\x45xport\x12 .director.knita.io.ExportRequest\x1a!.director.knita.io.ExportResponse\x12J\n\x05\x43lose\x12\x1f.director.knita.io.CloseRequest\x1a .director.knita.io.CloseResponseB+Z)github.com/knita-io/knita/api/director/v1b\x06proto3')
+DESCRIPTOR = _descriptor_pool.Default().AddSerializedFile(b'\n\x1a\x64irector/v1/director.proto\x12\x11\x64irector.knita.io\x1a\x1a\x65xecutor/v1/executor.proto\x1a\x15\x65vents/v1/event.proto\x1a\x1egoogle/protobuf/duration.proto\"M\n\x0bOpenRequest\x12\x10\n\x08\x62uild_id\x18\x01 \x01(\t\x12,\n\x04opts\x18\x02 \x01(\x0b\x32\x1e.executor.knita.io.RuntimeOpts\"k\n\x0cOpenResponse\x12\x12\n\nruntime_id\x18\x01 \x01(\t\x12\x16\n\x0ework_directory\x18\x02 \x01(\t\x12/\n\x08sys_info\x18\x03 \x01(\x0b\x32\x1d.executor.knita.io.SystemInfo\"P\n\rImportRequest\x12\x12\n\nruntime_id\x18\x01 \x01(\t\x12+\n\x04opts\x18\x02 \x01(\x0b\x32\x1d.director.knita.io.ImportOpts\"\xff\x01\n\nImportOpts\x12\x10\n\x08src_path\x18\x01 \x01(\t\x12\x11\n\tdest_path\x18\x02 \x01(\t\x12\x10\n\x08\x65xcludes\x18\x03 \x03(\t\x12)\n\x04meta\x18\x04 \x01(\x0b\x32\x1b.executor.knita.io.OptsMeta\x12\x14\n\x0c\x64isplay_name\x18\x06 \x01(\t\x12\x19\n\x11\x64\x65lete_extraneous\x18\x07 \x01(\x08\x12\x0f\n\x07streams\x18\x08 \x01(\r\x12\x0f\n\x07\x61rchive\x18\t \x01(\x08\x12\x10\n\x08includes\x18\n \x03(\t\x12\x11\n\tgitignore\x18\x0b \x01(\x08\x12\x17\n\x0fstrict_symlinks\x18\x0c \x01(\x08\"\xaf\x01\n\x0eImportResponse\x12,\n\x05\x66iles\x18\x01 \x03(\x0b\x32\x1d.executor.knita.io.FileDigest\x12\x15\n\rskipped_files\x18\x02 \x01(\r\x12\x15\n\rskipped_bytes\x18\x03 \x01(\x04\x12\x15\n\rdeleted_files\x18\x04 \x01(\r\x12\x14\n\x0c\x63\x61\x63hed_files\x18\x05 \x01(\r\x12\x14\n\x0c\x63\x61\x63hed_bytes\x18\x06 \x01(\x04\"P\n\rExportRequest\x12\x12\n\nruntime_id\x18\x01 \x01(\t\x12+\n\x04opts\x18\x02 \x01(\x0b\x32\x1d.director.knita.io.ExportOpts\"\xbf\x01\n\nExportOpts\x12\x10\n\x08src_path\x18\x01 \x01(\t\x12\x11\n\tdest_path\x18\x02 \x01(\t\x12\x10\n\x08\x65xcludes\x18\x03 \x03(\t\x12)\n\x04meta\x18\x04 \x01(\x0b\x32\x1b.executor.knita.io.OptsMeta\x12\x14\n\x0c\x64isplay_name\x18\x06 \x01(\t\x12\x0f\n\x07streams\x18\x07 \x01(\r\x12\x0f\n\x07\x61rchive\x18\x08 \x01(\x08\x12\x17\n\x0fstrict_symlinks\x18\t \x01(\x08\">\n\x0e\x45xportResponse\x12,\n\x05\x66iles\x18\x01 \x03(\x0b\x32\x1d.executor.knita.io.FileDigest\"[\n\x0b\x45xecRequest\x12\x12\n\nruntime_id\x18\x01 \x01(\t\x12)\n\x04opts\x18\x02 \x01(\x0b\x32\x1b.executor.knita.io.ExecOpts\x12\r\n\x05stdin\x18\x03 \x01(\x0c\"D\n\rSignalRequest\x12\x12\n\nruntime_id\x18\x01 \x01(\t\x12\x0f\n\x07\x65xec_id\x18\x02 \x01(\t\x12\x0e\n\x06signal\x18\x03 \x01(\t\"\x10\n\x0eSignalResponse\"\"\n\x0c\x43loseRequest\x12\x12\n\nruntime_id\x18\x01 \x01(\t\"\x0f\n\rCloseResponse\"^\n\x0cPauseRequest\x12\x12\n\nruntime_id\x18\x01 \x01(\t\x12\x0e\n\x06reason\x18\x02 \x01(\t\x12*\n\x07timeout\x18\x03 \x01(\x0b\x32\x19.google.protobuf.Duration\"3\n\rPauseResponse\x12\x0f\n\x07\x61\x62orted\x18\x01 \x01(\x08\x12\x11\n\ttimed_out\x18\x02 \x01(\x08\"\x1e\n\rResumeRequest\x12\r\n\x05\x61\x62ort\x18\x01 \x01(\x08\"!\n\x0eResumeResponse\x12\x0f\n\x07resumed\x18\x01 \x01(\x05\x32\xbe\x05\n\x08\x44irector\x12G\n\x04Open\x12\x1e.director.knita.io.OpenRequest\x1a\x1f.director.knita.io.OpenResponse\x12\x42\n\x04\x45xec\x12\x1e.director.knita.io.ExecRequest\x1a\x16.events.knita.io.Event(\x01\x30\x01\x12M\n\x06Signal\x12 .director.knita.io.SignalRequest\x1a!.director.knita.io.SignalResponse\x12Q\n\x06\x41ttach\x12 .executor.knita.io.AttachRequest\x1a!.executor.knita.io.AttachResponse(\x01\x30\x01\x12M\n\x06Import\x12 .director.knita.io.ImportRequest\x1a!.director.knita.io.ImportResponse\x12M\n\x06\x45xport\x12 .director.knita.io.ExportRequest\x1a!.director.knita.io.ExportResponse\x12J\n\x05\x43lose\x12\x1f.director.knita.io.CloseRequest\x1a .director.knita.io.CloseResponse\x12J\n\x05Pause\x12\x1f.director.knita.io.PauseRequest\x1a .director.knita.io.PauseResponse\x12M\n\x06Resume\x12 .director.knita.io.ResumeRequest\x1a!.director.knita.io.ResumeResponseB+Z)github.com/knita-io/knita/api/director/v1b\x06proto3')
 
 _globals = globals()
 _builder.BuildMessageAndEnumDescriptors(DESCRIPTOR, _globals)
@@ -24,28 +25,40 @@ _builder.BuildTopDescriptorsAndMessages(DESCRIPTOR, 'director.v1.director_pb2',
 if not _descriptor._USE_C_DESCRIPTORS:
   _globals['DESCRIPTOR']._loaded_options = None
   _globals['DESCRIPTOR']._serialized_options = b'Z)github.com/knita-io/knita/api/director/v1'
-  _globals['_OPENREQUEST']._serialized_start=100
-  _globals['_OPENREQUEST']._serialized_end=177
-  _globals['_OPENRESPONSE']._serialized_start=179
-  _globals['_OPENRESPONSE']._serialized_end=286
-  _globals['_IMPORTREQUEST']._serialized_start=288
-  _globals['_IMPORTREQUEST']._serialized_end=368
-  _globals['_IMPORTOPTS']._serialized_start=371
-  _globals['_IMPORTOPTS']._serialized_end=503
-  _globals['_IMPORTRESPONSE']._serialized_start=505
-  _globals['_IMPORTRESPONSE']._serialized_end=521
-  _globals['_EXPORTREQUEST']._serialized_start=523
-  _globals['_EXPORTREQUEST']._serialized_end=603
-  _globals['_EXPORTOPTS']._serialized_start=606
-  _globals['_EXPORTOPTS']._serialized_end=738
-  _globals['_EXPORTRESPONSE']._serialized_start=740
-  _globals['_EXPORTRESPONSE']._serialized_end=756
-  _globals['_EXECREQUEST']._serialized_start=758
-  _globals['_EXECREQUEST']._serialized_end=834
-  _globals['_CLOSEREQUEST']._serialized_start=836
-  _globals['_CLOSEREQUEST']._serialized_end=870
-  _globals['_CLOSERESPONSE']._serialized_start=872
-  _globals['_CLOSERESPONSE']._serialized_end=887
-  _globals['_DIRECTOR']._serialized_start=890
-  _globals['_DIRECTOR']._serialized_end=1273
+  _globals['_OPENREQUEST']._serialized_start=132
+  _globals['_OPENREQUEST']._serialized_end=209
+  _globals['_OPENRESPONSE']._serialized_start=211
+  _globals['_OPENRESPONSE']._serialized_end=318
+  _globals['_IMPORTREQUEST']._serialized_start=320
+  _globals['_IMPORTREQUEST']._serialized_end=400
+  _globals['_IMPORTOPTS']._serialized_start=403
+  _globals['_IMPORTOPTS']._serialized_end=658
+  _globals['_IMPORTRESPONSE']._serialized_start=661
+  _globals['_IMPORTRESPONSE']._serialized_end=836
+  _globals['_EXPORTREQUEST']._serialized_start=838
+  _globals['_EXPORTREQUEST']._serialized_end=918
+  _globals['_EXPORTOPTS']._serialized_start=921
+  _globals['_EXPORTOPTS']._serialized_end=1112
+  _globals['_EXPORTRESPONSE']._serialized_start=1114
+  _globals['_EXPORTRESPONSE']._serialized_end=1176
+  _globals['_EXECREQUEST']._serialized_start=1178
+  _globals['_EXECREQUEST']._serialized_end=1269
+  _globals['_SIGNALREQUEST']._serialized_start=1271
+  _globals['_SIGNALREQUEST']._serialized_end=1339
+  _globals['_SIGNALRESPONSE']._serialized_start=1341
+  _globals['_SIGNALRESPONSE']._serialized_end=1357
+  _globals['_CLOSEREQUEST']._serialized_start=1359
+  _globals['_CLOSEREQUEST']._serialized_end=1393
+  _globals['_CLOSERESPONSE']._serialized_start=1395
+  _globals['_CLOSERESPONSE']._serialized_end=1410
+  _globals['_PAUSEREQUEST']._serialized_start=1412
+  _globals['_PAUSEREQUEST']._serialized_end=1506
+  _globals['_PAUSERESPONSE']._serialized_start=1508
+  _globals['_PAUSERESPONSE']._serialized_end=1559
+  _globals['_RESUMEREQUEST']._serialized_start=1561
+  _globals['_RESUMEREQUEST']._serialized_end=1591
+  _globals['_RESUMERESPONSE']._serialized_start=1593
+  _globals['_RESUMERESPONSE']._serialized_end=1626
+  _globals['_DIRECTOR']._serialized_start=1629
+  _globals['_DIRECTOR']._serialized_end=2331
 # @@protoc_insertion_point(module_scope)
@@ -1,5 +1,6 @@
 from . import executor_pb2 as _executor_pb2
 from . import event_pb2 as _event_pb2
+from google.protobuf import duration_pb2 as _duration_pb2
 from google.protobuf.internal import containers as _containers
 from google.protobuf import descriptor as _descriptor
 from google.protobuf import message as _message
@@ -34,22 +35,46 @@ class ImportRequest(_message.Message):
     def __init__(self, runtime_id: _Optional[str] = ..., opts: _Optional[_Union[ImportOpts, _Mapping]] = ...) -> None: ...
 
 class ImportOpts(_message.Message):
-    __slots__ = ("src_path", "dest_path", "excludes", "meta", "display_name")
+    __slots__ = ("src_path", "dest_path", "excludes", "meta", "display_name", "delete_extraneous", "streams", "archive", "includes", "gitignore", "strict_symlinks")
     SRC_PATH_FIELD_NUMBER: _ClassVar[int]
     DEST_PATH_FIELD_NUMBER: _ClassVar[int]
     EXCLUDES_FIELD_NUMBER: _ClassVar[int]
     META_FIELD_NUMBER: _ClassVar[int]
     DISPLAY_NAME_FIELD_NUMBER: _ClassVar[int]
+    DELETE_EXTRANEOUS_FIELD_NUMBER: _ClassVar[int]
+    STREAMS_FIELD_NUMBER: _ClassVar[int]
+    ARCHIVE_FIELD_NUMBER: _ClassVar[int]
+    INCLUDES_FIELD_NUMBER: _ClassVar[int]
+    GITIGNORE_FIELD_NUMBER: _ClassVar[int]
+    STRICT_SYMLINKS_FIELD_NUMBER: _ClassVar[int]
     src_path: str
     dest_path: str
     excludes: _containers.RepeatedScalarFieldContainer[str]
     meta: _executor_pb2.OptsMeta
     display_name: str
-    def __init__(self, src_path: _Optional[str] = ..., dest_path: _Optional[str] = ..., excludes: _Optional[_Iterable[str]] = ..., meta: _Optional[_Union[_executor_pb2.OptsMeta, _Mapping]] = ..., display_name: _Optional[str] = ...) -> None: ...
+    delete_extraneous: bool
+    streams: int
+    archive: bool
+    includes: _containers.RepeatedScalarFieldContainer[str]
+    gitignore: bool
+    strict_symlinks: bool
+    def __init__(self, src_path: _Optional[str] = ..., dest_path: _Optional[str] = ..., excludes: _Optional[_Iterable[str]] = ..., meta: _Optional[_Union[_executor_pb2.OptsMeta, _Mapping]] = ..., display_name: _Optional[str] = ..., delete_extraneous: bool = ..., streams: _Optional[int] = ..., archive: bool = ..., includes: _Optional[_Iterable[str]] = ..., gitignore: bool = ..., strict_symlinks: bool = ...) -> None: ...
 
 class ImportResponse(_message.Message):
-    __slots__ = ()
-    def __init__(self) -> None: ...
+    __slots__ = ("files", "skipped_files", "skipped_bytes", "deleted_files", "cached_files", "cached_bytes")
+    FILES_FIELD_NUMBER: _ClassVar[int]
+    SKIPPED_FILES_FIELD_NUMBER: _ClassVar[int]
+    SKIPPED_BYTES_FIELD_NUMBER: _ClassVar[int]
+    DELETED_FILES_FIELD_NUMBER: _ClassVar[int]
+    CACHED_FILES_FIELD_NUMBER: _ClassVar[int]
+    CACHED_BYTES_FIELD_NUMBER: _ClassVar[int]
+    files: _containers.RepeatedCompositeFieldContainer[_executor_pb2.FileDigest]
+    skipped_files: int
+    skipped_bytes: int
+    deleted_files: int
+    cached_files: int
+    cached_bytes: int
+    def __init__(self, files: _Optional[_Iterable[_Union[_executor_pb2.FileDigest, _Mapping]]] = ..., skipped_files: _Optional[int] = ..., skipped_bytes: _Optional[int] = ..., deleted_files: _Optional[int] = ..., cached_files: _Optional[int] = ..., cached_bytes: _Optional[int] = ...) -> None: ...
 
 class ExportRequest(_message.Message):
     __slots__ = ("runtime_id", "opts")
@@ -60,30 +85,54 @@ class ExportRequest(_message.Message):
     def __init__(self, runtime_id: _Optional[str] = ..., opts: _Optional[_Union[ExportOpts, _Mapping]] = ...) -> None: ...
 
 class ExportOpts(_message.Message):
-    __slots__ = ("src_path", "dest_path", "excludes", "meta", "display_name")
+    __slots__ = ("src_path", "dest_path", "excludes", "meta", "display_name", "streams", "archive", "strict_symlinks")
     SRC_PATH_FIELD_NUMBER: _ClassVar[int]
     DEST_PATH_FIELD_NUMBER: _ClassVar[int]
     EXCLUDES_FIELD_NUMBER: _ClassVar[int]
     META_FIELD_NUMBER: _ClassVar[int]
     DISPLAY_NAME_FIELD_NUMBER: _ClassVar[int]
+    STREAMS_FIELD_NUMBER: _ClassVar[int]
+    ARCHIVE_FIELD_NUMBER: _ClassVar[int]
+    STRICT_SYMLINKS_FIELD_NUMBER: _ClassVar[int]
     src_path: str
     dest_path: str
     excludes: _containers.RepeatedScalarFieldContainer[str]
     meta: _executor_pb2.OptsMeta
     display_name: str
-    def __init__(self, src_path: _Optional[str] = ..., dest_path: _Optional[str] = ..., excludes: _Optional[_Iterable[str]] = ..., meta: _Optional[_Union[_executor_pb2.OptsMeta, _Mapping]] = ..., display_name: _Optional[str] = ...) -> None: ...
+    streams: int
+    archive: bool
+    strict_symlinks: bool
+    def __init__(self, src_path: _Optional[str] = ..., dest_path: _Optional[str] = ..., excludes: _Optional[_Iterable[str]] = ..., meta: _Optional[_Union[_executor_pb2.OptsMeta, _Mapping]] = ..., display_name: _Optional[str] = ..., streams: _Optional[int] = ..., archive: bool = ..., strict_symlinks: bool = ...) -> None: ...
 
 class ExportResponse(_message.Message):
-    __slots__ = ()
-    def __init__(self) -> None: ...
+    __slots__ = ("files",)
+    FILES_FIELD_NUMBER: _ClassVar[int]
+    files: _containers.RepeatedCompositeFieldContainer[_executor_pb2.FileDigest]
+    def __init__(self, files: _Optional[_Iterable[_Union[_executor_pb2.FileDigest, _Mapping]]] = ...) -> None: ...
 
 class ExecRequest(_message.Message):
-    __slots__ = ("runtime_id", "opts")
+    __slots__ = ("runtime_id", "opts", "stdin")
     RUNTIME_ID_FIELD_NUMBER: _ClassVar[int]
     OPTS_FIELD_NUMBER: _ClassVar[int]
+    STDIN_FIELD_NUMBER: _ClassVar[int]
     runtime_id: str
     opts: _executor_pb2.ExecOpts
-    def __init__(self, runtime_id: _Optional[str] = ..., opts: _Optional[_Union[_executor_pb2.ExecOpts, _Mapping]] = ...) -> None: ...
+    stdin: bytes
+    def __init__(self, runtime_id: _Optional[str] = ..., opts: _Optional[_Union[_executor_pb2.ExecOpts, _Mapping]] = ..., stdin: _Optional[bytes] = ...) -> None: ...
+
+class SignalRequest(_message.Message):
+    __slots__ = ("runtime_id", "exec_id", "signal")
+    RUNTIME_ID_FIELD_NUMBER: _ClassVar[int]
+    EXEC_ID_FIELD_NUMBER: _ClassVar[int]
+    SIGNAL_FIELD_NUMBER: _ClassVar[int]
+    runtime_id: str
+    exec_id: str
+    signal: str
+    def __init__(self, runtime_id: _Optional[str] = ..., exec_id: _Optional[str] = ..., signal: _Optional[str] = ...) -> None: ...
+
+class SignalResponse(_message.Message):
+    __slots__ = ()
+    def __init__(self) -> None: ...
 
 class CloseRequest(_message.Message):
     __slots__ = ("runtime_id",)
@@ -94,3 +143,33 @@ class CloseRequest(_message.Message):
 class CloseResponse(_message.Message):
     __slots__ = ()
     def __init__(self) -> None: ...
+
+class PauseRequest(_message.Message):
+    __slots__ = ("runtime_id", "reason", "timeout")
+    RUNTIME_ID_FIELD_NUMBER: _ClassVar[int]
+    REASON_FIELD_NUMBER: _ClassVar[int]
+    TIMEOUT_FIELD_NUMBER: _ClassVar[int]
+    runtime_id: str
+    reason: str
+    timeout: _duration_pb2.Duration
+    def __init__(self, runtime_id: _Optional[str] = ..., reason: _Optional[str] = ..., timeout: _Optional[_Union[_duration_pb2.Duration, _Mapping]] = ...) -> None: ...
+
+class PauseResponse(_message.Message):
+    __slots__ = ("aborted", "timed_out")
+    ABORTED_FIELD_NUMBER: _ClassVar[int]
+    TIMED_OUT_FIELD_NUMBER: _ClassVar[int]
+    aborted: bool
+    timed_out: bool
+    def __init__(self, aborted: bool = ..., timed_out: bool = ...) -> None: ...
+
+class ResumeRequest(_message.Message):
+    __slots__ = ("abort",)
+    ABORT_FIELD_NUMBER: _ClassVar[int]
+    abort: bool
+    def __init__(self, abort: bool = ...) -> None: ...
+
+class ResumeResponse(_message.Message):
+    __slots__ = ("resumed",)
+    RESUMED_FIELD_NUMBER: _ClassVar[int]
+    resumed: int
+    def __init__(self, resumed: _Optional[int] = ...) -> None: ...
@@ -5,6 +5,7 @@ import warnings
 
 from . import director_pb2 as director_dot_v1_dot_director__pb2
 from . import event_pb2 as events_dot_v1_dot_event__pb2
+from . import executor_pb2 as executor_dot_v1_dot_executor__pb2
 
 GRPC_GENERATED_VERSION = '1.63.0'
 GRPC_VERSION = grpc.__version__
@@ -45,11 +46,21 @@ class DirectorStub(object):
                 request_serializer=director_dot_v1_dot_director__pb2.OpenRequest.SerializeToString,
                 response_deserializer=director_dot_v1_dot_director__pb2.OpenResponse.FromString,
                 _registered_method=True)
-        self.Exec = channel.unary_stream(
+        self.Exec = channel.stream_stream(
                 '/director.knita.io.Director/Exec',
                 request_serializer=director_dot_v1_dot_director__pb2.ExecRequest.SerializeToString,
                 response_deserializer=events_dot_v1_dot_event__pb2.Event.FromString,
                 _registered_method=True)
+        self.Signal = channel.unary_unary(
+                '/director.knita.io.Director/Signal',
+                request_serializer=director_dot_v1_dot_director__pb2.SignalRequest.SerializeToString,
+                response_deserializer=director_dot_v1_dot_director__pb2.SignalResponse.FromString,
+                _registered_method=True)
+        self.Attach = channel.stream_stream(
+                '/director.knita.io.Director/Attach',
+                request_serializer=executor_dot_v1_dot_executor__pb2.AttachRequest.SerializeToString,
+                response_deserializer=executor_dot_v1_dot_executor__pb2.AttachResponse.FromString,
+                _registered_method=True)
         self.Import = channel.unary_unary(
                 '/director.knita.io.Director/Import',
                 request_serializer=director_dot_v1_dot_director__pb2.ImportRequest.SerializeToString,
@@ -65,6 +76,16 @@ class DirectorStub(object):
                 request_serializer=director_dot_v1_dot_director__pb2.CloseRequest.SerializeToString,
                 response_deserializer=director_dot_v1_dot_director__pb2.CloseResponse.FromString,
                 _registered_method=True)
+        self.Pause = channel.unary_unary(
+                '/director.knita.io.Director/Pause',
+                request_serializer=director_dot_v1_dot_director__pb2.PauseRequest.SerializeToString,
+                response_deserializer=director_dot_v1_dot_director__pb2.PauseResponse.FromString,
+                _registered_method=True)
+        self.Resume = channel.unary_unary(
+                '/director.knita.io.Director/Resume',
+                request_serializer=director_dot_v1_dot_director__pb2.ResumeRequest.SerializeToString,
+                response_deserializer=director_dot_v1_dot_director__pb2.ResumeResponse.FromString,
+                _registered_method=True)
 
 
 class DirectorServicer(object):
@@ -76,7 +97,19 @@ class DirectorServicer(object):
         context.set_details('Method not implemented!')
         raise NotImplementedError('Method not implemented!')
 
-    def Exec(self, request, context):
+    def Exec(self, request_iterator, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def Signal(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def Attach(self, request_iterator, context):
         """Missing associated documentation comment in .proto file."""
         context.set_code(grpc.StatusCode.UNIMPLEMENTED)
         context.set_details('Method not implemented!')
@@ -100,6 +133,18 @@ class DirectorServicer(object):
         context.set_details('Method not implemented!')
         raise NotImplementedError('Method not implemented!')
 
+    def Pause(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def Resume(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
 
 def add_DirectorServicer_to_server(servicer, server):
     rpc_method_handlers = {
@@ -108,11 +153,21 @@ def add_DirectorServicer_to_server(servicer, server):
                     request_deserializer=director_dot_v1_dot_director__pb2.OpenRequest.FromString,
                     response_serializer=director_dot_v1_dot_director__pb2.OpenResponse.SerializeToString,
             ),
-            'Exec': grpc.unary_stream_rpc_method_handler(
+            'Exec': grpc.stream_stream_rpc_method_handler(
                     servicer.Exec,
                     request_deserializer=director_dot_v1_dot_director__pb2.ExecRequest.FromString,
                     response_serializer=events_dot_v1_dot_event__pb2.Event.SerializeToString,
             ),
+            'Signal': grpc.unary_unary_rpc_method_handler(
+                    servicer.Signal,
+                    request_deserializer=director_dot_v1_dot_director__pb2.SignalRequest.FromString,
+                    response_serializer=director_dot_v1_dot_director__pb2.SignalResponse.SerializeToString,
+            ),
+            'Attach': grpc.stream_stream_rpc_method_handler(
+                    servicer.Attach,
+                    request_deserializer=executor_dot_v1_dot_executor__pb2.AttachRequest.FromString,
+                    response_serializer=executor_dot_v1_dot_executor__pb2.AttachResponse.SerializeToString,
+            ),
             'Import': grpc.unary_unary_rpc_method_handler(
                     servicer.Import,
                     request_deserializer=director_dot_v1_dot_director__pb2.ImportRequest.FromString,
@@ -128,6 +183,16 @@ def add_DirectorServicer_to_server(servicer, server):
                     request_deserializer=director_dot_v1_dot_director__pb2.CloseRequest.FromString,
                     response_serializer=director_dot_v1_dot_director__pb2.CloseResponse.SerializeToString,
             ),
+            'Pause': grpc.unary_unary_rpc_method_handler(
+                    servicer.Pause,
+                    request_deserializer=director_dot_v1_dot_director__pb2.PauseRequest.FromString,
+                    response_serializer=director_dot_v1_dot_director__pb2.PauseResponse.SerializeToString,
+            ),
+            'Resume': grpc.unary_unary_rpc_method_handler(
+                    servicer.Resume,
+                    request_deserializer=director_dot_v1_dot_director__pb2.ResumeRequest.FromString,
+                    response_serializer=director_dot_v1_dot_director__pb2.ResumeResponse.SerializeToString,
+            ),
     }
     generic_handler = grpc.method_handlers_generic_handler(
             'director.knita.io.Director', rpc_method_handlers)
@@ -166,7 +231,7 @@ class Director(object):
             _registered_method=True)
 
     @staticmethod
-    def Exec(request,
+    def Exec(request_iterator,
             target,
             options=(),
             channel_credentials=None,
@@ -176,8 +241,8 @@ class Director(object):
             wait_for_ready=None,
             timeout=None,
             metadata=None):
-        return grpc.experimental.unary_stream(
-            request,
+        return grpc.experimental.stream_stream(
+            request_iterator,
             target,
             '/director.knita.io.Director/Exec',
             director_dot_v1_dot_director__pb2.ExecRequest.SerializeToString,
@@ -192,6 +257,60 @@ class Director(object):
             metadata,
             _registered_method=True)
 
+    @staticmethod
+    def Signal(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/director.knita.io.Director/Signal',
+            director_dot_v1_dot_director__pb2.SignalRequest.SerializeToString,
+            director_dot_v1_dot_director__pb2.SignalResponse.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def Attach(request_iterator,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.stream_stream(
+            request_iterator,
+            target,
+            '/director.knita.io.Director/Attach',
+            executor_dot_v1_dot_executor__pb2.AttachRequest.SerializeToString,
+            executor_dot_v1_dot_executor__pb2.AttachResponse.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
     @staticmethod
     def Import(request,
             target,
@@ -272,3 +391,57 @@ class Director(object):
             timeout,
             metadata,
             _registered_method=True)
+
+    @staticmethod
+    def Pause(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/director.knita.io.Director/Pause',
+            director_dot_v1_dot_director__pb2.PauseRequest.SerializeToString,
+            director_dot_v1_dot_director__pb2.PauseResponse.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def Resume(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/director.knita.io.Director/Resume',
+            director_dot_v1_dot_director__pb2.ResumeRequest.SerializeToString,
+            director_dot_v1_dot_director__pb2.ResumeResponse.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
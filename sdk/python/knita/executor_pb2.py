@@ -14,9 +14,10 @@ _sym_db = _symbol_database.Default()
 
 from . import event_pb2 as events_dot_v1_dot_event__pb2
 from google.protobuf import duration_pb2 as google_dot_protobuf_dot_duration__pb2
+from google.protobuf import timestamp_pb2 as google_dot_protobuf_dot_timestamp__pb2
 
 
-DESCRIPTOR = _descriptor_pool.Default().AddSerializedFile(b'\n\x1a\x65xecutor/v1/executor.proto\x12\x11\x65xecutor.knita.io\x1a\x15\x65vents/v1/event.proto\x1a\x1egoogle/protobuf/duration.proto\"\x1c\n\x0c\x45xecutorInfo\x12\x0c\n\x04name\x18\x01 \x01(\t\"U\n\nSystemInfo\x12\n\n\x02os\x18\x02 \x01(\t\x12\x0c\n\x04\x61rch\x18\x03 \x01(\t\x12\x17\n\x0ftotal_cpu_cores\x18\x04 \x01(\r\x12\x14\n\x0ctotal_memory\x18\x05 \x01(\x04\"\x13\n\x11IntrospectRequest\"\xef\x01\n\x12IntrospectResponse\x12/\n\x08sys_info\x18\x01 \x01(\x0b\x32\x1d.executor.knita.io.SystemInfo\x12\x36\n\rexecutor_info\x18\x03 \x01(\x0b\x32\x1f.executor.knita.io.ExecutorInfo\x12\x41\n\x06labels\x18\x02 \x03(\x0b\x32\x31.executor.knita.io.IntrospectResponse.LabelsEntry\x1a-\n\x0bLabelsEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\"I\n\rEventsRequest\x12\x10\n\x08\x62uild_id\x18\x01 \x01(\t\x12\x12\n\nruntime_id\x18\x02 \x01(\t\x12\x12\n\nbarrier_id\x18\x03 \x01(\t\"a\n\x0bOpenRequest\x12\x10\n\x08\x62uild_id\x18\x01 \x01(\t\x12\x12\n\nruntime_id\x18\x02 \x01(\t\x12,\n\x04opts\x18\x03 \x01(\x0b\x32\x1e.executor.knita.io.RuntimeOpts\"W\n\x0cOpenResponse\x12\x16\n\x0ework_directory\x18\x01 \x01(\t\x12/\n\x08sys_info\x18\x02 \x01(\x0b\x32\x1d.executor.knita.io.SystemInfo\"&\n\x10HeartbeatRequest\x12\x12\n\nruntime_id\x18\x01 \x01(\t\"C\n\x11HeartbeatResponse\x12.\n\x0b\x65xtended_by\x18\x01 \x01(\x0b\x32\x19.google.protobuf.Duration\"\xe9\x01\n\x08OptsMeta\x12\x37\n\x06labels\x18\x01 \x03(\x0b\x32\'.executor.knita.io.OptsMeta.LabelsEntry\x12\x41\n\x0b\x61nnotations\x18\x02 \x03(\x0b\x32,.executor.knita.io.OptsMeta.AnnotationsEntry\x1a-\n\x0bLabelsEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\x1a\x32\n\x10\x41nnotationsEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\"\x9c\x02\n\x0bRuntimeOpts\x12,\n\x04type\x18\x01 \x01(\x0e\x32\x1e.executor.knita.io.RuntimeType\x12\x38\n\x0elabel_selector\x18\x02 \x01(\x0b\x32 .executor.knita.io.LabelSelector\x12+\n\x04host\x18\x03 \x01(\x0b\x32\x1b.executor.knita.io.HostOptsH\x00\x12/\n\x06\x64ocker\x18\x04 \x01(\x0b\x32\x1d.executor.knita.io.DockerOptsH\x00\x12)\n\x04meta\x18\x05 \x01(\x0b\x32\x1b.executor.knita.io.OptsMeta\x12\x14\n\x0c\x64isplay_name\x18\x07 \x01(\tB\x06\n\x04opts\"\n\n\x08HostOpts\">\n\nDockerOpts\x12\x30\n\x05image\x18\x01 \x01(\x0b\x32!.executor.knita.io.DockerPullOpts\"\x9b\x02\n\x0e\x44ockerPullOpts\x12\x11\n\timage_uri\x18\x01 \x01(\t\x12\x45\n\rpull_strategy\x18\x02 \x01(\x0e\x32..executor.knita.io.DockerPullOpts.PullStrategy\x12/\n\x04\x61uth\x18\x03 \x01(\x0b\x32!.executor.knita.io.DockerPullAuth\"~\n\x0cPullStrategy\x12\x1d\n\x19PULL_STRATEGY_UNSPECIFIED\x10\x00\x12\x17\n\x13PULL_STRATEGY_NEVER\x10\x01\x12\x18\n\x14PULL_STRATEGY_ALWAYS\x10\x02\x12\x1c\n\x18PULL_STRATEGY_NOT_EXISTS\x10\x03\"y\n\x0e\x44ockerPullAuth\x12-\n\x05\x62\x61sic\x18\x01 \x01(\x0b\x32\x1c.executor.knita.io.BasicAuthH\x00\x12\x30\n\x07\x61ws_ecr\x18\x02 \x01(\x0b\x32\x1d.executor.knita.io.AWSECRAuthH\x00\x42\x06\n\x04\x61uth\"/\n\tBasicAuth\x12\x10\n\x08username\x18\x01 \x01(\t\x12\x10\n\x08password\x18\x02 \x01(\t\"O\n\nAWSECRAuth\x12\x0e\n\x06region\x18\x01 \x01(\t\x12\x19\n\x11\x61ws_access_key_id\x18\x02 \x01(\t\x12\x16\n\x0e\x61ws_secret_key\x18\x03 \x01(\t\"q\n\x0b\x45xecRequest\x12\x12\n\nruntime_id\x18\x01 \x01(\t\x12\x0f\n\x07\x65xec_id\x18\x02 \x01(\t\x12\x12\n\nbarrier_id\x18\x03 \x01(\t\x12)\n\x04opts\x18\x04 \x01(\x0b\x32\x1b.executor.knita.io.ExecOpts\"t\n\x08\x45xecOpts\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\x0c\n\x04\x61rgs\x18\x02 \x03(\t\x12\x0b\n\x03\x65nv\x18\x03 \x03(\t\x12)\n\x04meta\x18\x04 \x01(\x0b\x32\x1b.executor.knita.io.OptsMeta\x12\x14\n\x0c\x64isplay_name\x18\x06 \x01(\t\"!\n\x0c\x45xecResponse\x12\x11\n\texit_code\x18\x01 \x01(\x05\"\xeb\x01\n\x0c\x46ileTransfer\x12\x12\n\nruntime_id\x18\x01 \x01(\t\x12\x13\n\x0btransfer_id\x18\x02 \x01(\t\x12\x0f\n\x07\x66ile_id\x18\x03 \x01(\t\x12\x35\n\x06header\x18\x04 \x01(\x0b\x32%.executor.knita.io.FileTransferHeader\x12\x31\n\x04\x62ody\x18\x05 \x01(\x0b\x32#.executor.knita.io.FileTransferBody\x12\x37\n\x07trailer\x18\x06 \x01(\x0b\x32&.executor.knita.io.FileTransferTrailer\"e\n\x12\x46ileTransferHeader\x12\x0e\n\x06is_dir\x18\x01 \x01(\x08\x12\x10\n\x08src_path\x18\x02 \x01(\t\x12\x11\n\tdest_path\x18\x03 \x01(\t\x12\x0c\n\x04mode\x18\x04 \x01(\r\x12\x0c\n\x04size\x18\x05 \x01(\x04\"0\n\x10\x46ileTransferBody\x12\x0e\n\x06offset\x18\x01 \x01(\x04\x12\x0c\n\x04\x64\x61ta\x18\x02 \x01(\x0c\"\"\n\x13\x46ileTransferTrailer\x12\x0b\n\x03md5\x18\x01 \x01(\x0c\"\x10\n\x0eImportResponse\"u\n\rExportRequest\x12\x12\n\nruntime_id\x18\x01 \x01(\t\x12\x11\n\texport_id\x18\x02 \x01(\t\x12\x10\n\x08src_path\x18\x03 \x01(\t\x12+\n\x04opts\x18\x04 \x01(\x0b\x32\x1d.executor.knita.io.ExportOpts\"\\\n\nExportOpts\x12\x11\n\tdest_path\x18\x01 \x01(\t\x12\x10\n\x08\x65xcludes\x18\x02 \x03(\t\x12)\n\x04meta\x18\x03 \x01(\x0b\x32\x1b.executor.knita.io.OptsMeta\"6\n\x0c\x43loseRequest\x12\x12\n\nruntime_id\x18\x01 \x01(\t\x12\x12\n\nbarrier_id\x18\x02 \x01(\t\"\x0f\n\rCloseResponse\"\xd2\x01\n\rLabelSelector\x12\x46\n\x0bmatchLabels\x18\x01 \x03(\x0b\x32\x31.executor.knita.io.LabelSelector.MatchLabelsEntry\x12\x45\n\x10matchExpressions\x18\x02 \x03(\x0b\x32+.executor.knita.io.LabelSelectorRequirement\x1a\x32\n\x10MatchLabelsEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\"\xd9\x01\n\x18LabelSelectorRequirement\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\x46\n\x08operator\x18\x02 \x01(\x0e\x32\x34.executor.knita.io.LabelSelectorRequirement.Operator\x12\x0e\n\x06values\x18\x03 \x03(\t\"X\n\x08Operator\x12\x18\n\x14OPERATOR_UNSPECIFIED\x10\x00\x12\x06\n\x02IN\x10\x01\x12\n\n\x06NOT_IN\x10\x02\x12\n\n\x06\x45XISTS\x10\x03\x12\x12\n\x0e\x44OES_NOT_EXIST\x10\x04*L\n\x0bRuntimeType\x12\x17\n\x13RUNTIME_UNSPECIFIED\x10\x00\x12\x10\n\x0cRUNTIME_HOST\x10\x01\x12\x12\n\x0eRUNTIME_DOCKER\x10\x02\x32\x80\x05\n\x08\x45xecutor\x12Y\n\nIntrospect\x12$.executor.knita.io.IntrospectRequest\x1a%.executor.knita.io.IntrospectResponse\x12\x44\n\x06\x45vents\x12 .executor.knita.io.EventsRequest\x1a\x16.events.knita.io.Event0\x01\x12G\n\x04Open\x12\x1e.executor.knita.io.OpenRequest\x1a\x1f.executor.knita.io.OpenResponse\x12V\n\tHeartbeat\x12#.executor.knita.io.HeartbeatRequest\x1a$.executor.knita.io.HeartbeatResponse\x12G\n\x04\x45xec\x12\x1e.executor.knita.io.ExecRequest\x1a\x1f.executor.knita.io.ExecResponse\x12N\n\x06Import\x12\x1f.executor.knita.io.FileTransfer\x1a!.executor.knita.io.ImportResponse(\x01\x12M\n\x06\x45xport\x12 .executor.knita.io.ExportRequest\x1a\x1f.executor.knita.io.FileTransfer0\x01\x12J\n\x05\x43lose\x12\x1f.executor.knita.io.CloseRequest\x1a .executor.knita.io.CloseResponseB+Z)github.com/knita-io/knita/api/executor/v1b\x06proto3')
+DESCRIPTOR = _descriptor_pool.Default().AddSerializedFile(b'\n\x1a\x65xecutor/v1/executor.proto\x12\x11\x65xecutor.knita.io\x1a\x15\x65vents/v1/event.proto\x1a\x1egoogle/protobuf/duration.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\x1c\n\x0c\x45xecutorInfo\x12\x0c\n\x04name\x18\x01 \x01(\t\"U\n\nSystemInfo\x12\n\n\x02os\x18\x02 \x01(\t\x12\x0c\n\x04\x61rch\x18\x03 \x01(\t\x12\x17\n\x0ftotal_cpu_cores\x18\x04 \x01(\r\x12\x14\n\x0ctotal_memory\x18\x05 \x01(\x04\"\x13\n\x11IntrospectRequest\"\xef\x01\n\x12IntrospectResponse\x12/\n\x08sys_info\x18\x01 \x01(\x0b\x32\x1d.executor.knita.io.SystemInfo\x12\x36\n\rexecutor_info\x18\x03 \x01(\x0b\x32\x1f.executor.knita.io.ExecutorInfo\x12\x41\n\x06labels\x18\x02 \x03(\x0b\x32\x31.executor.knita.io.IntrospectResponse.LabelsEntry\x1a-\n\x0bLabelsEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\"I\n\rEventsRequest\x12\x10\n\x08\x62uild_id\x18\x01 \x01(\t\x12\x12\n\nruntime_id\x18\x02 \x01(\t\x12\x12\n\nbarrier_id\x18\x03 \x01(\t\"a\n\x0bOpenRequest\x12\x10\n\x08\x62uild_id\x18\x01 \x01(\t\x12\x12\n\nruntime_id\x18\x02 \x01(\t\x12,\n\x04opts\x18\x03 \x01(\x0b\x32\x1e.executor.knita.io.RuntimeOpts\"\xc1\x01\n\x0cOpenResponse\x12\x16\n\x0ework_directory\x18\x01 \x01(\t\x12/\n\x08sys_info\x18\x02 \x01(\x0b\x32\x1d.executor.knita.io.SystemInfo\x12\x14\n\x0cimage_digest\x18\x03 \x01(\t\x12\x34\n\x0c\x63ompressions\x18\x04 \x03(\x0e\x32\x1e.executor.knita.io.Compression\x12\x1c\n\x14max_transfer_streams\x18\x05 \x01(\r\"R\n\x10HeartbeatRequest\x12\x12\n\nruntime_id\x18\x01 \x01(\t\x12*\n\x07suspend\x18\x02 \x01(\x0b\x32\x19.google.protobuf.Duration\"C\n\x11HeartbeatResponse\x12.\n\x0b\x65xtended_by\x18\x01 \x01(\x0b\x32\x19.google.protobuf.Duration\"\xe9\x01\n\x08OptsMeta\x12\x37\n\x06labels\x18\x01 \x03(\x0b\x32\'.executor.knita.io.OptsMeta.LabelsEntry\x12\x41\n\x0b\x61nnotations\x18\x02 \x03(\x0b\x32,.executor.knita.io.OptsMeta.AnnotationsEntry\x1a-\n\x0bLabelsEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\x1a\x32\n\x10\x41nnotationsEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\"\xe7\x02\n\x0bRuntimeOpts\x12,\n\x04type\x18\x01 \x01(\x0e\x32\x1e.executor.knita.io.RuntimeType\x12\x38\n\x0elabel_selector\x18\x02 \x01(\x0b\x32 .executor.knita.io.LabelSelector\x12+\n\x04host\x18\x03 \x01(\x0b\x32\x1b.executor.knita.io.HostOptsH\x00\x12/\n\x06\x64ocker\x18\x04 \x01(\x0b\x32\x1d.executor.knita.io.DockerOptsH\x00\x12)\n\x04meta\x18\x05 \x01(\x0b\x32\x1b.executor.knita.io.OptsMeta\x12\x14\n\x0c\x64isplay_name\x18\x07 \x01(\t\x12-\n\x06\x63\x61\x63hes\x18\x08 \x03(\x0b\x32\x1d.executor.knita.io.CacheMount\x12\x0b\n\x03\x65nv\x18\t \x03(\t\x12\r\n\x05shell\x18\n \x03(\tB\x06\n\x04opts\":\n\nCacheMount\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\x0c\n\x04path\x18\x02 \x01(\t\x12\x10\n\x08max_size\x18\x03 \x01(\x03\"\n\n\x08HostOpts\"\xc7\x03\n\nDockerOpts\x12\x30\n\x05image\x18\x01 \x01(\x0b\x32!.executor.knita.io.DockerPullOpts\x12\x36\n\x08services\x18\x02 \x03(\x0b\x32$.executor.knita.io.DockerServiceOpts\x12\x31\n\x05\x62uild\x18\x03 \x01(\x0b\x32\".executor.knita.io.DockerBuildOpts\x12\x0c\n\x04user\x18\x04 \x01(\t\x12\x11\n\tgroup_add\x18\x05 \x03(\t\x12\x0f\n\x07\x63\x61p_add\x18\x06 \x03(\t\x12\x10\n\x08\x63\x61p_drop\x18\x07 \x03(\t\x12\x12\n\nprivileged\x18\x08 \x01(\x08\x12\x18\n\x10read_only_rootfs\x18\t \x01(\x08\x12\x37\n\x05tmpfs\x18\n \x03(\x0b\x32(.executor.knita.io.DockerOpts.TmpfsEntry\x12\x10\n\x08shm_size\x18\x0b \x01(\x03\x12\x14\n\x0cnetwork_mode\x18\x0c \x01(\t\x12\x1b\n\x13mount_docker_socket\x18\r \x01(\x08\x1a,\n\nTmpfsEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\"\xc4\x01\n\x0f\x44ockerBuildOpts\x12\x14\n\x0c\x63ontext_path\x18\x01 \x01(\t\x12\x12\n\ndockerfile\x18\x02 \x01(\t\x12\x45\n\nbuild_args\x18\x03 \x03(\x0b\x32\x31.executor.knita.io.DockerBuildOpts.BuildArgsEntry\x12\x0e\n\x06target\x18\x04 \x01(\t\x1a\x30\n\x0e\x42uildArgsEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\"\xc0\x01\n\x11\x44ockerServiceOpts\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\x30\n\x05image\x18\x02 \x01(\x0b\x32!.executor.knita.io.DockerPullOpts\x12\x0b\n\x03\x65nv\x18\x03 \x03(\t\x12\x0f\n\x07\x61liases\x18\x04 \x03(\t\x12\x0f\n\x07\x63ommand\x18\x05 \x03(\t\x12<\n\treadiness\x18\x06 \x01(\x0b\x32).executor.knita.io.DockerServiceReadiness\"\x82\x01\n\x16\x44ockerServiceReadiness\x12\x0f\n\x07\x63ommand\x18\x01 \x03(\t\x12+\n\x08interval\x18\x02 \x01(\x0b\x32\x19.google.protobuf.Duration\x12*\n\x07timeout\x18\x03 \x01(\x0b\x32\x19.google.protobuf.Duration\"\x9b\x02\n\x0e\x44ockerPullOpts\x12\x11\n\timage_uri\x18\x01 \x01(\t\x12\x45\n\rpull_strategy\x18\x02 \x01(\x0e\x32..executor.knita.io.DockerPullOpts.PullStrategy\x12/\n\x04\x61uth\x18\x03 \x01(\x0b\x32!.executor.knita.io.DockerPullAuth\"~\n\x0cPullStrategy\x12\x1d\n\x19PULL_STRATEGY_UNSPECIFIED\x10\x00\x12\x17\n\x13PULL_STRATEGY_NEVER\x10\x01\x12\x18\n\x14PULL_STRATEGY_ALWAYS\x10\x02\x12\x1c\n\x18PULL_STRATEGY_NOT_EXISTS\x10\x03\"y\n\x0e\x44ockerPullAuth\x12-\n\x05\x62\x61sic\x18\x01 \x01(\x0b\x32\x1c.executor.knita.io.BasicAuthH\x00\x12\x30\n\x07\x61ws_ecr\x18\x02 \x01(\x0b\x32\x1d.executor.knita.io.AWSECRAuthH\x00\x42\x06\n\x04\x61uth\"/\n\tBasicAuth\x12\x10\n\x08username\x18\x01 \x01(\t\x12\x10\n\x08password\x18\x02 \x01(\t\"O\n\nAWSECRAuth\x12\x0e\n\x06region\x18\x01 \x01(\t\x12\x19\n\x11\x61ws_access_key_id\x18\x02 \x01(\t\x12\x16\n\x0e\x61ws_secret_key\x18\x03 \x01(\t\"\x80\x01\n\x0b\x45xecRequest\x12\x12\n\nruntime_id\x18\x01 \x01(\t\x12\x0f\n\x07\x65xec_id\x18\x02 \x01(\t\x12\x12\n\nbarrier_id\x18\x03 \x01(\t\x12)\n\x04opts\x18\x04 \x01(\x0b\x32\x1b.executor.knita.io.ExecOpts\x12\r\n\x05stdin\x18\x05 \x01(\x0c\"\xe1\x02\n\x08\x45xecOpts\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\x0c\n\x04\x61rgs\x18\x02 \x03(\t\x12\x0b\n\x03\x65nv\x18\x03 \x03(\t\x12)\n\x04meta\x18\x04 \x01(\x0b\x32\x1b.executor.knita.io.OptsMeta\x12\x14\n\x0c\x64isplay_name\x18\x06 \x01(\t\x12\r\n\x05stdin\x18\x07 \x01(\x08\x12*\n\x07timeout\x18\x08 \x01(\x0b\x32\x19.google.protobuf.Duration\x12\x1a\n\x12termination_signal\x18\t \x01(\t\x12;\n\x18termination_grace_period\x18\n \x01(\x0b\x32\x19.google.protobuf.Duration\x12\x10\n\x08work_dir\x18\x0b \x01(\t\x12\x0c\n\x04user\x18\x0c \x01(\t\x12\x0e\n\x06script\x18\r \x01(\t\x12\'\n\x03tty\x18\x0e \x01(\x0b\x32\x1a.executor.knita.io.TtyOpts\"%\n\x07TtyOpts\x12\x0c\n\x04rows\x18\x01 \x01(\r\x12\x0c\n\x04\x63ols\x18\x02 \x01(\r\"e\n\x0c\x45xecResponse\x12\x11\n\texit_code\x18\x01 \x01(\x05\x12\x11\n\ttimed_out\x18\x02 \x01(\x08\x12/\n\x05usage\x18\x03 \x01(\x0b\x32 .executor.knita.io.ResourceUsage\"\xf0\x01\n\rResourceUsage\x12,\n\twall_time\x18\x01 \x01(\x0b\x32\x19.google.protobuf.Duration\x12\x30\n\ruser_cpu_time\x18\x02 \x01(\x0b\x32\x19.google.protobuf.Duration\x12\x32\n\x0fsystem_cpu_time\x18\x03 \x01(\x0b\x32\x19.google.protobuf.Duration\x12\x10\n\x08peak_rss\x18\x04 \x01(\x04\x12\x39\n\x0e\x63ontainer_wide\x18\x05 \x01(\x0b\x32!.executor.knita.io.ContainerStats\"\xb7\x02\n\x0e\x43ontainerStats\x12+\n\x08\x63pu_time\x18\x01 \x01(\x0b\x32\x19.google.protobuf.Duration\x12\x30\n\ruser_cpu_time\x18\x02 \x01(\x0b\x32\x19.google.protobuf.Duration\x12\x32\n\x0fsystem_cpu_time\x18\x03 \x01(\x0b\x32\x19.google.protobuf.Duration\x12\x13\n\x0bmemory_peak\x18\x04 \x01(\x04\x12\x14\n\x0cmemory_limit\x18\x05 \x01(\x04\x12\x18\n\x10\x62lock_read_bytes\x18\x06 \x01(\x04\x12\x19\n\x11\x62lock_write_bytes\x18\x07 \x01(\x04\x12\x18\n\x10network_rx_bytes\x18\x08 \x01(\x04\x12\x18\n\x10network_tx_bytes\x18\t \x01(\x04\"D\n\rSignalRequest\x12\x12\n\nruntime_id\x18\x01 \x01(\t\x12\x0f\n\x07\x65xec_id\x18\x02 \x01(\t\x12\x0e\n\x06signal\x18\x03 \x01(\t\"\x10\n\x0eSignalResponse\"\x8b\x01\n\rAttachRequest\x12\x12\n\nruntime_id\x18\x01 \x01(\t\x12+\n\x04opts\x18\x02 \x01(\x0b\x32\x1d.executor.knita.io.AttachOpts\x12\r\n\x05stdin\x18\x03 \x01(\x0c\x12*\n\x06resize\x18\x04 \x01(\x0b\x32\x1a.executor.knita.io.TtyOpts\"S\n\nAttachOpts\x12\x0f\n\x07\x63ommand\x18\x01 \x03(\t\x12\'\n\x03tty\x18\x02 \x01(\x0b\x32\x1a.executor.knita.io.TtyOpts\x12\x0b\n\x03\x65nv\x18\x03 \x03(\t\"C\n\x0e\x41ttachResponse\x12\x0e\n\x06output\x18\x01 \x01(\x0c\x12\x0e\n\x06\x65xited\x18\x02 \x01(\x08\x12\x11\n\texit_code\x18\x03 \x01(\x05\"\xeb\x01\n\x0c\x46ileTransfer\x12\x12\n\nruntime_id\x18\x01 \x01(\t\x12\x13\n\x0btransfer_id\x18\x02 \x01(\t\x12\x0f\n\x07\x66ile_id\x18\x03 \x01(\t\x12\x35\n\x06header\x18\x04 \x01(\x0b\x32%.executor.knita.io.FileTransferHeader\x12\x31\n\x04\x62ody\x18\x05 \x01(\x0b\x32#.executor.knita.io.FileTransferBody\x12\x37\n\x07trailer\x18\x06 \x01(\x0b\x32&.executor.knita.io.FileTransferTrailer\"\xaa\x02\n\x12\x46ileTransferHeader\x12\x0e\n\x06is_dir\x18\x01 \x01(\x08\x12\x10\n\x08src_path\x18\x02 \x01(\t\x12\x11\n\tdest_path\x18\x03 \x01(\t\x12\x0c\n\x04mode\x18\x04 \x01(\r\x12\x0c\n\x04size\x18\x05 \x01(\x04\x12\x33\n\x0b\x63ompression\x18\x06 \x01(\x0e\x32\x1e.executor.knita.io.Compression\x12)\n\x05mtime\x18\x07 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\x0e\n\x06\x64\x65lete\x18\x08 \x01(\x08\x12\x13\n\x0b\x62lob_sha256\x18\t \x01(\x0c\x12)\n\x04type\x18\n \x01(\x0e\x32\x1b.executor.knita.io.FileType\x12\x13\n\x0blink_target\x18\x0b \x01(\t\"0\n\x10\x46ileTransferBody\x12\x0e\n\x06offset\x18\x01 \x01(\x04\x12\x0c\n\x04\x64\x61ta\x18\x02 \x01(\x0c\"2\n\x13\x46ileTransferTrailer\x12\x0b\n\x03md5\x18\x01 \x01(\x0c\x12\x0e\n\x06sha256\x18\x02 \x01(\x0c\"8\n\nFileDigest\x12\x0c\n\x04path\x18\x01 \x01(\t\x12\x0c\n\x04size\x18\x02 \x01(\x04\x12\x0e\n\x06sha256\x18\x03 \x01(\x0c\"3\n\x0fManifestRequest\x12\x12\n\nruntime_id\x18\x01 \x01(\t\x12\x0c\n\x04path\x18\x02 \x01(\t\"E\n\x10ManifestResponse\x12\x31\n\x07\x65ntries\x18\x01 \x03(\x0b\x32 .executor.knita.io.ManifestEntry\"\x82\x01\n\rManifestEntry\x12\x0c\n\x04path\x18\x01 \x01(\t\x12\x0e\n\x06is_dir\x18\x02 \x01(\x08\x12\x0c\n\x04size\x18\x03 \x01(\x04\x12)\n\x05mtime\x18\x04 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\x11\n\x04mode\x18\x05 \x01(\rH\x00\x88\x01\x01\x42\x07\n\x05_mode\";\n\x16ManifestDigestsRequest\x12\x12\n\nruntime_id\x18\x01 \x01(\t\x12\r\n\x05paths\x18\x02 \x03(\t\"G\n\x17ManifestDigestsResponse\x12,\n\x05\x66iles\x18\x01 \x03(\x0b\x32\x1d.executor.knita.io.FileDigest\"3\n\x0c\x42lobsRequest\x12\x12\n\nruntime_id\x18\x01 \x01(\t\x12\x0f\n\x07sha256s\x18\x02 \x03(\x0c\"\x1d\n\rBlobsResponse\x12\x0c\n\x04have\x18\x01 \x03(\x0c\">\n\x0eImportResponse\x12,\n\x05\x66iles\x18\x01 \x03(\x0b\x32\x1d.executor.knita.io.FileDigest\"\xf3\x01\n\rExportRequest\x12\x12\n\nruntime_id\x18\x01 \x01(\t\x12\x11\n\texport_id\x18\x02 \x01(\t\x12\x10\n\x08src_path\x18\x03 \x01(\t\x12+\n\x04opts\x18\x04 \x01(\x0b\x32\x1d.executor.knita.io.ExportOpts\x12;\n\x13\x61\x63\x63\x65pt_compressions\x18\x05 \x03(\x0e\x32\x1e.executor.knita.io.Compression\x12\x13\n\x0bmax_streams\x18\x06 \x01(\r\x12\x14\n\x0cstream_index\x18\x07 \x01(\r\x12\x14\n\x0cstream_count\x18\x08 \x01(\r\"\\\n\nExportOpts\x12\x11\n\tdest_path\x18\x01 \x01(\t\x12\x10\n\x08\x65xcludes\x18\x02 \x03(\t\x12)\n\x04meta\x18\x03 \x01(\x0b\x32\x1b.executor.knita.io.OptsMeta\"6\n\x0c\x43loseRequest\x12\x12\n\nruntime_id\x18\x01 \x01(\t\x12\x12\n\nbarrier_id\x18\x02 \x01(\t\"\x0f\n\rCloseResponse\"\xd2\x01\n\rLabelSelector\x12\x46\n\x0bmatchLabels\x18\x01 \x03(\x0b\x32\x31.executor.knita.io.LabelSelector.MatchLabelsEntry\x12\x45\n\x10matchExpressions\x18\x02 \x03(\x0b\x32+.executor.knita.io.LabelSelectorRequirement\x1a\x32\n\x10MatchLabelsEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\"\xd9\x01\n\x18LabelSelectorRequirement\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\x46\n\x08operator\x18\x02 \x01(\x0e\x32\x34.executor.knita.io.LabelSelectorRequirement.Operator\x12\x0e\n\x06values\x18\x03 \x03(\t\"X\n\x08Operator\x12\x18\n\x14OPERATOR_UNSPECIFIED\x10\x00\x12\x06\n\x02IN\x10\x01\x12\n\n\x06NOT_IN\x10\x02\x12\n\n\x06\x45XISTS\x10\x03\x12\x12\n\x0e\x44OES_NOT_EXIST\x10\x04*L\n\x0bRuntimeType\x12\x17\n\x13RUNTIME_UNSPECIFIED\x10\x00\x12\x10\n\x0cRUNTIME_HOST\x10\x01\x12\x12\n\x0eRUNTIME_DOCKER\x10\x02*K\n\x08\x46ileType\x12\x15\n\x11\x46ILE_TYPE_REGULAR\x10\x00\x12\x11\n\rFILE_TYPE_DIR\x10\x01\x12\x15\n\x11\x46ILE_TYPE_SYMLINK\x10\x02*9\n\x0b\x43ompression\x12\x14\n\x10\x43OMPRESSION_NONE\x10\x00\x12\x14\n\x10\x43OMPRESSION_ZSTD\x10\x01\x32\xb1\x08\n\x08\x45xecutor\x12Y\n\nIntrospect\x12$.executor.knita.io.IntrospectRequest\x1a%.executor.knita.io.IntrospectResponse\x12\x44\n\x06\x45vents\x12 .executor.knita.io.EventsRequest\x1a\x16.events.knita.io.Event0\x01\x12G\n\x04Open\x12\x1e.executor.knita.io.OpenRequest\x1a\x1f.executor.knita.io.OpenResponse\x12V\n\tHeartbeat\x12#.executor.knita.io.HeartbeatRequest\x1a$.executor.knita.io.HeartbeatResponse\x12I\n\x04\x45xec\x12\x1e.executor.knita.io.ExecRequest\x1a\x1f.executor.knita.io.ExecResponse(\x01\x12M\n\x06Signal\x12 .executor.knita.io.SignalRequest\x1a!.executor.knita.io.SignalResponse\x12Q\n\x06\x41ttach\x12 .executor.knita.io.AttachRequest\x1a!.executor.knita.io.AttachResponse(\x01\x30\x01\x12U\n\x08Manifest\x12\".executor.knita.io.ManifestRequest\x1a#.executor.knita.io.ManifestResponse0\x01\x12h\n\x0fManifestDigests\x12).executor.knita.io.ManifestDigestsRequest\x1a*.executor.knita.io.ManifestDigestsResponse\x12J\n\x05\x42lobs\x12\x1f.executor.knita.io.BlobsRequest\x1a .executor.knita.io.BlobsResponse\x12N\n\x06Import\x12\x1f.executor.knita.io.FileTransfer\x1a!.executor.knita.io.ImportResponse(\x01\x12M\n\x06\x45xport\x12 .executor.knita.io.ExportRequest\x1a\x1f.executor.knita.io.FileTransfer0\x01\x12J\n\x05\x43lose\x12\x1f.executor.knita.io.CloseRequest\x1a .executor.knita.io.CloseResponseB+Z)github.com/knita-io/knita/api/executor/v1b\x06proto3')
 
 _globals = globals()
 _builder.BuildMessageAndEnumDescriptors(DESCRIPTOR, _globals)
@@ -30,84 +31,136 @@ if not _descriptor._USE_C_DESCRIPTORS:
   _globals['_OPTSMETA_LABELSENTRY']._serialized_options = b'8\001'
   _globals['_OPTSMETA_ANNOTATIONSENTRY']._loaded_options = None
   _globals['_OPTSMETA_ANNOTATIONSENTRY']._serialized_options = b'8\001'
+  _globals['_DOCKEROPTS_TMPFSENTRY']._loaded_options = None
+  _globals['_DOCKEROPTS_TMPFSENTRY']._serialized_options = b'8\001'
+  _globals['_DOCKERBUILDOPTS_BUILDARGSENTRY']._loaded_options = None
+  _globals['_DOCKERBUILDOPTS_BUILDARGSENTRY']._serialized_options = b'8\001'
   _globals['_LABELSELECTOR_MATCHLABELSENTRY']._loaded_options = None
   _globals['_LABELSELECTOR_MATCHLABELSENTRY']._serialized_options = b'8\001'
-  _globals['_RUNTIMETYPE']._serialized_start=3426
-  _globals['_RUNTIMETYPE']._serialized_end=3502
-  _globals['_EXECUTORINFO']._serialized_start=104
-  _globals['_EXECUTORINFO']._serialized_end=132
-  _globals['_SYSTEMINFO']._serialized_start=134
-  _globals['_SYSTEMINFO']._serialized_end=219
-  _globals['_INTROSPECTREQUEST']._serialized_start=221
-  _globals['_INTROSPECTREQUEST']._serialized_end=240
-  _globals['_INTROSPECTRESPONSE']._serialized_start=243
-  _globals['_INTROSPECTRESPONSE']._serialized_end=482
-  _globals['_INTROSPECTRESPONSE_LABELSENTRY']._serialized_start=437
-  _globals['_INTROSPECTRESPONSE_LABELSENTRY']._serialized_end=482
-  _globals['_EVENTSREQUEST']._serialized_start=484
-  _globals['_EVENTSREQUEST']._serialized_end=557
-  _globals['_OPENREQUEST']._serialized_start=559
-  _globals['_OPENREQUEST']._serialized_end=656
-  _globals['_OPENRESPONSE']._serialized_start=658
-  _globals['_OPENRESPONSE']._serialized_end=745
-  _globals['_HEARTBEATREQUEST']._serialized_start=747
-  _globals['_HEARTBEATREQUEST']._serialized_end=785
-  _globals['_HEARTBEATRESPONSE']._serialized_start=787
-  _globals['_HEARTBEATRESPONSE']._serialized_end=854
-  _globals['_OPTSMETA']._serialized_start=857
-  _globals['_OPTSMETA']._serialized_end=1090
-  _globals['_OPTSMETA_LABELSENTRY']._serialized_start=437
-  _globals['_OPTSMETA_LABELSENTRY']._serialized_end=482
-  _globals['_OPTSMETA_ANNOTATIONSENTRY']._serialized_start=1040
-  _globals['_OPTSMETA_ANNOTATIONSENTRY']._serialized_end=1090
-  _globals['_RUNTIMEOPTS']._serialized_start=1093
-  _globals['_RUNTIMEOPTS']._serialized_end=1377
-  _globals['_HOSTOPTS']._serialized_start=1379
-  _globals['_HOSTOPTS']._serialized_end=1389
-  _globals['_DOCKEROPTS']._serialized_start=1391
-  _globals['_DOCKEROPTS']._serialized_end=1453
-  _globals['_DOCKERPULLOPTS']._serialized_start=1456
-  _globals['_DOCKERPULLOPTS']._serialized_end=1739
-  _globals['_DOCKERPULLOPTS_PULLSTRATEGY']._serialized_start=1613
-  _globals['_DOCKERPULLOPTS_PULLSTRATEGY']._serialized_end=1739
-  _globals['_DOCKERPULLAUTH']._serialized_start=1741
-  _globals['_DOCKERPULLAUTH']._serialized_end=1862
-  _globals['_BASICAUTH']._serialized_start=1864
-  _globals['_BASICAUTH']._serialized_end=1911
-  _globals['_AWSECRAUTH']._serialized_start=1913
-  _globals['_AWSECRAUTH']._serialized_end=1992
-  _globals['_EXECREQUEST']._serialized_start=1994
-  _globals['_EXECREQUEST']._serialized_end=2107
-  _globals['_EXECOPTS']._serialized_start=2109
-  _globals['_EXECOPTS']._serialized_end=2225
-  _globals['_EXECRESPONSE']._serialized_start=2227
-  _globals['_EXECRESPONSE']._serialized_end=2260
-  _globals['_FILETRANSFER']._serialized_start=2263
-  _globals['_FILETRANSFER']._serialized_end=2498
-  _globals['_FILETRANSFERHEADER']._serialized_start=2500
-  _globals['_FILETRANSFERHEADER']._serialized_end=2601
-  _globals['_FILETRANSFERBODY']._serialized_start=2603
-  _globals['_FILETRANSFERBODY']._serialized_end=2651
-  _globals['_FILETRANSFERTRAILER']._serialized_start=2653
-  _globals['_FILETRANSFERTRAILER']._serialized_end=2687
-  _globals['_IMPORTRESPONSE']._serialized_start=2689
-  _globals['_IMPORTRESPONSE']._serialized_end=2705
-  _globals['_EXPORTREQUEST']._serialized_start=2707
-  _globals['_EXPORTREQUEST']._serialized_end=2824
-  _globals['_EXPORTOPTS']._serialized_start=2826
-  _globals['_EXPORTOPTS']._serialized_end=2918
-  _globals['_CLOSEREQUEST']._serialized_start=2920
-  _globals['_CLOSEREQUEST']._serialized_end=2974
-  _globals['_CLOSERESPONSE']._serialized_start=2976
-  _globals['_CLOSERESPONSE']._serialized_end=2991
-  _globals['_LABELSELECTOR']._serialized_start=2994
-  _globals['_LABELSELECTOR']._serialized_end=3204
-  _globals['_LABELSELECTOR_MATCHLABELSENTRY']._serialized_start=3154
-  _globals['_LABELSELECTOR_MATCHLABELSENTRY']._serialized_end=3204
-  _globals['_LABELSELECTORREQUIREMENT']._serialized_start=3207
-  _globals['_LABELSELECTORREQUIREMENT']._serialized_end=3424
-  _globals['_LABELSELECTORREQUIREMENT_OPERATOR']._serialized_start=3336
-  _globals['_LABELSELECTORREQUIREMENT_OPERATOR']._serialized_end=3424
-  _globals['_EXECUTOR']._serialized_start=3505
-  _globals['_EXECUTOR']._serialized_end=4145
+  _globals['_RUNTIMETYPE']._serialized_start=6888
+  _globals['_RUNTIMETYPE']._serialized_end=6964
+  _globals['_FILETYPE']._serialized_start=6966
+  _globals['_FILETYPE']._serialized_end=7041
+  _globals['_COMPRESSION']._serialized_start=7043
+  _globals['_COMPRESSION']._serialized_end=7100
+  _globals['_EXECUTORINFO']._serialized_start=137
+  _globals['_EXECUTORINFO']._serialized_end=165
+  _globals['_SYSTEMINFO']._serialized_start=167
+  _globals['_SYSTEMINFO']._serialized_end=252
+  _globals['_INTROSPECTREQUEST']._serialized_start=254
+  _globals['_INTROSPECTREQUEST']._serialized_end=273
+  _globals['_INTROSPECTRESPONSE']._serialized_start=276
+  _globals['_INTROSPECTRESPONSE']._serialized_end=515
+  _globals['_INTROSPECTRESPONSE_LABELSENTRY']._serialized_start=470
+  _globals['_INTROSPECTRESPONSE_LABELSENTRY']._serialized_end=515
+  _globals['_EVENTSREQUEST']._serialized_start=517
+  _globals['_EVENTSREQUEST']._serialized_end=590
+  _globals['_OPENREQUEST']._serialized_start=592
+  _globals['_OPENREQUEST']._serialized_end=689
+  _globals['_OPENRESPONSE']._serialized_start=692
+  _globals['_OPENRESPONSE']._serialized_end=885
+  _globals['_HEARTBEATREQUEST']._serialized_start=887
+  _globals['_HEARTBEATREQUEST']._serialized_end=969
+  _globals['_HEARTBEATRESPONSE']._serialized_start=971
+  _globals['_HEARTBEATRESPONSE']._serialized_end=1038
+  _globals['_OPTSMETA']._serialized_start=1041
+  _globals['_OPTSMETA']._serialized_end=1274
+  _globals['_OPTSMETA_LABELSENTRY']._serialized_start=470
+  _globals['_OPTSMETA_LABELSENTRY']._serialized_end=515
+  _globals['_OPTSMETA_ANNOTATIONSENTRY']._serialized_start=1224
+  _globals['_OPTSMETA_ANNOTATIONSENTRY']._serialized_end=1274
+  _globals['_RUNTIMEOPTS']._serialized_start=1277
+  _globals['_RUNTIMEOPTS']._serialized_end=1636
+  _globals['_CACHEMOUNT']._serialized_start=1638
+  _globals['_CACHEMOUNT']._serialized_end=1696
+  _globals['_HOSTOPTS']._serialized_start=1698
+  _globals['_HOSTOPTS']._serialized_end=1708
+  _globals['_DOCKEROPTS']._serialized_start=1711
+  _globals['_DOCKEROPTS']._serialized_end=2166
+  _globals['_DOCKEROPTS_TMPFSENTRY']._serialized_start=2122
+  _globals['_DOCKEROPTS_TMPFSENTRY']._serialized_end=2166
+  _globals['_DOCKERBUILDOPTS']._serialized_start=2169
+  _globals['_DOCKERBUILDOPTS']._serialized_end=2365
+  _globals['_DOCKERBUILDOPTS_BUILDARGSENTRY']._serialized_start=2317
+  _globals['_DOCKERBUILDOPTS_BUILDARGSENTRY']._serialized_end=2365
+  _globals['_DOCKERSERVICEOPTS']._serialized_start=2368
+  _globals['_DOCKERSERVICEOPTS']._serialized_end=2560
+  _globals['_DOCKERSERVICEREADINESS']._serialized_start=2563
+  _globals['_DOCKERSERVICEREADINESS']._serialized_end=2693
+  _globals['_DOCKERPULLOPTS']._serialized_start=2696
+  _globals['_DOCKERPULLOPTS']._serialized_end=2979
+  _globals['_DOCKERPULLOPTS_PULLSTRATEGY']._serialized_start=2853
+  _globals['_DOCKERPULLOPTS_PULLSTRATEGY']._serialized_end=2979
+  _globals['_DOCKERPULLAUTH']._serialized_start=2981
+  _globals['_DOCKERPULLAUTH']._serialized_end=3102
+  _globals['_BASICAUTH']._serialized_start=3104
+  _globals['_BASICAUTH']._serialized_end=3151
+  _globals['_AWSECRAUTH']._serialized_start=3153
+  _globals['_AWSECRAUTH']._serialized_end=3232
+  _globals['_EXECREQUEST']._serialized_start=3235
+  _globals['_EXECREQUEST']._serialized_end=3363
+  _globals['_EXECOPTS']._serialized_start=3366
+  _globals['_EXECOPTS']._serialized_end=3719
+  _globals['_TTYOPTS']._serialized_start=3721
+  _globals['_TTYOPTS']._serialized_end=3758
+  _globals['_EXECRESPONSE']._serialized_start=3760
+  _globals['_EXECRESPONSE']._serialized_end=3861
+  _globals['_RESOURCEUSAGE']._serialized_start=3864
+  _globals['_RESOURCEUSAGE']._serialized_end=4104
+  _globals['_CONTAINERSTATS']._serialized_start=4107
+  _globals['_CONTAINERSTATS']._serialized_end=4418
+  _globals['_SIGNALREQUEST']._serialized_start=4420
+  _globals['_SIGNALREQUEST']._serialized_end=4488
+  _globals['_SIGNALRESPONSE']._serialized_start=4490
+  _globals['_SIGNALRESPONSE']._serialized_end=4506
+  _globals['_ATTACHREQUEST']._serialized_start=4509
+  _globals['_ATTACHREQUEST']._serialized_end=4648
+  _globals['_ATTACHOPTS']._serialized_start=4650
+  _globals['_ATTACHOPTS']._serialized_end=4733
+  _globals['_ATTACHRESPONSE']._serialized_start=4735
+  _globals['_ATTACHRESPONSE']._serialized_end=4802
+  _globals['_FILETRANSFER']._serialized_start=4805
+  _globals['_FILETRANSFER']._serialized_end=5040
+  _globals['_FILETRANSFERHEADER']._serialized_start=5043
+  _globals['_FILETRANSFERHEADER']._serialized_end=5341
+  _globals['_FILETRANSFERBODY']._serialized_start=5343
+  _globals['_FILETRANSFERBODY']._serialized_end=5391
+  _globals['_FILETRANSFERTRAILER']._serialized_start=5393
+  _globals['_FILETRANSFERTRAILER']._serialized_end=5443
+  _globals['_FILEDIGEST']._serialized_start=5445
+  _globals['_FILEDIGEST']._serialized_end=5501
+  _globals['_MANIFESTREQUEST']._serialized_start=5503
+  _globals['_MANIFESTREQUEST']._serialized_end=5554
+  _globals['_MANIFESTRESPONSE']._serialized_start=5556
+  _globals['_MANIFESTRESPONSE']._serialized_end=5625
+  _globals['_MANIFESTENTRY']._serialized_start=5628
+  _globals['_MANIFESTENTRY']._serialized_end=5758
+  _globals['_MANIFESTDIGESTSREQUEST']._serialized_start=5760
+  _globals['_MANIFESTDIGESTSREQUEST']._serialized_end=5819
+  _globals['_MANIFESTDIGESTSRESPONSE']._serialized_start=5821
+  _globals['_MANIFESTDIGESTSRESPONSE']._serialized_end=5892
+  _globals['_BLOBSREQUEST']._serialized_start=5894
+  _globals['_BLOBSREQUEST']._serialized_end=5945
+  _globals['_BLOBSRESPONSE']._serialized_start=5947
+  _globals['_BLOBSRESPONSE']._serialized_end=5976
+  _globals['_IMPORTRESPONSE']._serialized_start=5978
+  _globals['_IMPORTRESPONSE']._serialized_end=6040
+  _globals['_EXPORTREQUEST']._serialized_start=6043
+  _globals['_EXPORTREQUEST']._serialized_end=6286
+  _globals['_EXPORTOPTS']._serialized_start=6288
+  _globals['_EXPORTOPTS']._serialized_end=6380
+  _globals['_CLOSEREQUEST']._serialized_start=6382
+  _globals['_CLOSEREQUEST']._serialized_end=6436
+  _globals['_CLOSERESPONSE']._serialized_start=6438
+  _globals['_CLOSERESPONSE']._serialized_end=6453
+  _globals['_LABELSELECTOR']._serialized_start=6456
+  _globals['_LABELSELECTOR']._serialized_end=6666
+  _globals['_LABELSELECTOR_MATCHLABELSENTRY']._serialized_start=6616
+  _globals['_LABELSELECTOR_MATCHLABELSENTRY']._serialized_end=6666
+  _globals['_LABELSELECTORREQUIREMENT']._serialized_start=6669
+  _globals['_LABELSELECTORREQUIREMENT']._serialized_end=6886
+  _globals['_LABELSELECTORREQUIREMENT_OPERATOR']._serialized_start=6798
+  _globals['_LABELSELECTORREQUIREMENT_OPERATOR']._serialized_end=6886
+  _globals['_EXECUTOR']._serialized_start=7103
+  _globals['_EXECUTOR']._serialized_end=8176
 # @@protoc_insertion_point(module_scope)
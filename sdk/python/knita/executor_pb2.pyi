@@ -1,5 +1,6 @@
 from . import event_pb2 as _event_pb2
 from google.protobuf import duration_pb2 as _duration_pb2
+from google.protobuf import timestamp_pb2 as _timestamp_pb2
 from google.protobuf.internal import containers as _containers
 from google.protobuf.internal import enum_type_wrapper as _enum_type_wrapper
 from google.protobuf import descriptor as _descriptor
@@ -13,9 +14,23 @@ class RuntimeType(int, metaclass=_enum_type_wrapper.EnumTypeWrapper):
     RUNTIME_UNSPECIFIED: _ClassVar[RuntimeType]
     RUNTIME_HOST: _ClassVar[RuntimeType]
     RUNTIME_DOCKER: _ClassVar[RuntimeType]
+class FileType(int, metaclass=_enum_type_wrapper.EnumTypeWrapper):
+    __slots__ = ()
+    FILE_TYPE_REGULAR: _ClassVar[FileType]
+    FILE_TYPE_DIR: _ClassVar[FileType]
+    FILE_TYPE_SYMLINK: _ClassVar[FileType]
+class Compression(int, metaclass=_enum_type_wrapper.EnumTypeWrapper):
+    __slots__ = ()
+    COMPRESSION_NONE: _ClassVar[Compression]
+    COMPRESSION_ZSTD: _ClassVar[Compression]
 RUNTIME_UNSPECIFIED: RuntimeType
 RUNTIME_HOST: RuntimeType
 RUNTIME_DOCKER: RuntimeType
+FILE_TYPE_REGULAR: FileType
+FILE_TYPE_DIR: FileType
+FILE_TYPE_SYMLINK: FileType
+COMPRESSION_NONE: Compression
+COMPRESSION_ZSTD: Compression
 
 class ExecutorInfo(_message.Message):
     __slots__ = ("name",)
@@ -77,18 +92,26 @@ class OpenRequest(_message.Message):
     def __init__(self, build_id: _Optional[str] = ..., runtime_id: _Optional[str] = ..., opts: _Optional[_Union[RuntimeOpts, _Mapping]] = ...) -> None: ...
 
 class OpenResponse(_message.Message):
-    __slots__ = ("work_directory", "sys_info")
+    __slots__ = ("work_directory", "sys_info", "image_digest", "compressions", "max_transfer_streams")
     WORK_DIRECTORY_FIELD_NUMBER: _ClassVar[int]
     SYS_INFO_FIELD_NUMBER: _ClassVar[int]
+    IMAGE_DIGEST_FIELD_NUMBER: _ClassVar[int]
+    COMPRESSIONS_FIELD_NUMBER: _ClassVar[int]
+    MAX_TRANSFER_STREAMS_FIELD_NUMBER: _ClassVar[int]
     work_directory: str
     sys_info: SystemInfo
-    def __init__(self, work_directory: _Optional[str] = ..., sys_info: _Optional[_Union[SystemInfo, _Mapping]] = ...) -> None: ...
+    image_digest: str
+    compressions: _containers.RepeatedScalarFieldContainer[Compression]
+    max_transfer_streams: int
+    def __init__(self, work_directory: _Optional[str] = ..., sys_info: _Optional[_Union[SystemInfo, _Mapping]] = ..., image_digest: _Optional[str] = ..., compressions: _Optional[_Iterable[_Union[Compression, str]]] = ..., max_transfer_streams: _Optional[int] = ...) -> None: ...
 
 class HeartbeatRequest(_message.Message):
-    __slots__ = ("runtime_id",)
+    __slots__ = ("runtime_id", "suspend")
     RUNTIME_ID_FIELD_NUMBER: _ClassVar[int]
+    SUSPEND_FIELD_NUMBER: _ClassVar[int]
     runtime_id: str
-    def __init__(self, runtime_id: _Optional[str] = ...) -> None: ...
+    suspend: _duration_pb2.Duration
+    def __init__(self, runtime_id: _Optional[str] = ..., suspend: _Optional[_Union[_duration_pb2.Duration, _Mapping]] = ...) -> None: ...
 
 class HeartbeatResponse(_message.Message):
     __slots__ = ("extended_by",)
@@ -119,30 +142,122 @@ class OptsMeta(_message.Message):
     def __init__(self, labels: _Optional[_Mapping[str, str]] = ..., annotations: _Optional[_Mapping[str, str]] = ...) -> None: ...
 
 class RuntimeOpts(_message.Message):
-    __slots__ = ("type", "label_selector", "host", "docker", "meta", "display_name")
+    __slots__ = ("type", "label_selector", "host", "docker", "meta", "display_name", "caches", "env", "shell")
     TYPE_FIELD_NUMBER: _ClassVar[int]
     LABEL_SELECTOR_FIELD_NUMBER: _ClassVar[int]
     HOST_FIELD_NUMBER: _ClassVar[int]
     DOCKER_FIELD_NUMBER: _ClassVar[int]
     META_FIELD_NUMBER: _ClassVar[int]
     DISPLAY_NAME_FIELD_NUMBER: _ClassVar[int]
+    CACHES_FIELD_NUMBER: _ClassVar[int]
+    ENV_FIELD_NUMBER: _ClassVar[int]
+    SHELL_FIELD_NUMBER: _ClassVar[int]
     type: RuntimeType
     label_selector: LabelSelector
     host: HostOpts
     docker: DockerOpts
     meta: OptsMeta
     display_name: str
-    def __init__(self, type: _Optional[_Union[RuntimeType, str]] = ..., label_selector: _Optional[_Union[LabelSelector, _Mapping]] = ..., host: _Optional[_Union[HostOpts, _Mapping]] = ..., docker: _Optional[_Union[DockerOpts, _Mapping]] = ..., meta: _Optional[_Union[OptsMeta, _Mapping]] = ..., display_name: _Optional[str] = ...) -> None: ...
+    caches: _containers.RepeatedCompositeFieldContainer[CacheMount]
+    env: _containers.RepeatedScalarFieldContainer[str]
+    shell: _containers.RepeatedScalarFieldContainer[str]
+    def __init__(self, type: _Optional[_Union[RuntimeType, str]] = ..., label_selector: _Optional[_Union[LabelSelector, _Mapping]] = ..., host: _Optional[_Union[HostOpts, _Mapping]] = ..., docker: _Optional[_Union[DockerOpts, _Mapping]] = ..., meta: _Optional[_Union[OptsMeta, _Mapping]] = ..., display_name: _Optional[str] = ..., caches: _Optional[_Iterable[_Union[CacheMount, _Mapping]]] = ..., env: _Optional[_Iterable[str]] = ..., shell: _Optional[_Iterable[str]] = ...) -> None: ...
+
+class CacheMount(_message.Message):
+    __slots__ = ("name", "path", "max_size")
+    NAME_FIELD_NUMBER: _ClassVar[int]
+    PATH_FIELD_NUMBER: _ClassVar[int]
+    MAX_SIZE_FIELD_NUMBER: _ClassVar[int]
+    name: str
+    path: str
+    max_size: int
+    def __init__(self, name: _Optional[str] = ..., path: _Optional[str] = ..., max_size: _Optional[int] = ...) -> None: ...
 
 class HostOpts(_message.Message):
     __slots__ = ()
     def __init__(self) -> None: ...
 
 class DockerOpts(_message.Message):
-    __slots__ = ("image",)
+    __slots__ = ("image", "services", "build", "user", "group_add", "cap_add", "cap_drop", "privileged", "read_only_rootfs", "tmpfs", "shm_size", "network_mode", "mount_docker_socket")
+    class TmpfsEntry(_message.Message):
+        __slots__ = ("key", "value")
+        KEY_FIELD_NUMBER: _ClassVar[int]
+        VALUE_FIELD_NUMBER: _ClassVar[int]
+        key: str
+        value: str
+        def __init__(self, key: _Optional[str] = ..., value: _Optional[str] = ...) -> None: ...
+    IMAGE_FIELD_NUMBER: _ClassVar[int]
+    SERVICES_FIELD_NUMBER: _ClassVar[int]
+    BUILD_FIELD_NUMBER: _ClassVar[int]
+    USER_FIELD_NUMBER: _ClassVar[int]
+    GROUP_ADD_FIELD_NUMBER: _ClassVar[int]
+    CAP_ADD_FIELD_NUMBER: _ClassVar[int]
+    CAP_DROP_FIELD_NUMBER: _ClassVar[int]
+    PRIVILEGED_FIELD_NUMBER: _ClassVar[int]
+    READ_ONLY_ROOTFS_FIELD_NUMBER: _ClassVar[int]
+    TMPFS_FIELD_NUMBER: _ClassVar[int]
+    SHM_SIZE_FIELD_NUMBER: _ClassVar[int]
+    NETWORK_MODE_FIELD_NUMBER: _ClassVar[int]
+    MOUNT_DOCKER_SOCKET_FIELD_NUMBER: _ClassVar[int]
+    image: DockerPullOpts
+    services: _containers.RepeatedCompositeFieldContainer[DockerServiceOpts]
+    build: DockerBuildOpts
+    user: str
+    group_add: _containers.RepeatedScalarFieldContainer[str]
+    cap_add: _containers.RepeatedScalarFieldContainer[str]
+    cap_drop: _containers.RepeatedScalarFieldContainer[str]
+    privileged: bool
+    read_only_rootfs: bool
+    tmpfs: _containers.ScalarMap[str, str]
+    shm_size: int
+    network_mode: str
+    mount_docker_socket: bool
+    def __init__(self, image: _Optional[_Union[DockerPullOpts, _Mapping]] = ..., services: _Optional[_Iterable[_Union[DockerServiceOpts, _Mapping]]] = ..., build: _Optional[_Union[DockerBuildOpts, _Mapping]] = ..., user: _Optional[str] = ..., group_add: _Optional[_Iterable[str]] = ..., cap_add: _Optional[_Iterable[str]] = ..., cap_drop: _Optional[_Iterable[str]] = ..., privileged: bool = ..., read_only_rootfs: bool = ..., tmpfs: _Optional[_Mapping[str, str]] = ..., shm_size: _Optional[int] = ..., network_mode: _Optional[str] = ..., mount_docker_socket: bool = ...) -> None: ...
+
+class DockerBuildOpts(_message.Message):
+    __slots__ = ("context_path", "dockerfile", "build_args", "target")
+    class BuildArgsEntry(_message.Message):
+        __slots__ = ("key", "value")
+        KEY_FIELD_NUMBER: _ClassVar[int]
+        VALUE_FIELD_NUMBER: _ClassVar[int]
+        key: str
+        value: str
+        def __init__(self, key: _Optional[str] = ..., value: _Optional[str] = ...) -> None: ...
+    CONTEXT_PATH_FIELD_NUMBER: _ClassVar[int]
+    DOCKERFILE_FIELD_NUMBER: _ClassVar[int]
+    BUILD_ARGS_FIELD_NUMBER: _ClassVar[int]
+    TARGET_FIELD_NUMBER: _ClassVar[int]
+    context_path: str
+    dockerfile: str
+    build_args: _containers.ScalarMap[str, str]
+    target: str
+    def __init__(self, context_path: _Optional[str] = ..., dockerfile: _Optional[str] = ..., build_args: _Optional[_Mapping[str, str]] = ..., target: _Optional[str] = ...) -> None: ...
+
+class DockerServiceOpts(_message.Message):
+    __slots__ = ("name", "image", "env", "aliases", "command", "readiness")
+    NAME_FIELD_NUMBER: _ClassVar[int]
     IMAGE_FIELD_NUMBER: _ClassVar[int]
+    ENV_FIELD_NUMBER: _ClassVar[int]
+    ALIASES_FIELD_NUMBER: _ClassVar[int]
+    COMMAND_FIELD_NUMBER: _ClassVar[int]
+    READINESS_FIELD_NUMBER: _ClassVar[int]
+    name: str
     image: DockerPullOpts
-    def __init__(self, image: _Optional[_Union[DockerPullOpts, _Mapping]] = ...) -> None: ...
+    env: _containers.RepeatedScalarFieldContainer[str]
+    aliases: _containers.RepeatedScalarFieldContainer[str]
+    command: _containers.RepeatedScalarFieldContainer[str]
+    readiness: DockerServiceReadiness
+    def __init__(self, name: _Optional[str] = ..., image: _Optional[_Union[DockerPullOpts, _Mapping]] = ..., env: _Optional[_Iterable[str]] = ..., aliases: _Optional[_Iterable[str]] = ..., command: _Optional[_Iterable[str]] = ..., readiness: _Optional[_Union[DockerServiceReadiness, _Mapping]] = ...) -> None: ...
+
+class DockerServiceReadiness(_message.Message):
+    __slots__ = ("command", "interval", "timeout")
+    COMMAND_FIELD_NUMBER: _ClassVar[int]
+    INTERVAL_FIELD_NUMBER: _ClassVar[int]
+    TIMEOUT_FIELD_NUMBER: _ClassVar[int]
+    command: _containers.RepeatedScalarFieldContainer[str]
+    interval: _duration_pb2.Duration
+    timeout: _duration_pb2.Duration
+    def __init__(self, command: _Optional[_Iterable[str]] = ..., interval: _Optional[_Union[_duration_pb2.Duration, _Mapping]] = ..., timeout: _Optional[_Union[_duration_pb2.Duration, _Mapping]] = ...) -> None: ...
 
 class DockerPullOpts(_message.Message):
     __slots__ = ("image_uri", "pull_strategy", "auth")
@@ -191,36 +306,148 @@ class AWSECRAuth(_message.Message):
     def __init__(self, region: _Optional[str] = ..., aws_access_key_id: _Optional[str] = ..., aws_secret_key: _Optional[str] = ...) -> None: ...
 
 class ExecRequest(_message.Message):
-    __slots__ = ("runtime_id", "exec_id", "barrier_id", "opts")
+    __slots__ = ("runtime_id", "exec_id", "barrier_id", "opts", "stdin")
     RUNTIME_ID_FIELD_NUMBER: _ClassVar[int]
     EXEC_ID_FIELD_NUMBER: _ClassVar[int]
     BARRIER_ID_FIELD_NUMBER: _ClassVar[int]
     OPTS_FIELD_NUMBER: _ClassVar[int]
+    STDIN_FIELD_NUMBER: _ClassVar[int]
     runtime_id: str
     exec_id: str
     barrier_id: str
     opts: ExecOpts
-    def __init__(self, runtime_id: _Optional[str] = ..., exec_id: _Optional[str] = ..., barrier_id: _Optional[str] = ..., opts: _Optional[_Union[ExecOpts, _Mapping]] = ...) -> None: ...
+    stdin: bytes
+    def __init__(self, runtime_id: _Optional[str] = ..., exec_id: _Optional[str] = ..., barrier_id: _Optional[str] = ..., opts: _Optional[_Union[ExecOpts, _Mapping]] = ..., stdin: _Optional[bytes] = ...) -> None: ...
 
 class ExecOpts(_message.Message):
-    __slots__ = ("name", "args", "env", "meta", "display_name")
+    __slots__ = ("name", "args", "env", "meta", "display_name", "stdin", "timeout", "termination_signal", "termination_grace_period", "work_dir", "user", "script", "tty")
     NAME_FIELD_NUMBER: _ClassVar[int]
     ARGS_FIELD_NUMBER: _ClassVar[int]
     ENV_FIELD_NUMBER: _ClassVar[int]
     META_FIELD_NUMBER: _ClassVar[int]
     DISPLAY_NAME_FIELD_NUMBER: _ClassVar[int]
+    STDIN_FIELD_NUMBER: _ClassVar[int]
+    TIMEOUT_FIELD_NUMBER: _ClassVar[int]
+    TERMINATION_SIGNAL_FIELD_NUMBER: _ClassVar[int]
+    TERMINATION_GRACE_PERIOD_FIELD_NUMBER: _ClassVar[int]
+    WORK_DIR_FIELD_NUMBER: _ClassVar[int]
+    USER_FIELD_NUMBER: _ClassVar[int]
+    SCRIPT_FIELD_NUMBER: _ClassVar[int]
+    TTY_FIELD_NUMBER: _ClassVar[int]
     name: str
     args: _containers.RepeatedScalarFieldContainer[str]
     env: _containers.RepeatedScalarFieldContainer[str]
     meta: OptsMeta
     display_name: str
-    def __init__(self, name: _Optional[str] = ..., args: _Optional[_Iterable[str]] = ..., env: _Optional[_Iterable[str]] = ..., meta: _Optional[_Union[OptsMeta, _Mapping]] = ..., display_name: _Optional[str] = ...) -> None: ...
+    stdin: bool
+    timeout: _duration_pb2.Duration
+    termination_signal: str
+    termination_grace_period: _duration_pb2.Duration
+    work_dir: str
+    user: str
+    script: str
+    tty: TtyOpts
+    def __init__(self, name: _Optional[str] = ..., args: _Optional[_Iterable[str]] = ..., env: _Optional[_Iterable[str]] = ..., meta: _Optional[_Union[OptsMeta, _Mapping]] = ..., display_name: _Optional[str] = ..., stdin: bool = ..., timeout: _Optional[_Union[_duration_pb2.Duration, _Mapping]] = ..., termination_signal: _Optional[str] = ..., termination_grace_period: _Optional[_Union[_duration_pb2.Duration, _Mapping]] = ..., work_dir: _Optional[str] = ..., user: _Optional[str] = ..., script: _Optional[str] = ..., tty: _Optional[_Union[TtyOpts, _Mapping]] = ...) -> None: ...
+
+class TtyOpts(_message.Message):
+    __slots__ = ("rows", "cols")
+    ROWS_FIELD_NUMBER: _ClassVar[int]
+    COLS_FIELD_NUMBER: _ClassVar[int]
+    rows: int
+    cols: int
+    def __init__(self, rows: _Optional[int] = ..., cols: _Optional[int] = ...) -> None: ...
 
 class ExecResponse(_message.Message):
-    __slots__ = ("exit_code",)
+    __slots__ = ("exit_code", "timed_out", "usage")
+    EXIT_CODE_FIELD_NUMBER: _ClassVar[int]
+    TIMED_OUT_FIELD_NUMBER: _ClassVar[int]
+    USAGE_FIELD_NUMBER: _ClassVar[int]
+    exit_code: int
+    timed_out: bool
+    usage: ResourceUsage
+    def __init__(self, exit_code: _Optional[int] = ..., timed_out: bool = ..., usage: _Optional[_Union[ResourceUsage, _Mapping]] = ...) -> None: ...
+
+class ResourceUsage(_message.Message):
+    __slots__ = ("wall_time", "user_cpu_time", "system_cpu_time", "peak_rss", "container_wide")
+    WALL_TIME_FIELD_NUMBER: _ClassVar[int]
+    USER_CPU_TIME_FIELD_NUMBER: _ClassVar[int]
+    SYSTEM_CPU_TIME_FIELD_NUMBER: _ClassVar[int]
+    PEAK_RSS_FIELD_NUMBER: _ClassVar[int]
+    CONTAINER_WIDE_FIELD_NUMBER: _ClassVar[int]
+    wall_time: _duration_pb2.Duration
+    user_cpu_time: _duration_pb2.Duration
+    system_cpu_time: _duration_pb2.Duration
+    peak_rss: int
+    container_wide: ContainerStats
+    def __init__(self, wall_time: _Optional[_Union[_duration_pb2.Duration, _Mapping]] = ..., user_cpu_time: _Optional[_Union[_duration_pb2.Duration, _Mapping]] = ..., system_cpu_time: _Optional[_Union[_duration_pb2.Duration, _Mapping]] = ..., peak_rss: _Optional[int] = ..., container_wide: _Optional[_Union[ContainerStats, _Mapping]] = ...) -> None: ...
+
+class ContainerStats(_message.Message):
+    __slots__ = ("cpu_time", "user_cpu_time", "system_cpu_time", "memory_peak", "memory_limit", "block_read_bytes", "block_write_bytes", "network_rx_bytes", "network_tx_bytes")
+    CPU_TIME_FIELD_NUMBER: _ClassVar[int]
+    USER_CPU_TIME_FIELD_NUMBER: _ClassVar[int]
+    SYSTEM_CPU_TIME_FIELD_NUMBER: _ClassVar[int]
+    MEMORY_PEAK_FIELD_NUMBER: _ClassVar[int]
+    MEMORY_LIMIT_FIELD_NUMBER: _ClassVar[int]
+    BLOCK_READ_BYTES_FIELD_NUMBER: _ClassVar[int]
+    BLOCK_WRITE_BYTES_FIELD_NUMBER: _ClassVar[int]
+    NETWORK_RX_BYTES_FIELD_NUMBER: _ClassVar[int]
+    NETWORK_TX_BYTES_FIELD_NUMBER: _ClassVar[int]
+    cpu_time: _duration_pb2.Duration
+    user_cpu_time: _duration_pb2.Duration
+    system_cpu_time: _duration_pb2.Duration
+    memory_peak: int
+    memory_limit: int
+    block_read_bytes: int
+    block_write_bytes: int
+    network_rx_bytes: int
+    network_tx_bytes: int
+    def __init__(self, cpu_time: _Optional[_Union[_duration_pb2.Duration, _Mapping]] = ..., user_cpu_time: _Optional[_Union[_duration_pb2.Duration, _Mapping]] = ..., system_cpu_time: _Optional[_Union[_duration_pb2.Duration, _Mapping]] = ..., memory_peak: _Optional[int] = ..., memory_limit: _Optional[int] = ..., block_read_bytes: _Optional[int] = ..., block_write_bytes: _Optional[int] = ..., network_rx_bytes: _Optional[int] = ..., network_tx_bytes: _Optional[int] = ...) -> None: ...
+
+class SignalRequest(_message.Message):
+    __slots__ = ("runtime_id", "exec_id", "signal")
+    RUNTIME_ID_FIELD_NUMBER: _ClassVar[int]
+    EXEC_ID_FIELD_NUMBER: _ClassVar[int]
+    SIGNAL_FIELD_NUMBER: _ClassVar[int]
+    runtime_id: str
+    exec_id: str
+    signal: str
+    def __init__(self, runtime_id: _Optional[str] = ..., exec_id: _Optional[str] = ..., signal: _Optional[str] = ...) -> None: ...
+
+class SignalResponse(_message.Message):
+    __slots__ = ()
+    def __init__(self) -> None: ...
+
+class AttachRequest(_message.Message):
+    __slots__ = ("runtime_id", "opts", "stdin", "resize")
+    RUNTIME_ID_FIELD_NUMBER: _ClassVar[int]
+    OPTS_FIELD_NUMBER: _ClassVar[int]
+    STDIN_FIELD_NUMBER: _ClassVar[int]
+    RESIZE_FIELD_NUMBER: _ClassVar[int]
+    runtime_id: str
+    opts: AttachOpts
+    stdin: bytes
+    resize: TtyOpts
+    def __init__(self, runtime_id: _Optional[str] = ..., opts: _Optional[_Union[AttachOpts, _Mapping]] = ..., stdin: _Optional[bytes] = ..., resize: _Optional[_Union[TtyOpts, _Mapping]] = ...) -> None: ...
+
+class AttachOpts(_message.Message):
+    __slots__ = ("command", "tty", "env")
+    COMMAND_FIELD_NUMBER: _ClassVar[int]
+    TTY_FIELD_NUMBER: _ClassVar[int]
+    ENV_FIELD_NUMBER: _ClassVar[int]
+    command: _containers.RepeatedScalarFieldContainer[str]
+    tty: TtyOpts
+    env: _containers.RepeatedScalarFieldContainer[str]
+    def __init__(self, command: _Optional[_Iterable[str]] = ..., tty: _Optional[_Union[TtyOpts, _Mapping]] = ..., env: _Optional[_Iterable[str]] = ...) -> None: ...
+
+class AttachResponse(_message.Message):
+    __slots__ = ("output", "exited", "exit_code")
+    OUTPUT_FIELD_NUMBER: _ClassVar[int]
+    EXITED_FIELD_NUMBER: _ClassVar[int]
     EXIT_CODE_FIELD_NUMBER: _ClassVar[int]
+    output: bytes
+    exited: bool
     exit_code: int
-    def __init__(self, exit_code: _Optional[int] = ...) -> None: ...
+    def __init__(self, output: _Optional[bytes] = ..., exited: bool = ..., exit_code: _Optional[int] = ...) -> None: ...
 
 class FileTransfer(_message.Message):
     __slots__ = ("runtime_id", "transfer_id", "file_id", "header", "body", "trailer")
@@ -239,18 +466,30 @@ class FileTransfer(_message.Message):
     def __init__(self, runtime_id: _Optional[str] = ..., transfer_id: _Optional[str] = ..., file_id: _Optional[str] = ..., header: _Optional[_Union[FileTransferHeader, _Mapping]] = ..., body: _Optional[_Union[FileTransferBody, _Mapping]] = ..., trailer: _Optional[_Union[FileTransferTrailer, _Mapping]] = ...) -> None: ...
 
 class FileTransferHeader(_message.Message):
-    __slots__ = ("is_dir", "src_path", "dest_path", "mode", "size")
+    __slots__ = ("is_dir", "src_path", "dest_path", "mode", "size", "compression", "mtime", "delete", "blob_sha256", "type", "link_target")
     IS_DIR_FIELD_NUMBER: _ClassVar[int]
     SRC_PATH_FIELD_NUMBER: _ClassVar[int]
     DEST_PATH_FIELD_NUMBER: _ClassVar[int]
     MODE_FIELD_NUMBER: _ClassVar[int]
     SIZE_FIELD_NUMBER: _ClassVar[int]
+    COMPRESSION_FIELD_NUMBER: _ClassVar[int]
+    MTIME_FIELD_NUMBER: _ClassVar[int]
+    DELETE_FIELD_NUMBER: _ClassVar[int]
+    BLOB_SHA256_FIELD_NUMBER: _ClassVar[int]
+    TYPE_FIELD_NUMBER: _ClassVar[int]
+    LINK_TARGET_FIELD_NUMBER: _ClassVar[int]
     is_dir: bool
     src_path: str
     dest_path: str
     mode: int
     size: int
-    def __init__(self, is_dir: bool = ..., src_path: _Optional[str] = ..., dest_path: _Optional[str] = ..., mode: _Optional[int] = ..., size: _Optional[int] = ...) -> None: ...
+    compression: Compression
+    mtime: _timestamp_pb2.Timestamp
+    delete: bool
+    blob_sha256: bytes
+    type: FileType
+    link_target: str
+    def __init__(self, is_dir: bool = ..., src_path: _Optional[str] = ..., dest_path: _Optional[str] = ..., mode: _Optional[int] = ..., size: _Optional[int] = ..., compression: _Optional[_Union[Compression, str]] = ..., mtime: _Optional[_Union[_timestamp_pb2.Timestamp, _Mapping]] = ..., delete: bool = ..., blob_sha256: _Optional[bytes] = ..., type: _Optional[_Union[FileType, str]] = ..., link_target: _Optional[str] = ...) -> None: ...
 
 class FileTransferBody(_message.Message):
     __slots__ = ("offset", "data")
@@ -261,26 +500,104 @@ class FileTransferBody(_message.Message):
     def __init__(self, offset: _Optional[int] = ..., data: _Optional[bytes] = ...) -> None: ...
 
 class FileTransferTrailer(_message.Message):
-    __slots__ = ("md5",)
+    __slots__ = ("md5", "sha256")
     MD5_FIELD_NUMBER: _ClassVar[int]
+    SHA256_FIELD_NUMBER: _ClassVar[int]
     md5: bytes
-    def __init__(self, md5: _Optional[bytes] = ...) -> None: ...
+    sha256: bytes
+    def __init__(self, md5: _Optional[bytes] = ..., sha256: _Optional[bytes] = ...) -> None: ...
+
+class FileDigest(_message.Message):
+    __slots__ = ("path", "size", "sha256")
+    PATH_FIELD_NUMBER: _ClassVar[int]
+    SIZE_FIELD_NUMBER: _ClassVar[int]
+    SHA256_FIELD_NUMBER: _ClassVar[int]
+    path: str
+    size: int
+    sha256: bytes
+    def __init__(self, path: _Optional[str] = ..., size: _Optional[int] = ..., sha256: _Optional[bytes] = ...) -> None: ...
+
+class ManifestRequest(_message.Message):
+    __slots__ = ("runtime_id", "path")
+    RUNTIME_ID_FIELD_NUMBER: _ClassVar[int]
+    PATH_FIELD_NUMBER: _ClassVar[int]
+    runtime_id: str
+    path: str
+    def __init__(self, runtime_id: _Optional[str] = ..., path: _Optional[str] = ...) -> None: ...
+
+class ManifestResponse(_message.Message):
+    __slots__ = ("entries",)
+    ENTRIES_FIELD_NUMBER: _ClassVar[int]
+    entries: _containers.RepeatedCompositeFieldContainer[ManifestEntry]
+    def __init__(self, entries: _Optional[_Iterable[_Union[ManifestEntry, _Mapping]]] = ...) -> None: ...
+
+class ManifestEntry(_message.Message):
+    __slots__ = ("path", "is_dir", "size", "mtime", "mode")
+    PATH_FIELD_NUMBER: _ClassVar[int]
+    IS_DIR_FIELD_NUMBER: _ClassVar[int]
+    SIZE_FIELD_NUMBER: _ClassVar[int]
+    MTIME_FIELD_NUMBER: _ClassVar[int]
+    MODE_FIELD_NUMBER: _ClassVar[int]
+    path: str
+    is_dir: bool
+    size: int
+    mtime: _timestamp_pb2.Timestamp
+    mode: int
+    def __init__(self, path: _Optional[str] = ..., is_dir: bool = ..., size: _Optional[int] = ..., mtime: _Optional[_Union[_timestamp_pb2.Timestamp, _Mapping]] = ..., mode: _Optional[int] = ...) -> None: ...
+
+class ManifestDigestsRequest(_message.Message):
+    __slots__ = ("runtime_id", "paths")
+    RUNTIME_ID_FIELD_NUMBER: _ClassVar[int]
+    PATHS_FIELD_NUMBER: _ClassVar[int]
+    runtime_id: str
+    paths: _containers.RepeatedScalarFieldContainer[str]
+    def __init__(self, runtime_id: _Optional[str] = ..., paths: _Optional[_Iterable[str]] = ...) -> None: ...
+
+class ManifestDigestsResponse(_message.Message):
+    __slots__ = ("files",)
+    FILES_FIELD_NUMBER: _ClassVar[int]
+    files: _containers.RepeatedCompositeFieldContainer[FileDigest]
+    def __init__(self, files: _Optional[_Iterable[_Union[FileDigest, _Mapping]]] = ...) -> None: ...
+
+class BlobsRequest(_message.Message):
+    __slots__ = ("runtime_id", "sha256s")
+    RUNTIME_ID_FIELD_NUMBER: _ClassVar[int]
+    SHA256S_FIELD_NUMBER: _ClassVar[int]
+    runtime_id: str
+    sha256s: _containers.RepeatedScalarFieldContainer[bytes]
+    def __init__(self, runtime_id: _Optional[str] = ..., sha256s: _Optional[_Iterable[bytes]] = ...) -> None: ...
+
+class BlobsResponse(_message.Message):
+    __slots__ = ("have",)
+    HAVE_FIELD_NUMBER: _ClassVar[int]
+    have: _containers.RepeatedScalarFieldContainer[bytes]
+    def __init__(self, have: _Optional[_Iterable[bytes]] = ...) -> None: ...
 
 class ImportResponse(_message.Message):
-    __slots__ = ()
-    def __init__(self) -> None: ...
+    __slots__ = ("files",)
+    FILES_FIELD_NUMBER: _ClassVar[int]
+    files: _containers.RepeatedCompositeFieldContainer[FileDigest]
+    def __init__(self, files: _Optional[_Iterable[_Union[FileDigest, _Mapping]]] = ...) -> None: ...
 
 class ExportRequest(_message.Message):
-    __slots__ = ("runtime_id", "export_id", "src_path", "opts")
+    __slots__ = ("runtime_id", "export_id", "src_path", "opts", "accept_compressions", "max_streams", "stream_index", "stream_count")
     RUNTIME_ID_FIELD_NUMBER: _ClassVar[int]
     EXPORT_ID_FIELD_NUMBER: _ClassVar[int]
     SRC_PATH_FIELD_NUMBER: _ClassVar[int]
     OPTS_FIELD_NUMBER: _ClassVar[int]
+    ACCEPT_COMPRESSIONS_FIELD_NUMBER: _ClassVar[int]
+    MAX_STREAMS_FIELD_NUMBER: _ClassVar[int]
+    STREAM_INDEX_FIELD_NUMBER: _ClassVar[int]
+    STREAM_COUNT_FIELD_NUMBER: _ClassVar[int]
     runtime_id: str
     export_id: str
     src_path: str
     opts: ExportOpts
-    def __init__(self, runtime_id: _Optional[str] = ..., export_id: _Optional[str] = ..., src_path: _Optional[str] = ..., opts: _Optional[_Union[ExportOpts, _Mapping]] = ...) -> None: ...
+    accept_compressions: _containers.RepeatedScalarFieldContainer[Compression]
+    max_streams: int
+    stream_index: int
+    stream_count: int
+    def __init__(self, runtime_id: _Optional[str] = ..., export_id: _Optional[str] = ..., src_path: _Optional[str] = ..., opts: _Optional[_Union[ExportOpts, _Mapping]] = ..., accept_compressions: _Optional[_Iterable[_Union[Compression, str]]] = ..., max_streams: _Optional[int] = ..., stream_index: _Optional[int] = ..., stream_count: _Optional[int] = ...) -> None: ...
 
 class ExportOpts(_message.Message):
     __slots__ = ("dest_path", "excludes", "meta")
@@ -60,11 +60,36 @@ class ExecutorStub(object):
                 request_serializer=executor_dot_v1_dot_executor__pb2.HeartbeatRequest.SerializeToString,
                 response_deserializer=executor_dot_v1_dot_executor__pb2.HeartbeatResponse.FromString,
                 _registered_method=True)
-        self.Exec = channel.unary_unary(
+        self.Exec = channel.stream_unary(
                 '/executor.knita.io.Executor/Exec',
                 request_serializer=executor_dot_v1_dot_executor__pb2.ExecRequest.SerializeToString,
                 response_deserializer=executor_dot_v1_dot_executor__pb2.ExecResponse.FromString,
                 _registered_method=True)
+        self.Signal = channel.unary_unary(
+                '/executor.knita.io.Executor/Signal',
+                request_serializer=executor_dot_v1_dot_executor__pb2.SignalRequest.SerializeToString,
+                response_deserializer=executor_dot_v1_dot_executor__pb2.SignalResponse.FromString,
+                _registered_method=True)
+        self.Attach = channel.stream_stream(
+                '/executor.knita.io.Executor/Attach',
+                request_serializer=executor_dot_v1_dot_executor__pb2.AttachRequest.SerializeToString,
+                response_deserializer=executor_dot_v1_dot_executor__pb2.AttachResponse.FromString,
+                _registered_method=True)
+        self.Manifest = channel.unary_stream(
+                '/executor.knita.io.Executor/Manifest',
+                request_serializer=executor_dot_v1_dot_executor__pb2.ManifestRequest.SerializeToString,
+                response_deserializer=executor_dot_v1_dot_executor__pb2.ManifestResponse.FromString,
+                _registered_method=True)
+        self.ManifestDigests = channel.unary_unary(
+                '/executor.knita.io.Executor/ManifestDigests',
+                request_serializer=executor_dot_v1_dot_executor__pb2.ManifestDigestsRequest.SerializeToString,
+                response_deserializer=executor_dot_v1_dot_executor__pb2.ManifestDigestsResponse.FromString,
+                _registered_method=True)
+        self.Blobs = channel.unary_unary(
+                '/executor.knita.io.Executor/Blobs',
+                request_serializer=executor_dot_v1_dot_executor__pb2.BlobsRequest.SerializeToString,
+                response_deserializer=executor_dot_v1_dot_executor__pb2.BlobsResponse.FromString,
+                _registered_method=True)
         self.Import = channel.stream_unary(
                 '/executor.knita.io.Executor/Import',
                 request_serializer=executor_dot_v1_dot_executor__pb2.FileTransfer.SerializeToString,
@@ -109,7 +134,37 @@ class ExecutorServicer(object):
         context.set_details('Method not implemented!')
         raise NotImplementedError('Method not implemented!')
 
-    def Exec(self, request, context):
+    def Exec(self, request_iterator, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def Signal(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def Attach(self, request_iterator, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def Manifest(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def ManifestDigests(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def Blobs(self, request, context):
         """Missing associated documentation comment in .proto file."""
         context.set_code(grpc.StatusCode.UNIMPLEMENTED)
         context.set_details('Method not implemented!')
@@ -156,11 +211,36 @@ def add_ExecutorServicer_to_server(servicer, server):
                     request_deserializer=executor_dot_v1_dot_executor__pb2.HeartbeatRequest.FromString,
                     response_serializer=executor_dot_v1_dot_executor__pb2.HeartbeatResponse.SerializeToString,
             ),
-            'Exec': grpc.unary_unary_rpc_method_handler(
+            'Exec': grpc.stream_unary_rpc_method_handler(
                     servicer.Exec,
                     request_deserializer=executor_dot_v1_dot_executor__pb2.ExecRequest.FromString,
                     response_serializer=executor_dot_v1_dot_executor__pb2.ExecResponse.SerializeToString,
             ),
+            'Signal': grpc.unary_unary_rpc_method_handler(
+                    servicer.Signal,
+                    request_deserializer=executor_dot_v1_dot_executor__pb2.SignalRequest.FromString,
+                    response_serializer=executor_dot_v1_dot_executor__pb2.SignalResponse.SerializeToString,
+            ),
+            'Attach': grpc.stream_stream_rpc_method_handler(
+                    servicer.Attach,
+                    request_deserializer=executor_dot_v1_dot_executor__pb2.AttachRequest.FromString,
+                    response_serializer=executor_dot_v1_dot_executor__pb2.AttachResponse.SerializeToString,
+            ),
+            'Manifest': grpc.unary_stream_rpc_method_handler(
+                    servicer.Manifest,
+                    request_deserializer=executor_dot_v1_dot_executor__pb2.ManifestRequest.FromString,
+                    response_serializer=executor_dot_v1_dot_executor__pb2.ManifestResponse.SerializeToString,
+            ),
+            'ManifestDigests': grpc.unary_unary_rpc_method_handler(
+                    servicer.ManifestDigests,
+                    request_deserializer=executor_dot_v1_dot_executor__pb2.ManifestDigestsRequest.FromString,
+                    response_serializer=executor_dot_v1_dot_executor__pb2.ManifestDigestsResponse.SerializeToString,
+            ),
+            'Blobs': grpc.unary_unary_rpc_method_handler(
+                    servicer.Blobs,
+                    request_deserializer=executor_dot_v1_dot_executor__pb2.BlobsRequest.FromString,
+                    response_serializer=executor_dot_v1_dot_executor__pb2.BlobsResponse.SerializeToString,
+            ),
             'Import': grpc.stream_unary_rpc_method_handler(
                     servicer.Import,
                     request_deserializer=executor_dot_v1_dot_executor__pb2.FileTransfer.FromString,
@@ -295,7 +375,7 @@ class Executor(object):
             _registered_method=True)
 
     @staticmethod
-    def Exec(request,
+    def Exec(request_iterator,
             target,
             options=(),
             channel_credentials=None,
@@ -305,8 +385,8 @@ class Executor(object):
             wait_for_ready=None,
             timeout=None,
             metadata=None):
-        return grpc.experimental.unary_unary(
-            request,
+        return grpc.experimental.stream_unary(
+            request_iterator,
             target,
             '/executor.knita.io.Executor/Exec',
             executor_dot_v1_dot_executor__pb2.ExecRequest.SerializeToString,
@@ -321,6 +401,141 @@ class Executor(object):
             metadata,
             _registered_method=True)
 
+    @staticmethod
+    def Signal(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/executor.knita.io.Executor/Signal',
+            executor_dot_v1_dot_executor__pb2.SignalRequest.SerializeToString,
+            executor_dot_v1_dot_executor__pb2.SignalResponse.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def Attach(request_iterator,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.stream_stream(
+            request_iterator,
+            target,
+            '/executor.knita.io.Executor/Attach',
+            executor_dot_v1_dot_executor__pb2.AttachRequest.SerializeToString,
+            executor_dot_v1_dot_executor__pb2.AttachResponse.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def Manifest(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_stream(
+            request,
+            target,
+            '/executor.knita.io.Executor/Manifest',
+            executor_dot_v1_dot_executor__pb2.ManifestRequest.SerializeToString,
+            executor_dot_v1_dot_executor__pb2.ManifestResponse.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def ManifestDigests(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/executor.knita.io.Executor/ManifestDigests',
+            executor_dot_v1_dot_executor__pb2.ManifestDigestsRequest.SerializeToString,
+            executor_dot_v1_dot_executor__pb2.ManifestDigestsResponse.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def Blobs(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/executor.knita.io.Executor/Blobs',
+            executor_dot_v1_dot_executor__pb2.BlobsRequest.SerializeToString,
+            executor_dot_v1_dot_executor__pb2.BlobsResponse.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
     @staticmethod
     def Import(request_iterator,
             target,
@@ -1,12 +1,17 @@
 import os
+from contextlib import contextmanager
 from enum import Enum
-from typing import Optional, List
+from typing import Optional, List, Tuple
+import grpc
 from google.protobuf.any_pb2 import Any
+from google.protobuf.duration_pb2 import Duration
 from . import director_pb2
 from . import director_pb2_grpc
 from . import executor_pb2
 from . import builtin_pb2
 
+_STDIN_CHUNK_SIZE = 32 * 1024
+
 
 class Operator(str, Enum):
     """Operator values for a label-selector Requirement (matches the gRPC proto)."""
@@ -33,6 +38,54 @@ def _opts_meta(labels: Optional[dict] = None,
     return executor_pb2.OptsMeta(labels=labels or {}, annotations=annotations or {})
 
 
+def _duration(seconds: Optional[float]) -> Optional[Duration]:
+    """Build a Duration from a number of seconds, or return None if seconds is None."""
+    if seconds is None:
+        return None
+    d = Duration()
+    d.FromNanoseconds(int(seconds * 1e9))
+    return d
+
+
+def _tty_opts(tty: Optional[Tuple[int, int]]) -> Optional[executor_pb2.TtyOpts]:
+    """Build TtyOpts from a (rows, cols) tuple, or return None if tty is None."""
+    if tty is None:
+        return None
+    rows, cols = tty
+    return executor_pb2.TtyOpts(rows=rows, cols=cols)
+
+
+def _exec_requests(req: director_pb2.ExecRequest, stdin=None):
+    """Yield the initial exec request followed by chunks of stdin (if any)."""
+    yield req
+    if stdin is None:
+        return
+    if isinstance(stdin, str):
+        stdin = stdin.encode()
+    if isinstance(stdin, bytes):
+        for i in range(0, len(stdin), _STDIN_CHUNK_SIZE):
+            yield director_pb2.ExecRequest(stdin=stdin[i:i + _STDIN_CHUNK_SIZE])
+        return
+    while True:
+        chunk = stdin.read(_STDIN_CHUNK_SIZE)
+        if not chunk:
+            return
+        if isinstance(chunk, str):
+            chunk = chunk.encode()
+        yield director_pb2.ExecRequest(stdin=chunk)
+
+
+@contextmanager
+def _transfer_errors():
+    """Raises ChecksumMismatchException in place of the RPC error if a transferred file fails verification."""
+    try:
+        yield
+    except grpc.RpcError as e:
+        if e.code() == grpc.StatusCode.DATA_LOSS:
+            raise ChecksumMismatchException(e.details()) from e
+        raise
+
+
 def _label_selector(match_labels: Optional[dict],
                     match_expressions: Optional[List[Requirement]]) -> Optional[executor_pb2.LabelSelector]:
     """Build a LabelSelector from a matchLabels dict and a list of Requirement, or None."""
@@ -99,9 +152,20 @@ class DockerAWSECRAuth:
 class ExecException(Exception):
     """Raised when exec finishes with a non-zero exit code."""
     exit_code: int
+    # Resource usage in Docker runtimes is container wide, so includes any other processes running in the container.
+    usage: Optional[executor_pb2.ResourceUsage]
 
-    def __init__(self, exit_code: int):
+    def __init__(self, exit_code: int, usage: Optional[executor_pb2.ResourceUsage] = None):
         self.exit_code = exit_code
+        self.usage = usage
+
+
+class ChecksumMismatchException(Exception):
+    """Raised when an imported or exported file fails verification, indicating it was corrupted in transit."""
+
+
+class BuildAbortedException(Exception):
+    """Raised when a paused build is aborted."""
 
 
 class Runtime:
@@ -144,40 +208,77 @@ class Runtime:
 
     def import_(self, src: str, dest: str = None, excludes: [str] = None,
                 display_name: str = "", labels: Optional[dict] = None,
-                annotations: Optional[dict] = None):
+                annotations: Optional[dict] = None, delete_extraneous: bool = False,
+                streams: int = 0, archive: bool = False, includes: [str] = None,
+                gitignore: bool = False, strict_symlinks: bool = False) -> director_pb2.ImportResponse:
         """Import files from the local work directory into the runtime's remote work directory. src must be a
         relative path, and may be a glob (doublestar syntax supported). By default, all files identified by src will
-        be copied to their original location on the remote. Use ImportOpts.dest to override this."""
+        be copied to their original location on the remote. Use ImportOpts.dest to override this. Files the runtime
+        already holds with identical content are skipped. If delete_extraneous is set, files in the imported
+        directories that do not exist locally are deleted from the runtime. streams is the number of concurrent
+        streams to split the import across (up to the executor's maximum); by default it is based on the number and
+        size of the files, and 1 imports sequentially. If archive is set, src is a local .tar, .tar.gz, .tgz or .zip
+        archive whose contents are extracted into dest in the runtime. If includes are given, only the files and
+        directories that match (or are within a directory that matches) one of them are imported; excludes take
+        precedence. Paths ignored by .knitaignore files are always skipped, as are paths ignored by .gitignore files
+        if gitignore is set. Symlinks whose targets are absolute, or resolve outside of dest, are skipped with a
+        warning, or fail the import if strict_symlinks is set. Every imported file is verified against its SHA-256
+        digest; returns the verified digests. Raises ChecksumMismatchException if a file fails verification."""
         req = director_pb2.ImportRequest(
             runtime_id=self.__runtime_id,
             opts=director_pb2.ImportOpts(src_path=src, dest_path=dest, excludes=excludes,
-                                         display_name=display_name,
+                                         display_name=display_name, delete_extraneous=delete_extraneous,
+                                         streams=streams, archive=archive, includes=includes,
+                                         gitignore=gitignore, strict_symlinks=strict_symlinks,
                                          meta=_opts_meta(labels, annotations)))
-        self.__director_stub.Import(req)
+        with _transfer_errors():
+            return self.__director_stub.Import(req)
 
     def export(self, src: str, dest: str = None, excludes: [str] = None,
                display_name: str = "", labels: Optional[dict] = None,
-               annotations: Optional[dict] = None):
+               annotations: Optional[dict] = None, streams: int = 0,
+               archive: bool = False, strict_symlinks: bool = False) -> director_pb2.ExportResponse:
         """Export files from the runtime's remote work directory into the local work directory. src must be a
         relative path, and may be a glob (doublestar syntax supported). By default, all files identified by src will
-        be copied to their original location locally. Use ExportOpts.dest to override this."""
+        be copied to their original location locally. Use ExportOpts.dest to override this. streams is the number of
+        concurrent streams to split the export across (up to the executor's maximum); by default it is based on the
+        number and size of the files, and 1 exports sequentially. If archive is set, the exported files are packed into
+        a .tar, .tar.gz, .tgz or .zip archive at dest (which is required) rather than written to the local work
+        directory. Symlinks whose targets are absolute, or resolve outside of dest, are skipped with a warning, or
+        fail the export if strict_symlinks is set. Every exported file is verified against its SHA-256 digest;
+        returns the verified digests. Raises ChecksumMismatchException if a file fails verification."""
         req = director_pb2.ExportRequest(
             runtime_id=self.__runtime_id,
             opts=director_pb2.ExportOpts(src_path=src, dest_path=dest, excludes=excludes,
-                                         display_name=display_name,
-                                         meta=_opts_meta(labels, annotations)))
-        self.__director_stub.Export(req)
+                                         display_name=display_name, streams=streams, archive=archive,
+                                         strict_symlinks=strict_symlinks, meta=_opts_meta(labels, annotations)))
+        with _transfer_errors():
+            return self.__director_stub.Export(req)
 
     def exec(self, name: str, args: [str] = None, env: [str] = None, display_name: str = "", stdout=None,
-             stderr=None, labels: Optional[dict] = None, annotations: Optional[dict] = None):
-        """Exec executes a command inside the remote runtime.
-        Raises ExecException if the command finishes with a non-zero code."""
+             stderr=None, labels: Optional[dict] = None, annotations: Optional[dict] = None, stdin=None,
+             timeout: Optional[float] = None, work_dir: str = "", user: str = "", script: str = "",
+             tty: Optional[Tuple[int, int]] = None) -> builtin_pb2.ExecResult:
+        """Exec executes a command inside the remote runtime. stdin may be bytes, a str, or a file-like object
+        opened for reading, and is streamed to the command until exhausted. timeout is the number of seconds
+        the command may run for before it is terminated. work_dir is relative to the runtime's work directory.
+        user is in the form "user", "user:group", "uid" or "uid:gid". script is run with the runtime's shell
+        instead of name and args (pass an empty name). tty is a (rows, cols) tuple that runs the command in a
+        pseudo-terminal of that size; the terminal's output is delivered to stdout.
+        Returns the exec's result, including the resources it consumed.
+        Raises ExecException if the command finishes with a non-zero code or times out. If the build pauses on
+        failure, blocks until it is resumed."""
         req = director_pb2.ExecRequest(
             runtime_id=self.__runtime_id,
             opts=executor_pb2.ExecOpts(name=name, args=args, env=env,
                                        display_name=display_name,
-                                       meta=_opts_meta(labels, annotations)))
-        for event in self.__director_stub.Exec(req):
+                                       meta=_opts_meta(labels, annotations),
+                                       stdin=stdin is not None,
+                                       timeout=_duration(timeout),
+                                       work_dir=work_dir, user=user, script=script,
+                                       tty=_tty_opts(tty)))
+        failed = None
+        for event in self.__director_stub.Exec(_exec_requests(req, stdin)):
             any_msg: Any = event.payload
             type_name = any_msg.TypeName()
             if type_name == builtin_pb2.StdoutEvent.DESCRIPTOR.full_name:
@@ -196,10 +297,22 @@ class Runtime:
                 ev = builtin_pb2.ExecEndEvent()
                 any_msg.Unpack(ev)
                 if ev.HasField("result"):
-                    code = ev.result.exit_code
-                    if code != 0:
-                        raise ExecException(code)
-                    return
+                    if ev.result.exit_code != 0 or ev.result.timed_out:
+                        failed = ev.result
+                        # Keep receiving until the stream ends, as the build may pause on failure first.
+                        continue
+                    return ev.result
+        if failed is not None:
+            raise ExecException(failed.exit_code, failed.usage)
+
+    def pause(self, reason: str):
+        """Pause pauses the build at a breakpoint so the runtime can be inspected, e.g. with knita attach.
+        The runtime is kept alive while paused. Blocks until the build is resumed with knita resume, or the
+        build's pause timeout expires. Raises BuildAbortedException if the build is aborted with knita abort."""
+        req = director_pb2.PauseRequest(runtime_id=self.__runtime_id, reason=reason)
+        res = self.__director_stub.Pause(req)
+        if res.aborted:
+            raise BuildAbortedException()
 
     def close(self):
         """Close the runtime. After a call to close the runtime can no longer be used."""